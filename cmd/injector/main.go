@@ -31,8 +31,9 @@ import (
 )
 
 var (
-	log         = logger.NewLogger("dapr.injector")
-	healthzPort int
+	log             = logger.NewLogger("dapr.injector")
+	healthzPort     int
+	metricsExporter metrics.Exporter
 )
 
 func main() {
@@ -68,6 +69,10 @@ func main() {
 		healthzServer.Ready()
 	})
 
+	if err := metricsExporter.Close(); err != nil {
+		log.Warnf("error closing metrics exporter: %v", err)
+	}
+
 	log.Infof("Dapr sidecar injector shut down")
 }
 
@@ -75,7 +80,7 @@ func init() {
 	loggerOptions := logger.DefaultOptions()
 	loggerOptions.AttachCmdFlags(flag.StringVar, flag.BoolVar)
 
-	metricsExporter := metrics.NewExporter(metrics.DefaultMetricNamespace)
+	metricsExporter = metrics.NewExporter(metrics.DefaultMetricNamespace)
 	metricsExporter.Options().AttachCmdFlags(flag.StringVar, flag.BoolVar)
 	var kubeconfig *string
 	if home := homedir.HomeDir(); home != "" {
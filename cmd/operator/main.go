@@ -32,6 +32,7 @@ var (
 	config                string
 	certChainPath         string
 	disableLeaderElection bool
+	metricsExporter       metrics.Exporter
 )
 
 const (
@@ -50,6 +51,10 @@ func main() {
 	go operator.RunWebhooks(ctx, !disableLeaderElection)
 
 	<-ctx.Done() // Wait for SIGTERM and SIGINT.
+
+	if err := metricsExporter.Close(); err != nil {
+		log.Warnf("error closing metrics exporter: %v", err)
+	}
 }
 
 func init() {
@@ -61,7 +66,7 @@ func init() {
 	loggerOptions := logger.DefaultOptions()
 	loggerOptions.AttachCmdFlags(flag.StringVar, flag.BoolVar)
 
-	metricsExporter := metrics.NewExporter(metrics.DefaultMetricNamespace)
+	metricsExporter = metrics.NewExporter(metrics.DefaultMetricNamespace)
 	metricsExporter.Options().AttachCmdFlags(flag.StringVar, flag.BoolVar)
 
 	flag.StringVar(&config, "config", defaultDaprSystemConfigName, "Path to config file, or name of a configuration object")
@@ -26,6 +26,8 @@ import (
 const (
 	defaultCredentialsPath   = "/var/run/dapr/credentials"
 	defaultHealthzPort       = 8080
+	defaultAdminPort         = 8081
+	defaultAdminAddress      = "localhost"
 	defaultPlacementPort     = 50005
 	defaultReplicationFactor = 100
 )
@@ -41,6 +43,8 @@ type config struct {
 	// Placement server configurations
 	placementPort int
 	healthzPort   int
+	adminPort     int
+	adminAddress  string
 	certChainPath string
 	tlsEnabled    bool
 
@@ -62,6 +66,8 @@ func newConfig() *config {
 
 		placementPort: defaultPlacementPort,
 		healthzPort:   defaultHealthzPort,
+		adminPort:     defaultAdminPort,
+		adminAddress:  defaultAdminAddress,
 		certChainPath: defaultCredentialsPath,
 		tlsEnabled:    false,
 	}
@@ -72,6 +78,8 @@ func newConfig() *config {
 	flag.StringVar(&cfg.raftLogStorePath, "raft-logstore-path", cfg.raftLogStorePath, "raft log store path.")
 	flag.IntVar(&cfg.placementPort, "port", cfg.placementPort, "sets the gRPC port for the placement service")
 	flag.IntVar(&cfg.healthzPort, "healthz-port", cfg.healthzPort, "sets the HTTP port for the healthz server")
+	flag.IntVar(&cfg.adminPort, "admin-port", cfg.adminPort, "sets the HTTP port for the read-only admin API. Set to 0 to disable it")
+	flag.StringVar(&cfg.adminAddress, "admin-listen-address", cfg.adminAddress, "sets the address the admin API listens on. The admin API requires mTLS or the DAPR_API_TOKEN environment variable")
 	flag.StringVar(&cfg.certChainPath, "certchain", cfg.certChainPath, "Path to the credentials directory holding the cert chain")
 	flag.BoolVar(&cfg.tlsEnabled, "tls-enabled", cfg.tlsEnabled, "Should TLS be enabled for the placement gRPC server")
 	flag.IntVar(&cfg.replicationFactor, "replicationFactor", defaultReplicationFactor, "sets the replication factor for actor distribution on vnodes")
@@ -30,6 +30,7 @@ import (
 	"github.com/dapr/dapr/pkg/placement/hashing"
 	"github.com/dapr/dapr/pkg/placement/monitoring"
 	"github.com/dapr/dapr/pkg/placement/raft"
+	auth "github.com/dapr/dapr/pkg/runtime/security"
 	"github.com/dapr/dapr/pkg/version"
 )
 
@@ -82,18 +83,36 @@ func main() {
 	// Start Healthz endpoint.
 	go startHealthzServer(cfg.healthzPort)
 
+	// Start admin API endpoint.
+	ctx, cancel := context.WithCancel(context.Background())
+	adminToken := auth.GetAPIToken()
+	if cfg.adminPort > 0 && certChain == nil && adminToken == "" {
+		log.Warnf("admin API is disabled: it requires mTLS or the %s environment variable", auth.APITokenEnvVar)
+	} else if cfg.adminPort > 0 {
+		go startAdminServer(ctx, apiServer, placement.AdminServerOptions{
+			Address:   cfg.adminAddress,
+			Port:      cfg.adminPort,
+			CertChain: certChain,
+			APIToken:  adminToken,
+		})
+	}
+
 	// Relay incoming process signal to exit placement gracefully
 	signalCh := make(chan os.Signal, 10)
 	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
 	defer signal.Stop(signalCh)
 
 	<-signalCh
+	cancel()
 
 	// Shutdown servers
 	gracefulExitCh := make(chan struct{})
 	go func() {
 		apiServer.Shutdown()
 		raftServer.Shutdown()
+		if err := cfg.metricsExporter.Close(); err != nil {
+			log.Warnf("error closing metrics exporter: %v", err)
+		}
 		close(gracefulExitCh)
 	}()
 
@@ -117,6 +136,12 @@ func startHealthzServer(healthzPort int) {
 	}
 }
 
+func startAdminServer(ctx context.Context, apiServer *placement.Service, opts placement.AdminServerOptions) {
+	if err := apiServer.RunAdminServer(ctx, opts); err != nil {
+		log.Fatalf("failed to start admin server: %s", err)
+	}
+}
+
 func loadCertChains(certChainPath string) *credentials.CertChain {
 	tlsCreds := credentials.NewTLSCredentials(certChainPath)
 
@@ -158,4 +158,8 @@ func main() {
 	shutdownDuration := 5 * time.Second
 	log.Infof("allowing %s for graceful shutdown to complete", shutdownDuration)
 	<-time.After(shutdownDuration)
+
+	if err := metricsExporter.Close(); err != nil {
+		log.Warnf("error closing metrics exporter: %v", err)
+	}
 }
@@ -19,10 +19,14 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/PuerkitoBio/purell"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/dapr/kit/logger"
 
@@ -106,6 +110,7 @@ func ParseAccessControlSpec(accessControlSpec config.AccessControlSpec, protocol
 			operationActions := config.AccessControlListOperationAction{
 				OperationName: operationName,
 				VerbAction:    make(map[string]string),
+				RateLimiter:   newRateLimiter(appPolicy.RateLimit),
 			}
 
 			// Iterate over all the http verbs and create a map and set the action for fast lookup
@@ -118,12 +123,54 @@ func ParseAccessControlSpec(accessControlSpec config.AccessControlSpec, protocol
 
 			operationPolicy.PutOperationAction(operationName, &operationActions)
 		}
+		// Topics are stored in a separate tree for each pub/sub component
+		pubSubPolicy := make(map[string]*config.Trie)
+		pubSubTopicActions := make(map[string]*config.AccessControlListOperationAction)
+		for _, pubSubAction := range appPolicySpec.PubSubActions {
+			pubsubName := pubSubAction.PubSubName
+			if pubsubName == "" {
+				pubsubName = "*"
+			}
+			if _, ok := pubSubPolicy[pubsubName]; !ok {
+				pubSubPolicy[pubsubName] = config.NewTrie()
+			}
+
+			topic := pubSubAction.Topic
+			if topic == "" {
+				topic = "**"
+			}
+			if !strings.HasPrefix(topic, "/") {
+				topic = "/" + topic
+			}
+
+			// Actions for the same topic are merged, as the tree keeps the first value stored for a topic
+			topicKey := pubsubName + "||" + topic
+			topicActions, ok := pubSubTopicActions[topicKey]
+			if !ok {
+				topicActions = &config.AccessControlListOperationAction{
+					OperationName: topic,
+					VerbAction:    make(map[string]string),
+				}
+				pubSubTopicActions[topicKey] = topicActions
+				pubSubPolicy[pubsubName].PutOperationAction(topic, topicActions)
+			}
+			for _, operation := range pubSubAction.Operations {
+				topicActions.VerbAction[strings.ToLower(operation)] = pubSubAction.Action
+			}
+			if len(pubSubAction.Operations) == 0 {
+				// The action applies to both publishing and subscribing
+				topicActions.OperationAction = pubSubAction.Action
+			}
+		}
+
 		aclPolicySpec := config.AccessControlListPolicySpec{
 			AppName:             appPolicySpec.AppName,
 			DefaultAction:       appPolicySpec.DefaultAction,
 			TrustDomain:         appPolicySpec.TrustDomain,
 			Namespace:           appPolicySpec.Namespace,
 			AppOperationActions: operationPolicy,
+			RateLimiter:         newRateLimiter(appPolicySpec.RateLimit),
+			PubSubActions:       pubSubPolicy,
 		}
 
 		// The policy spec can have the same appID which belongs to different namespaces
@@ -236,7 +283,9 @@ func normalizeOperation(operation string) (string, error) {
 	return s, nil
 }
 
-func ApplyAccessControlPolicies(ctx context.Context, operation string, httpVerb commonv1pb.HTTPExtension_Verb, appProtocol string, acl *config.AccessControlList) (bool, string) {
+// ApplyAccessControlPolicies returns an error if the access control policies don't allow the caller to invoke the operation:
+// PermissionDenied if the operation is denied, or ResourceExhausted if the caller exceeded its rate limit.
+func ApplyAccessControlPolicies(ctx context.Context, operation string, httpVerb commonv1pb.HTTPExtension_Verb, appProtocol string, acl *config.AccessControlList) error {
 	// Apply access control list filter
 	spiffeID, err := GetAndParseSpiffeID(ctx)
 	if err != nil {
@@ -256,7 +305,7 @@ func ApplyAccessControlPolicies(ctx context.Context, operation string, httpVerb
 	if err != nil {
 		errMessage = fmt.Sprintf("error in method normalization: %s", err)
 		log.Debugf(errMessage)
-		return false, errMessage
+		return status.Error(codes.PermissionDenied, errMessage)
 	}
 
 	action, actionPolicy := IsOperationAllowedByAccessControlPolicy(spiffeID, appID, operation, httpVerb, appProtocol, acl)
@@ -265,9 +314,17 @@ func ApplyAccessControlPolicies(ctx context.Context, operation string, httpVerb
 	if !action {
 		errMessage = fmt.Sprintf("access control policy has denied access to appid: %s operation: %s verb: %s", appID, operation, httpVerb)
 		log.Debugf(errMessage)
+		return status.Error(codes.PermissionDenied, errMessage)
 	}
 
-	return action, errMessage
+	if !IsOperationWithinRateLimit(spiffeID, appID, operation, appProtocol, acl) {
+		diag.DefaultMonitoring.RequestRateLimitedByAppPolicy(appID, trustDomain, namespace, operation, httpVerb.String())
+		errMessage = fmt.Sprintf("access control policy has rate limited appid: %s operation: %s verb: %s", appID, operation, httpVerb)
+		log.Debugf(errMessage)
+		return status.Error(codes.ResourceExhausted, errMessage)
+	}
+
+	return nil
 }
 
 func emitACLMetrics(actionPolicy, appID, trustDomain, namespace, operation, verb string, action bool) {
@@ -375,6 +432,123 @@ func IsOperationAllowedByAccessControlPolicy(spiffeID *config.SpiffeID, srcAppID
 	return isActionAllowed(action), actionPolicy
 }
 
+// IsOperationWithinRateLimit determines if the rate limits of the access control policy of the calling app allow the operation.
+// Each allowed call consumes a request from the rate limits of the app and of the matching operation.
+func IsOperationWithinRateLimit(spiffeID *config.SpiffeID, srcAppID string, inputOperation string, appProtocol string, accessControlList *config.AccessControlList) bool {
+	if accessControlList == nil || srcAppID == "" || spiffeID == nil {
+		return true
+	}
+
+	// Rate limits are only enforced for the policies matching the identity of the caller
+	key := getKeyForAppID(srcAppID, spiffeID.Namespace)
+	appPolicy, found := accessControlList.PolicySpec[key]
+	if !found || appPolicy.TrustDomain != spiffeID.TrustDomain || appPolicy.Namespace != spiffeID.Namespace {
+		return true
+	}
+
+	if !strings.HasPrefix(inputOperation, "/") {
+		inputOperation = "/" + inputOperation
+	}
+
+	if appProtocol == config.HTTPProtocol {
+		inputOperation = strings.ToLower(inputOperation)
+	}
+
+	// The requests are reserved from both rate limits and given back if either is exceeded,
+	// so that a rejected call doesn't consume the requests of the other limit.
+	now := time.Now()
+	var operationReservation *rate.Reservation
+	if appPolicy.AppOperationActions != nil {
+		operationPolicy := appPolicy.AppOperationActions.Search(inputOperation)
+		if operationPolicy != nil {
+			var ok bool
+			operationReservation, ok = reserveRequest(operationPolicy.RateLimiter, now)
+			if !ok {
+				return false
+			}
+		}
+	}
+
+	if _, ok := reserveRequest(appPolicy.RateLimiter, now); !ok {
+		if operationReservation != nil {
+			operationReservation.CancelAt(now)
+		}
+		return false
+	}
+	return true
+}
+
+// reserveRequest consumes a request from the rate limiter, if any, at the given time.
+// It returns false without consuming the request if the limit is exceeded.
+func reserveRequest(limiter *rate.Limiter, now time.Time) (*rate.Reservation, bool) {
+	if limiter == nil {
+		return nil, true
+	}
+	r := limiter.ReserveN(now, 1)
+	if !r.OK() {
+		return nil, false
+	}
+	if r.DelayFrom(now) > 0 {
+		r.CancelAt(now)
+		return nil, false
+	}
+	return r, true
+}
+
+// IsPubSubOperationAllowedByAccessControlPolicy determines if the pub/sub access control policies allow the app
+// to publish or subscribe to the topic of the pub/sub component.
+// Topics that don't match any policy of the app are allowed, and remain subject to the scopes of the component.
+func IsPubSubOperationAllowedByAccessControlPolicy(spiffeID *config.SpiffeID, pubsubName, topic, operation string, accessControlList *config.AccessControlList) bool {
+	if accessControlList == nil || spiffeID == nil {
+		return true
+	}
+
+	key := getKeyForAppID(spiffeID.AppID, spiffeID.Namespace)
+	appPolicy, found := accessControlList.PolicySpec[key]
+	if !found || appPolicy.TrustDomain != spiffeID.TrustDomain || appPolicy.Namespace != spiffeID.Namespace {
+		return true
+	}
+
+	if !strings.HasPrefix(topic, "/") {
+		topic = "/" + topic
+	}
+
+	// Policies for the pub/sub component take precedence over the ones for all the components
+	for _, name := range []string{pubsubName, "*"} {
+		topicPolicies, ok := appPolicy.PubSubActions[name]
+		if !ok {
+			continue
+		}
+		topicPolicy := topicPolicies.Search(topic)
+		if topicPolicy == nil {
+			continue
+		}
+		if action, ok := topicPolicy.VerbAction[operation]; ok {
+			return isActionAllowed(action)
+		}
+		if action, ok := topicPolicy.VerbAction["*"]; ok {
+			return isActionAllowed(action)
+		}
+		if topicPolicy.OperationAction != "" {
+			return isActionAllowed(topicPolicy.OperationAction)
+		}
+	}
+
+	return true
+}
+
+// newRateLimiter returns a rate limiter for the spec, or nil if the spec sets no limit.
+func newRateLimiter(spec config.RateLimitSpec) *rate.Limiter {
+	if spec.RequestsPerSecond <= 0 {
+		return nil
+	}
+	burst := spec.Burst
+	if burst <= 0 {
+		burst = spec.RequestsPerSecond
+	}
+	return rate.NewLimiter(rate.Limit(spec.RequestsPerSecond), burst)
+}
+
 func isActionAllowed(action string) bool {
 	return strings.EqualFold(action, config.AllowAccess)
 }
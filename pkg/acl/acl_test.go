@@ -691,6 +691,197 @@ func TestIsOperationAllowedByAccessControlPolicy(t *testing.T) {
 	})
 }
 
+func TestIsOperationWithinRateLimit(t *testing.T) {
+	spec := config.AccessControlSpec{
+		DefaultAction: config.AllowAccess,
+		TrustDomain:   "public",
+		AppPolicies: []config.AppPolicySpec{
+			{
+				AppName:       app1,
+				DefaultAction: config.AllowAccess,
+				TrustDomain:   "public",
+				Namespace:     "ns1",
+				RateLimit: config.RateLimitSpec{
+					RequestsPerSecond: 1,
+					Burst:             3,
+				},
+				AppOperationActions: []config.AppOperation{
+					{
+						Action:    config.AllowAccess,
+						Operation: "/op1",
+						RateLimit: config.RateLimitSpec{
+							RequestsPerSecond: 1,
+						},
+					},
+				},
+			},
+		},
+	}
+	spiffeID := &config.SpiffeID{
+		TrustDomain: "public",
+		Namespace:   "ns1",
+		AppID:       app1,
+	}
+
+	t.Run("test rate limiters are created from the spec", func(t *testing.T) {
+		accessControlList, err := ParseAccessControlSpec(spec, config.GRPCProtocol)
+		assert.NoError(t, err)
+		appPolicy := accessControlList.PolicySpec[app1Ns1]
+		assert.NotNil(t, appPolicy.RateLimiter)
+		assert.Equal(t, 3, appPolicy.RateLimiter.Burst())
+		operationPolicy := appPolicy.AppOperationActions.Search("/op1")
+		assert.NotNil(t, operationPolicy.RateLimiter)
+		assert.Equal(t, 1, operationPolicy.RateLimiter.Burst())
+	})
+
+	t.Run("test when no acl specified", func(t *testing.T) {
+		assert.True(t, IsOperationWithinRateLimit(spiffeID, app1, "op1", config.GRPCProtocol, nil))
+	})
+
+	t.Run("test when operation limit is exceeded", func(t *testing.T) {
+		accessControlList, _ := ParseAccessControlSpec(spec, config.GRPCProtocol)
+		assert.True(t, IsOperationWithinRateLimit(spiffeID, app1, "op1", config.GRPCProtocol, accessControlList))
+		assert.False(t, IsOperationWithinRateLimit(spiffeID, app1, "op1", config.GRPCProtocol, accessControlList))
+		// Other operations are only limited by the app limit
+		assert.True(t, IsOperationWithinRateLimit(spiffeID, app1, "op2", config.GRPCProtocol, accessControlList))
+	})
+
+	t.Run("test when app limit is exceeded", func(t *testing.T) {
+		accessControlList, _ := ParseAccessControlSpec(spec, config.GRPCProtocol)
+		for i := 0; i < 3; i++ {
+			assert.True(t, IsOperationWithinRateLimit(spiffeID, app1, "op2", config.GRPCProtocol, accessControlList))
+		}
+		assert.False(t, IsOperationWithinRateLimit(spiffeID, app1, "op2", config.GRPCProtocol, accessControlList))
+	})
+
+	t.Run("test when app limit is exceeded the operation limit is not consumed", func(t *testing.T) {
+		limitedSpec := spec
+		limitedSpec.AppPolicies = []config.AppPolicySpec{spec.AppPolicies[0]}
+		limitedSpec.AppPolicies[0].RateLimit = config.RateLimitSpec{RequestsPerSecond: 1}
+		accessControlList, _ := ParseAccessControlSpec(limitedSpec, config.GRPCProtocol)
+		assert.True(t, IsOperationWithinRateLimit(spiffeID, app1, "op2", config.GRPCProtocol, accessControlList))
+		assert.False(t, IsOperationWithinRateLimit(spiffeID, app1, "op1", config.GRPCProtocol, accessControlList))
+		operationPolicy := accessControlList.PolicySpec[app1Ns1].AppOperationActions.Search("/op1")
+		assert.True(t, operationPolicy.RateLimiter.Allow())
+	})
+
+	t.Run("test when namespace does not match", func(t *testing.T) {
+		accessControlList, _ := ParseAccessControlSpec(spec, config.GRPCProtocol)
+		otherID := &config.SpiffeID{
+			TrustDomain: "public",
+			Namespace:   "ns2",
+			AppID:       app1,
+		}
+		for i := 0; i < 5; i++ {
+			assert.True(t, IsOperationWithinRateLimit(otherID, app1, "op1", config.GRPCProtocol, accessControlList))
+		}
+	})
+}
+
+func TestIsPubSubOperationAllowedByAccessControlPolicy(t *testing.T) {
+	accessControlList, err := ParseAccessControlSpec(config.AccessControlSpec{
+		DefaultAction: config.DenyAccess,
+		TrustDomain:   "public",
+		AppPolicies: []config.AppPolicySpec{
+			{
+				AppName:     app1,
+				TrustDomain: "public",
+				Namespace:   "ns1",
+				PubSubActions: []config.PubSubAction{
+					{
+						PubSubName: "pubsub1",
+						Topic:      "orders",
+						Operations: []string{"publish"},
+						Action:     config.AllowAccess,
+					},
+					{
+						PubSubName: "pubsub1",
+						Topic:      "orders",
+						Operations: []string{"subscribe"},
+						Action:     config.DenyAccess,
+					},
+					{
+						PubSubName: "pubsub1",
+						Topic:      "audit/*",
+						Action:     config.DenyAccess,
+					},
+					{
+						Topic:      "secrets",
+						Operations: []string{"*"},
+						Action:     config.DenyAccess,
+					},
+				},
+			},
+		},
+	}, config.HTTPProtocol)
+	assert.NoError(t, err)
+
+	spiffeID := &config.SpiffeID{
+		TrustDomain: "public",
+		Namespace:   "ns1",
+		AppID:       app1,
+	}
+
+	t.Run("test when no acl specified", func(t *testing.T) {
+		assert.True(t, IsPubSubOperationAllowedByAccessControlPolicy(spiffeID, "pubsub1", "secrets", config.PubSubPublishOperation, nil))
+	})
+
+	t.Run("test actions for each operation", func(t *testing.T) {
+		assert.True(t, IsPubSubOperationAllowedByAccessControlPolicy(spiffeID, "pubsub1", "orders", config.PubSubPublishOperation, accessControlList))
+		assert.False(t, IsPubSubOperationAllowedByAccessControlPolicy(spiffeID, "pubsub1", "orders", config.PubSubSubscribeOperation, accessControlList))
+	})
+
+	t.Run("test action for all operations", func(t *testing.T) {
+		assert.False(t, IsPubSubOperationAllowedByAccessControlPolicy(spiffeID, "pubsub1", "audit/logins", config.PubSubPublishOperation, accessControlList))
+		assert.False(t, IsPubSubOperationAllowedByAccessControlPolicy(spiffeID, "pubsub1", "audit/logins", config.PubSubSubscribeOperation, accessControlList))
+	})
+
+	t.Run("test action for all pubsub components", func(t *testing.T) {
+		assert.False(t, IsPubSubOperationAllowedByAccessControlPolicy(spiffeID, "pubsub2", "secrets", config.PubSubSubscribeOperation, accessControlList))
+	})
+
+	t.Run("test when no topic policy matches", func(t *testing.T) {
+		// The global default action only applies to service invocation
+		assert.True(t, IsPubSubOperationAllowedByAccessControlPolicy(spiffeID, "pubsub2", "orders", config.PubSubSubscribeOperation, accessControlList))
+	})
+
+	t.Run("test when no topic policy matches with an app default action", func(t *testing.T) {
+		accessControlList, err := ParseAccessControlSpec(config.AccessControlSpec{
+			DefaultAction: config.DenyAccess,
+			TrustDomain:   "public",
+			AppPolicies: []config.AppPolicySpec{
+				{
+					AppName:       app1,
+					DefaultAction: config.DenyAccess,
+					TrustDomain:   "public",
+					Namespace:     "ns1",
+					PubSubActions: []config.PubSubAction{
+						{
+							PubSubName: "pubsub1",
+							Topic:      "orders",
+							Action:     config.DenyAccess,
+						},
+					},
+				},
+			},
+		}, config.HTTPProtocol)
+		assert.NoError(t, err)
+
+		// The default actions only apply to service invocation
+		assert.False(t, IsPubSubOperationAllowedByAccessControlPolicy(spiffeID, "pubsub1", "orders", config.PubSubPublishOperation, accessControlList))
+		assert.True(t, IsPubSubOperationAllowedByAccessControlPolicy(spiffeID, "pubsub1", "payments", config.PubSubPublishOperation, accessControlList))
+	})
+
+	t.Run("test when namespace does not match", func(t *testing.T) {
+		otherID := &config.SpiffeID{
+			TrustDomain: "public",
+			Namespace:   "ns2",
+			AppID:       app1,
+		}
+		assert.True(t, IsPubSubOperationAllowedByAccessControlPolicy(otherID, "pubsub1", "orders", config.PubSubSubscribeOperation, accessControlList))
+	})
+}
+
 func TestNormalizeOperation(t *testing.T) {
 	t.Run("normal path no slash", func(t *testing.T) {
 		p := "path"
@@ -54,6 +54,8 @@ type actor struct {
 	disposeCh chan struct{}
 
 	once sync.Once
+	// timersRestored guards the restoration of the persistent timers of the actor once activated.
+	timersRestored sync.Once
 }
 
 func newActor(actorType, actorID string, maxReentrancyDepth *int) *actor {
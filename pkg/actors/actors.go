@@ -15,12 +15,14 @@ package actors
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
 	nethttp "net/http"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -76,10 +78,13 @@ type Actors interface {
 	CreateReminder(ctx context.Context, req *CreateReminderRequest) error
 	DeleteReminder(ctx context.Context, req *DeleteReminderRequest) error
 	RenameReminder(ctx context.Context, req *RenameReminderRequest) error
+	ListReminders(ctx context.Context, req *ListRemindersRequest) (*ListRemindersResponse, error)
+	DeleteReminders(ctx context.Context, req *DeleteRemindersRequest) error
 	CreateTimer(ctx context.Context, req *CreateTimerRequest) error
 	DeleteTimer(ctx context.Context, req *DeleteTimerRequest) error
 	IsActorHosted(ctx context.Context, req *ActorHostedRequest) bool
 	GetActiveActorsCount(ctx context.Context) []ActiveActorsCount
+	GetPlacementStatus() PlacementStatus
 }
 
 type actorsRuntime struct {
@@ -92,6 +97,7 @@ type actorsRuntime struct {
 	actorsTable            *sync.Map
 	activeTimers           *sync.Map
 	activeTimersLock       *sync.RWMutex
+	persistentTimers       *sync.Map
 	activeReminders        *sync.Map
 	remindersLock          *sync.RWMutex
 	remindersMigrationLock *sync.Mutex
@@ -114,6 +120,12 @@ type ActiveActorsCount struct {
 	Count int    `json:"count"`
 }
 
+// PlacementStatus is the status of the connection to the placement service.
+type PlacementStatus struct {
+	Connected    bool   `json:"connected"`
+	TableVersion string `json:"tableVersion"`
+}
+
 // ActorMetadata represents information about the actor type.
 type ActorMetadata struct {
 	ID                string                 `json:"id"`
@@ -168,6 +180,7 @@ func NewActors(
 		actorsTable:            &sync.Map{},
 		activeTimers:           &sync.Map{},
 		activeTimersLock:       &sync.RWMutex{},
+		persistentTimers:       &sync.Map{},
 		activeReminders:        &sync.Map{},
 		remindersLock:          &sync.RWMutex{},
 		remindersMigrationLock: &sync.Mutex{},
@@ -212,7 +225,7 @@ func (a *actorsRuntime) Init() error {
 
 	a.placement = internal.NewActorPlacement(
 		a.config.PlacementAddresses, a.certChain,
-		a.config.AppID, hostname, a.config.HostedActorTypes,
+		a.config.AppID, a.config.Namespace, hostname, a.config.HostedActorTypes,
 		appHealthFn,
 		afterTableUpdateFn)
 
@@ -420,7 +433,13 @@ func (a *actorsRuntime) getOrCreateActor(actorType, actorID string) *actor {
 		val, _ = a.actorsTable.LoadOrStore(key, newActor(actorType, actorID, a.config.GetReentrancyForType(actorType).MaxStackDepth))
 	}
 
-	return val.(*actor)
+	act := val.(*actor)
+	// The persistent timers are restored before any call is dispatched to the actor activated on this host,
+	// so that the timers deleted or replaced by the actor are known to be persisted.
+	act.timersRestored.Do(func() {
+		a.restorePersistentTimers(actorType, actorID)
+	})
+	return act
 }
 
 func (a *actorsRuntime) callLocalActor(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
@@ -1145,6 +1164,23 @@ func (a *actorsRuntime) CreateReminder(ctx context.Context, req *CreateReminderR
 }
 
 func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest) error {
+	if req.Persistent && a.store == nil {
+		return errors.New("actors: state store does not exist or incorrectly configured")
+	}
+	if !req.Persistent {
+		// A non-persistent timer replaces any persistent timer with the same name.
+		timerKey := constructCompositeKey(req.ActorType, req.ActorID, req.Name)
+		if _, persisted := a.persistentTimers.LoadAndDelete(timerKey); persisted && a.store != nil {
+			if err := a.deletePersistentTimer(req.ActorType, req.ActorID, req.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return a.createTimer(ctx, req, req.Persistent)
+}
+
+// createTimer starts the timer and, if `persist` is set, saves it in the actor state store.
+func (a *actorsRuntime) createTimer(ctx context.Context, req *CreateTimerRequest, persist bool) error {
 	var (
 		err                 error
 		repeats             int
@@ -1195,10 +1231,31 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 		}
 	}
 
-	log.Debugf("create timer %q dueTime:%s period:%s repeats:%d ttl:%s",
-		req.Name, dueTime.String(), period.String(), repeats, ttl.String())
+	// Timers restored from the state store may be past their due time: the missed
+	// occurrences are skipped and the last one of them fires right away.
+	if years != 0 || months != 0 || days != 0 || period != 0 {
+		now := time.Now()
+		for (repeats < 0 || repeats > 1) && dueTime.AddDate(years, months, days).Add(period).Before(now) {
+			dueTime = dueTime.AddDate(years, months, days).Add(period)
+			if repeats > 0 {
+				repeats--
+			}
+		}
+	}
+
+	if persist {
+		if err = a.savePersistentTimer(req, dueTime, ttl); err != nil {
+			return errors.Wrap(err, "error saving persistent timer")
+		}
+	}
+
+	log.Debugf("create timer %q dueTime:%s period:%s repeats:%d ttl:%s persistent:%t",
+		req.Name, dueTime.String(), period.String(), repeats, ttl.String(), req.Persistent)
 	stop := make(chan bool, 1)
 	a.activeTimers.Store(timerKey, stop)
+	if req.Persistent {
+		a.persistentTimers.Store(timerKey, struct{}{})
+	}
 
 	go func(stop chan bool, req *CreateTimerRequest) {
 		var (
@@ -1268,6 +1325,122 @@ func (a *actorsRuntime) CreateTimer(ctx context.Context, req *CreateTimerRequest
 	return nil
 }
 
+func (a *actorsRuntime) persistentTimersKey(actorType, actorID string) string {
+	return constructCompositeKey("actors", actorType, actorID, "timers")
+}
+
+// getPersistentTimers returns the persistent timers of an actor stored in the actor state store.
+func (a *actorsRuntime) getPersistentTimers(actorType, actorID string) ([]CreateTimerRequest, *string, error) {
+	policy := a.resiliency.ComponentOutboundPolicy(context.Background(), a.storeName)
+	var resp *state.GetResponse
+	err := policy(func(ctx context.Context) (rErr error) {
+		resp, rErr = a.store.Get(&state.GetRequest{
+			Key: a.persistentTimersKey(actorType, actorID),
+		})
+		return rErr
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var timers []CreateTimerRequest
+	if len(resp.Data) > 0 {
+		if err = json.Unmarshal(resp.Data, &timers); err != nil {
+			return nil, nil, errors.Wrap(err, "error decoding persistent timers")
+		}
+	}
+	return timers, resp.ETag, nil
+}
+
+func (a *actorsRuntime) savePersistentTimers(actorType, actorID string, timers []CreateTimerRequest, etag *string) error {
+	key := a.persistentTimersKey(actorType, actorID)
+	policy := a.resiliency.ComponentOutboundPolicy(context.Background(), a.storeName)
+	return policy(func(ctx context.Context) error {
+		if len(timers) == 0 {
+			return a.store.Delete(&state.DeleteRequest{
+				Key:  key,
+				ETag: etag,
+			})
+		}
+		return a.store.Set(&state.SetRequest{
+			Key:   key,
+			Value: timers,
+			ETag:  etag,
+			Options: state.SetStateOption{
+				Concurrency: state.FirstWrite,
+			},
+		})
+	})
+}
+
+// savePersistentTimer stores the timer with absolute due time and TTL, so it can be restored later on any host.
+func (a *actorsRuntime) savePersistentTimer(req *CreateTimerRequest, dueTime, ttl time.Time) error {
+	timers, etag, err := a.getPersistentTimers(req.ActorType, req.ActorID)
+	if err != nil {
+		return err
+	}
+
+	timer := *req
+	timer.Persistent = true
+	timer.DueTime = dueTime.Format(time.RFC3339Nano)
+	if !ttl.IsZero() {
+		timer.TTL = ttl.Format(time.RFC3339Nano)
+	}
+
+	replaced := false
+	for i := range timers {
+		if timers[i].Name == req.Name {
+			timers[i] = timer
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		timers = append(timers, timer)
+	}
+	return a.savePersistentTimers(req.ActorType, req.ActorID, timers, etag)
+}
+
+// deletePersistentTimer removes the timer from the actor state store, if it was persisted.
+func (a *actorsRuntime) deletePersistentTimer(actorType, actorID, name string) error {
+	timers, etag, err := a.getPersistentTimers(actorType, actorID)
+	if err != nil {
+		return err
+	}
+
+	for i := range timers {
+		if timers[i].Name == name {
+			timers = append(timers[:i], timers[i+1:]...)
+			return a.savePersistentTimers(actorType, actorID, timers, etag)
+		}
+	}
+	return nil
+}
+
+// restorePersistentTimers starts the persistent timers of an actor that has just been activated.
+func (a *actorsRuntime) restorePersistentTimers(actorType, actorID string) {
+	if a.store == nil {
+		return
+	}
+
+	timers, _, err := a.getPersistentTimers(actorType, actorID)
+	if err != nil {
+		log.Errorf("error loading persistent timers for actor %s: %s", constructCompositeKey(actorType, actorID), err)
+		return
+	}
+
+	for i := range timers {
+		timer := timers[i]
+		log.Debugf("restoring persistent timer %s for actor type %s with id %s", timer.Name, actorType, actorID)
+		if err = a.createTimer(context.Background(), &timer, false); err != nil {
+			log.Warnf("error restoring persistent timer %s for actor type %s with id %s, removing it: %s", timer.Name, actorType, actorID, err)
+			if err = a.deletePersistentTimer(actorType, actorID, timer.Name); err != nil {
+				log.Errorf("error deleting persistent timer %s: %s", timer.Name, err)
+			}
+		}
+	}
+}
+
 func (a *actorsRuntime) executeTimer(actorType, actorID, name, dueTime, period, callback string, data interface{}) error {
 	t := TimerResponse{
 		Callback: callback,
@@ -1942,6 +2115,153 @@ func (a *actorsRuntime) GetReminder(ctx context.Context, req *GetReminderRequest
 	return nil, nil
 }
 
+// ListReminders returns a page of the reminders of an actor type, optionally filtered by actor ID.
+// Reminders are sorted by actor ID and name, so the continuation token stays valid while reminders change.
+func (a *actorsRuntime) ListReminders(ctx context.Context, req *ListRemindersRequest) (*ListRemindersResponse, error) {
+	if req.PageSize < 0 {
+		return nil, errors.New("page size must not be negative")
+	}
+
+	var after string
+	if req.ContinuationToken != "" {
+		b, err := base64.RawURLEncoding.DecodeString(req.ContinuationToken)
+		if err != nil {
+			return nil, errors.Errorf("invalid continuation token %q", req.ContinuationToken)
+		}
+		after = string(b)
+	}
+
+	reminderRefs, _, err := a.getRemindersForActorType(req.ActorType, false)
+	if err != nil {
+		return nil, err
+	}
+
+	reminders := make([]Reminder, 0, len(reminderRefs))
+	for _, r := range reminderRefs {
+		if req.ActorID != "" && r.reminder.ActorID != req.ActorID {
+			continue
+		}
+		if after != "" && constructCompositeKey(r.reminder.ActorID, r.reminder.Name) <= after {
+			continue
+		}
+		reminders = append(reminders, r.reminder)
+	}
+	sort.Slice(reminders, func(i, j int) bool {
+		return constructCompositeKey(reminders[i].ActorID, reminders[i].Name) < constructCompositeKey(reminders[j].ActorID, reminders[j].Name)
+	})
+
+	resp := &ListRemindersResponse{
+		Reminders: reminders,
+	}
+	if req.PageSize > 0 && len(reminders) > req.PageSize {
+		resp.Reminders = reminders[:req.PageSize]
+		last := resp.Reminders[req.PageSize-1]
+		resp.ContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(constructCompositeKey(last.ActorID, last.Name)))
+	}
+	return resp, nil
+}
+
+// DeleteReminders deletes all the reminders of an actor.
+func (a *actorsRuntime) DeleteReminders(ctx context.Context, req *DeleteRemindersRequest) error {
+	if a.store == nil {
+		return errors.New("actors: state store does not exist or incorrectly configured")
+	}
+
+	if a.evaluationBusy {
+		select {
+		case <-time.After(time.Second * 5):
+			return errors.New("error deleting reminders: timed out after 5s")
+		case <-a.evaluationChan:
+			break
+		}
+	}
+
+	var deleted []string
+	deleteFn := func(ctx context.Context) error {
+		reminders, actorMetadata, rErr := a.getRemindersForActorType(req.ActorType, false)
+		if rErr != nil {
+			return rErr
+		}
+
+		deleted = deleted[:0]
+		remaining := make([]actorReminderReference, 0, len(reminders))
+		remindersInPartition := map[uint32][]Reminder{}
+		updatedPartitions := map[uint32]struct{}{}
+		for _, r := range reminders {
+			if r.reminder.ActorID == req.ActorID {
+				deleted = append(deleted, r.reminder.Name)
+				updatedPartitions[r.actorRemindersPartitionID] = struct{}{}
+				continue
+			}
+			remaining = append(remaining, r)
+			remindersInPartition[r.actorRemindersPartitionID] = append(remindersInPartition[r.actorRemindersPartitionID], r.reminder)
+		}
+		if len(deleted) == 0 {
+			return nil
+		}
+
+		for partitionID := range updatedPartitions {
+			stateKey := actorMetadata.calculateRemindersStateKey(req.ActorType, partitionID)
+			databasePartitionKey := actorMetadata.calculateDatabasePartitionKey(stateKey)
+			rErr = a.saveRemindersInPartition(ctx, stateKey, remindersInPartition[partitionID], actorMetadata.calculateEtag(partitionID), databasePartitionKey)
+			if rErr != nil {
+				return rErr
+			}
+		}
+
+		// Save metadata to get a new eTag, as in DeleteReminder.
+		rErr = a.saveActorTypeMetadata(req.ActorType, actorMetadata)
+		if rErr != nil {
+			return rErr
+		}
+
+		a.remindersLock.Lock()
+		a.reminders[req.ActorType] = remaining
+		a.remindersLock.Unlock()
+		return nil
+	}
+
+	var err error
+	// TODO: Once Resiliency is no longer a preview feature, remove this check and just use resiliency.
+	if a.isResiliencyEnabled {
+		var policy resiliency.Runner
+		if !a.resiliency.PolicyDefined(a.storeName, resiliency.Component) {
+			policy = a.resiliency.BuiltInPolicy(ctx, resiliency.BuiltInActorReminderRetries)
+		} else {
+			noOp := resiliency.NoOp{}
+			policy = noOp.EndpointPolicy(ctx, "", "")
+		}
+		err = policy(deleteFn)
+	} else {
+		err = backoff.Retry(func() error {
+			return deleteFn(ctx)
+		}, backoff.NewExponentialBackOff())
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(deleted) == 0 {
+		return nil
+	}
+
+	actorKey := constructCompositeKey(req.ActorType, req.ActorID)
+	trackDeletes := make([]state.DeleteRequest, len(deleted))
+	for i, name := range deleted {
+		reminderKey := constructCompositeKey(actorKey, name)
+		if stop, exists := a.activeReminders.LoadAndDelete(reminderKey); exists {
+			close(stop.(chan bool))
+		}
+		trackDeletes[i] = state.DeleteRequest{Key: reminderKey}
+	}
+	log.Infof("deleted %d reminders of actor %s", len(deleted), actorKey)
+
+	policy := a.resiliency.ComponentOutboundPolicy(ctx, a.storeName)
+	return policy(func(ctx context.Context) error {
+		return a.store.BulkDelete(trackDeletes)
+	})
+}
+
 func (a *actorsRuntime) DeleteTimer(ctx context.Context, req *DeleteTimerRequest) error {
 	actorKey := constructCompositeKey(req.ActorType, req.ActorID)
 	timerKey := constructCompositeKey(actorKey, req.Name)
@@ -1952,6 +2272,10 @@ func (a *actorsRuntime) DeleteTimer(ctx context.Context, req *DeleteTimerRequest
 		a.activeTimers.Delete(timerKey)
 	}
 
+	// Only the timers created or restored as persistent are in the actor state store.
+	if _, persisted := a.persistentTimers.LoadAndDelete(timerKey); persisted && a.store != nil {
+		return a.deletePersistentTimer(req.ActorType, req.ActorID, req.Name)
+	}
 	return nil
 }
 
@@ -1974,6 +2298,18 @@ func (a *actorsRuntime) GetActiveActorsCount(ctx context.Context) []ActiveActors
 	return activeActorsCount
 }
 
+// GetPlacementStatus returns the status of the connection to the placement service.
+func (a *actorsRuntime) GetPlacementStatus() PlacementStatus {
+	if a.placement == nil {
+		return PlacementStatus{}
+	}
+	connected, version := a.placement.Status()
+	return PlacementStatus{
+		Connected:    connected,
+		TableVersion: version,
+	}
+}
+
 // Stop closes all network connections and resources used in actor runtime.
 func (a *actorsRuntime) Stop() {
 	if a.placement != nil {
@@ -218,6 +218,43 @@ func (_m *MockActors) GetReminder(ctx context.Context, req *GetReminderRequest)
 	return r0, r1
 }
 
+// ListReminders provides a mock function with given fields: req
+func (_m *MockActors) ListReminders(ctx context.Context, req *ListRemindersRequest) (*ListRemindersResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 *ListRemindersResponse
+	if rf, ok := ret.Get(0).(func(*ListRemindersRequest) *ListRemindersResponse); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListRemindersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ListRemindersRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteReminders provides a mock function with given fields: req
+func (_m *MockActors) DeleteReminders(ctx context.Context, req *DeleteRemindersRequest) error {
+	ret := _m.Called(req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*DeleteRemindersRequest) error); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetActiveActorsCount provides a mock function
 func (_m *MockActors) GetActiveActorsCount(ctx context.Context) []ActiveActorsCount {
 	_m.Called()
@@ -233,6 +270,20 @@ func (_m *MockActors) GetActiveActorsCount(ctx context.Context) []ActiveActorsCo
 	}
 }
 
+// GetPlacementStatus provides a mock function with given fields:
+func (_m *MockActors) GetPlacementStatus() PlacementStatus {
+	ret := _m.Called()
+
+	var r0 PlacementStatus
+	if rf, ok := ret.Get(0).(func() PlacementStatus); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(PlacementStatus)
+	}
+
+	return r0
+}
+
 type FailingActors struct {
 	Failure daprt.Failure
 }
@@ -276,6 +327,14 @@ func (f *FailingActors) RenameReminder(ctx context.Context, req *RenameReminderR
 	return nil
 }
 
+func (f *FailingActors) ListReminders(ctx context.Context, req *ListRemindersRequest) (*ListRemindersResponse, error) {
+	return &ListRemindersResponse{}, nil
+}
+
+func (f *FailingActors) DeleteReminders(ctx context.Context, req *DeleteRemindersRequest) error {
+	return nil
+}
+
 func (f *FailingActors) CreateTimer(ctx context.Context, req *CreateTimerRequest) error {
 	return nil
 }
@@ -291,3 +350,7 @@ func (f *FailingActors) IsActorHosted(ctx context.Context, req *ActorHostedReque
 func (f *FailingActors) GetActiveActorsCount(ctx context.Context) []ActiveActorsCount {
 	return []ActiveActorsCount{}
 }
+
+func (f *FailingActors) GetPlacementStatus() PlacementStatus {
+	return PlacementStatus{}
+}
@@ -16,6 +16,7 @@ package actors
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -723,6 +724,80 @@ func TestRenameReminder(t *testing.T) {
 	assert.Equal(t, "a", newReminder.Data)
 }
 
+func TestListReminders(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		reminder := createReminderData(actorID, actorType, fmt.Sprintf("reminder%d", i), "1s", "1s", "", "a")
+		err := testActorsRuntime.CreateReminder(ctx, &reminder)
+		assert.NoError(t, err)
+	}
+	otherReminder := createReminderData("otherActor", actorType, "reminder0", "1s", "1s", "", "a")
+	err := testActorsRuntime.CreateReminder(ctx, &otherReminder)
+	assert.NoError(t, err)
+
+	t.Run("list all reminders of an actor type", func(t *testing.T) {
+		resp, err := testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{ActorType: actorType})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Reminders, 6)
+		assert.Empty(t, resp.ContinuationToken)
+	})
+
+	t.Run("list reminders of a single actor in pages", func(t *testing.T) {
+		req := &ListRemindersRequest{ActorType: actorType, ActorID: actorID, PageSize: 2}
+		names := []string{}
+		for i := 0; i < 3; i++ {
+			resp, err := testActorsRuntime.ListReminders(ctx, req)
+			assert.NoError(t, err)
+			for _, r := range resp.Reminders {
+				assert.Equal(t, actorID, r.ActorID)
+				names = append(names, r.Name)
+			}
+			req.ContinuationToken = resp.ContinuationToken
+		}
+		assert.Empty(t, req.ContinuationToken)
+		assert.Equal(t, []string{"reminder0", "reminder1", "reminder2", "reminder3", "reminder4"}, names)
+	})
+
+	t.Run("invalid continuation token", func(t *testing.T) {
+		_, err := testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{ActorType: actorType, ContinuationToken: "%%%"})
+		assert.Error(t, err)
+	})
+
+	t.Run("negative page size", func(t *testing.T) {
+		_, err := testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{ActorType: actorType, PageSize: -1})
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteReminders(t *testing.T) {
+	testActorsRuntime := newTestActorsRuntime()
+	actorType, actorID := getTestActorTypeAndID()
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		reminder := createReminderData(actorID, actorType, fmt.Sprintf("reminder%d", i), "1s", "1s", "", "a")
+		err := testActorsRuntime.CreateReminder(ctx, &reminder)
+		assert.NoError(t, err)
+	}
+	otherReminder := createReminderData("otherActor", actorType, "reminder0", "1s", "1s", "", "a")
+	err := testActorsRuntime.CreateReminder(ctx, &otherReminder)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(testActorsRuntime.reminders[actorType]))
+
+	err = testActorsRuntime.DeleteReminders(ctx, &DeleteRemindersRequest{
+		ActorType: actorType,
+		ActorID:   actorID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(testActorsRuntime.reminders[actorType]))
+	assert.Equal(t, "otherActor", testActorsRuntime.reminders[actorType][0].reminder.ActorID)
+
+	resp, err := testActorsRuntime.ListReminders(ctx, &ListRemindersRequest{ActorType: actorType, ActorID: actorID})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Reminders)
+}
+
 func TestOverrideReminder(t *testing.T) {
 	ctx := context.Background()
 	t.Run("override data", func(t *testing.T) {
@@ -1189,6 +1264,148 @@ func TestDeleteTimer(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func TestPersistentTimer(t *testing.T) {
+	ctx := context.Background()
+	actorType, actorID := getTestActorTypeAndID()
+	actorKey := constructCompositeKey(actorType, actorID)
+
+	t.Run("persistent timer is stored and removed on delete", func(t *testing.T) {
+		testActorsRuntime := newTestActorsRuntime()
+		fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
+
+		timer := createTimerData(actorID, actorType, "timer1", "1h", "1h", "", "callback", "")
+		timer.Persistent = true
+		err := testActorsRuntime.CreateTimer(ctx, &timer)
+		assert.NoError(t, err)
+
+		timers, _, err := testActorsRuntime.getPersistentTimers(actorType, actorID)
+		assert.NoError(t, err)
+		assert.Len(t, timers, 1)
+		assert.Equal(t, "timer1", timers[0].Name)
+		assert.True(t, timers[0].Persistent)
+		_, err = time.Parse(time.RFC3339, timers[0].DueTime)
+		assert.NoError(t, err)
+
+		err = testActorsRuntime.DeleteTimer(ctx, &DeleteTimerRequest{
+			Name:      timer.Name,
+			ActorID:   actorID,
+			ActorType: actorType,
+		})
+		assert.NoError(t, err)
+
+		timers, _, err = testActorsRuntime.getPersistentTimers(actorType, actorID)
+		assert.NoError(t, err)
+		assert.Empty(t, timers)
+	})
+
+	t.Run("persistent timer requires a state store", func(t *testing.T) {
+		testActorsRuntime := newTestActorsRuntimeWithoutStore()
+		fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
+
+		timer := createTimerData(actorID, actorType, "timer1", "1h", "1h", "", "callback", "")
+		timer.Persistent = true
+		assert.Error(t, testActorsRuntime.CreateTimer(ctx, &timer))
+	})
+
+	t.Run("persistent timer is restored when the actor is activated", func(t *testing.T) {
+		testActorsRuntime := newTestActorsRuntime()
+		fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
+
+		timer := createTimerData(actorID, actorType, "timer1", "1h", "1h", "", "callback", "")
+		timer.Persistent = true
+		err := testActorsRuntime.CreateTimer(ctx, &timer)
+		assert.NoError(t, err)
+
+		// simulate the actor being deactivated and the timer being lost
+		timerKey := constructCompositeKey(actorKey, timer.Name)
+		testActorsRuntime.actorsTable.Delete(actorKey)
+		stopChan, _ := testActorsRuntime.activeTimers.LoadAndDelete(timerKey)
+		close(stopChan.(chan bool))
+
+		testActorsRuntime.getOrCreateActor(actorType, actorID)
+		_, ok := testActorsRuntime.activeTimers.Load(timerKey)
+		assert.True(t, ok)
+	})
+
+	t.Run("restored persistent timer is removed on delete", func(t *testing.T) {
+		testActorsRuntime := newTestActorsRuntime()
+		fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
+
+		timer := createTimerData(actorID, actorType, "timer1", "1h", "1h", "", "callback", "")
+		timer.Persistent = true
+		assert.NoError(t, testActorsRuntime.CreateTimer(ctx, &timer))
+
+		// simulate the actor being activated on another host
+		timerKey := constructCompositeKey(actorKey, timer.Name)
+		testActorsRuntime.actorsTable.Delete(actorKey)
+		testActorsRuntime.persistentTimers.Delete(timerKey)
+		stopChan, _ := testActorsRuntime.activeTimers.LoadAndDelete(timerKey)
+		close(stopChan.(chan bool))
+
+		testActorsRuntime.getOrCreateActor(actorType, actorID)
+		err := testActorsRuntime.DeleteTimer(ctx, &DeleteTimerRequest{
+			Name:      timer.Name,
+			ActorID:   actorID,
+			ActorType: actorType,
+		})
+		assert.NoError(t, err)
+
+		timers, _, err := testActorsRuntime.getPersistentTimers(actorType, actorID)
+		assert.NoError(t, err)
+		assert.Empty(t, timers)
+	})
+
+	t.Run("non persistent timer replaces persistent timer", func(t *testing.T) {
+		testActorsRuntime := newTestActorsRuntime()
+		fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
+
+		timer := createTimerData(actorID, actorType, "timer1", "1h", "1h", "", "callback", "")
+		timer.Persistent = true
+		assert.NoError(t, testActorsRuntime.CreateTimer(ctx, &timer))
+
+		timer.Persistent = false
+		assert.NoError(t, testActorsRuntime.CreateTimer(ctx, &timer))
+
+		timers, _, err := testActorsRuntime.getPersistentTimers(actorType, actorID)
+		assert.NoError(t, err)
+		assert.Empty(t, timers)
+	})
+
+	t.Run("non persistent timer doesn't use the state store", func(t *testing.T) {
+		testActorsRuntime := newTestActorsRuntime()
+		testActorsRuntime.store = &unavailableStateStore{}
+		fakeCallAndActivateActor(testActorsRuntime, actorType, actorID)
+
+		timer := createTimerData(actorID, actorType, "timer1", "1h", "1h", "", "callback", "")
+		assert.NoError(t, testActorsRuntime.CreateTimer(ctx, &timer))
+		assert.NoError(t, testActorsRuntime.CreateTimer(ctx, &timer))
+
+		err := testActorsRuntime.DeleteTimer(ctx, &DeleteTimerRequest{
+			Name:      timer.Name,
+			ActorID:   actorID,
+			ActorType: actorType,
+		})
+		assert.NoError(t, err)
+	})
+}
+
+// unavailableStateStore fails all the state operations.
+type unavailableStateStore struct {
+	state.Store
+}
+
+func (s *unavailableStateStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	return nil, errors.New("state store unavailable")
+}
+
+func (s *unavailableStateStore) Set(req *state.SetRequest) error {
+	return errors.New("state store unavailable")
+}
+
+func (s *unavailableStateStore) Delete(req *state.DeleteRequest) error {
+	return errors.New("state store unavailable")
+}
+
 func TestOverrideTimerCancelsActiveTimers(t *testing.T) {
 	ctx := context.Background()
 	t.Run("override data", func(t *testing.T) {
@@ -23,4 +23,6 @@ type CreateTimerRequest struct {
 	TTL       string      `json:"ttl"`
 	Callback  string      `json:"callback"`
 	Data      interface{} `json:"data"`
+	// Persistent timers are saved in the actor state store and restored when the actor is activated again.
+	Persistent bool `json:"persistent,omitempty"`
 }
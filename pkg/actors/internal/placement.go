@@ -52,6 +52,8 @@ const (
 type ActorPlacement struct {
 	actorTypes []string
 	appID      string
+	// namespace is the namespace of the runtime. Actor types are isolated per namespace.
+	namespace string
 	// runtimeHostname is the address and port of the runtime
 	runtimeHostName string
 
@@ -116,13 +118,14 @@ func addDNSResolverPrefix(addr []string) []string {
 // NewActorPlacement initializes ActorPlacement for the actor service.
 func NewActorPlacement(
 	serverAddr []string, clientCert *dapr_credentials.CertChain,
-	appID, runtimeHostName string, actorTypes []string,
+	appID, namespace, runtimeHostName string, actorTypes []string,
 	appHealthFn func() bool,
 	afterTableUpdateFn func(),
 ) *ActorPlacement {
 	return &ActorPlacement{
 		actorTypes:      actorTypes,
 		appID:           appID,
+		namespace:       namespace,
 		runtimeHostName: runtimeHostName,
 		serverAddr:      addDNSResolverPrefix(serverAddr),
 
@@ -251,10 +254,11 @@ func (p *ActorPlacement) Start() {
 			}
 
 			host := v1pb.Host{
-				Name:     p.runtimeHostName,
-				Entities: p.actorTypes,
-				Id:       p.appID,
-				Load:     1, // Not used yet
+				Name:      p.runtimeHostName,
+				Entities:  p.actorTypes,
+				Id:        p.appID,
+				Namespace: p.namespace,
+				Load:      1, // Not used yet
 				// Port is redundant because Name should include port number
 			}
 
@@ -434,6 +438,7 @@ func (p *ActorPlacement) updatePlacements(in *v1pb.PlacementTables) {
 			loadMap := map[string]*hashing.Host{}
 			for lk, lv := range v.LoadMap {
 				loadMap[lk] = hashing.NewHost(lv.Name, lv.Id, lv.Load, lv.Port)
+				loadMap[lk].Namespace = lv.Namespace
 			}
 			tables.Entries[k] = hashing.NewFromExisting(v.Hosts, v.SortedSet, loadMap)
 		}
@@ -453,6 +458,18 @@ func (p *ActorPlacement) updatePlacements(in *v1pb.PlacementTables) {
 	log.Infof("placement tables updated, version: %s", in.GetVersion())
 }
 
+// Status returns whether the stream to the placement service is connected,
+// and the version of the placement tables.
+func (p *ActorPlacement) Status() (bool, string) {
+	p.streamConnectedCond.L.Lock()
+	connected := p.streamConnAlive
+	p.streamConnectedCond.L.Unlock()
+
+	p.placementTableLock.RLock()
+	defer p.placementTableLock.RUnlock()
+	return connected, p.placementTables.Version
+}
+
 // WaitUntilPlacementTableIsReady waits until placement table is until table lock is unlocked.
 func (p *ActorPlacement) WaitUntilPlacementTableIsReady() {
 	if p.tableIsBlocked.Load() {
@@ -461,6 +478,7 @@ func (p *ActorPlacement) WaitUntilPlacementTableIsReady() {
 }
 
 // LookupActor resolves to actor service instance address using consistent hashing table.
+// Only the hosts in the namespace of the runtime are considered.
 func (p *ActorPlacement) LookupActor(actorType, actorID string) (string, string) {
 	p.placementTableLock.RLock()
 	defer p.placementTableLock.RUnlock()
@@ -477,5 +495,10 @@ func (p *ActorPlacement) LookupActor(actorType, actorID string) (string, string)
 	if err != nil || host == nil {
 		return "", ""
 	}
+	// Placement disseminates the tables of the runtime namespace only. Hosts without namespace
+	// are reported by placement services which do not support namespace isolation.
+	if host.Namespace != "" && host.Namespace != p.namespace {
+		return "", ""
+	}
 	return host.Name, host.AppID
 }
@@ -69,7 +69,7 @@ func TestPlacementStream_RoundRobin(t *testing.T) {
 	noopTableUpdateFunc := func() {}
 
 	testPlacement := NewActorPlacement(
-		address, nil, "testAppID", "127.0.0.1:1000", []string{"actorOne", "actorTwo"},
+		address, nil, "testAppID", "", "127.0.0.1:1000", []string{"actorOne", "actorTwo"},
 		appHealthFunc, noopTableUpdateFunc)
 
 	t.Run("found leader placement in a round robin way", func(t *testing.T) {
@@ -121,7 +121,7 @@ func TestAppHealthyStatus(t *testing.T) {
 	appHealthFunc := appHealth.Load
 	noopTableUpdateFunc := func() {}
 	testPlacement := NewActorPlacement(
-		[]string{address}, nil, "testAppID", "127.0.0.1:1000", []string{"actorOne", "actorTwo"},
+		[]string{address}, nil, "testAppID", "", "127.0.0.1:1000", []string{"actorOne", "actorTwo"},
 		appHealthFunc, noopTableUpdateFunc)
 
 	// act
@@ -148,7 +148,7 @@ func TestOnPlacementOrder(t *testing.T) {
 	tableUpdateFunc := func() { tableUpdateCount++ }
 	testPlacement := NewActorPlacement(
 		[]string{}, nil,
-		"testAppID", "127.0.0.1:1000",
+		"testAppID", "", "127.0.0.1:1000",
 		[]string{"actorOne", "actorTwo"},
 		appHealthFunc, tableUpdateFunc)
 
@@ -182,6 +182,10 @@ func TestOnPlacementOrder(t *testing.T) {
 		})
 
 		assert.Equal(t, 1, tableUpdateCount)
+
+		connected, version := testPlacement.Status()
+		assert.False(t, connected)
+		assert.Equal(t, tableVersion, version)
 	})
 
 	t.Run("unlock operation", func(t *testing.T) {
@@ -197,7 +201,7 @@ func TestWaitUntilPlacementTableIsReady(t *testing.T) {
 	tableUpdateFunc := func() {}
 	testPlacement := NewActorPlacement(
 		[]string{}, nil,
-		"testAppID", "127.0.0.1:1000",
+		"testAppID", "", "127.0.0.1:1000",
 		[]string{"actorOne", "actorTwo"},
 		appHealthFunc, tableUpdateFunc)
 
@@ -226,7 +230,7 @@ func TestLookupActor(t *testing.T) {
 	tableUpdateFunc := func() {}
 	testPlacement := NewActorPlacement(
 		[]string{}, nil,
-		"testAppID", "127.0.0.1:1000",
+		"testAppID", "", "127.0.0.1:1000",
 		[]string{"actorOne", "actorTwo"},
 		appHealthFunc, tableUpdateFunc)
 
@@ -259,6 +263,19 @@ func TestLookupActor(t *testing.T) {
 		assert.Empty(t, name)
 		assert.Empty(t, appID)
 	})
+
+	t.Run("host in another namespace", func(t *testing.T) {
+		const testActorType = "actorTwo"
+		actorTwoHashing := hashing.NewConsistentHash()
+		actorTwoHashing.Add("127.0.0.1:2000", "otherAppID", 0)
+		host, _ := actorTwoHashing.GetHost("id0")
+		host.Namespace = "otherNamespace"
+		testPlacement.placementTables.Entries[testActorType] = actorTwoHashing
+
+		name, appID := testPlacement.LookupActor(testActorType, "id0")
+		assert.Empty(t, name)
+		assert.Empty(t, appID)
+	})
 }
 
 func TestConcurrentUnblockPlacements(t *testing.T) {
@@ -266,7 +283,7 @@ func TestConcurrentUnblockPlacements(t *testing.T) {
 	tableUpdateFunc := func() {}
 	testPlacement := NewActorPlacement(
 		[]string{}, nil,
-		"testAppID", "127.0.0.1:1000",
+		"testAppID", "", "127.0.0.1:1000",
 		[]string{"actorOne", "actorTwo"},
 		appHealthFunc, tableUpdateFunc)
 
@@ -38,6 +38,10 @@ type ConfigurationSpec struct {
 	// +optional
 	HTTPPipelineSpec PipelineSpec `json:"httpPipeline,omitempty"`
 	// +optional
+	GRPCPipelineSpec PipelineSpec `json:"grpcPipeline,omitempty"`
+	// +optional
+	AppHTTPPipelineSpec PipelineSpec `json:"appHttpPipeline,omitempty"`
+	// +optional
 	TracingSpec TracingSpec `json:"tracing,omitempty"`
 	// +kubebuilder:default={enabled:true}
 	MetricSpec MetricSpec `json:"metric,omitempty"`
@@ -126,6 +130,8 @@ type SelectorField struct {
 type TracingSpec struct {
 	SamplingRate string     `json:"samplingRate"`
 	Zipkin       ZipkinSpec `json:"zipkin"`
+	// +optional
+	Otel OtelSpec `json:"otel,omitempty"`
 }
 
 // ZipkinSpec defines Zipkin trace configurations.
@@ -133,9 +139,47 @@ type ZipkinSpec struct {
 	EndpointAddresss string `json:"endpointAddress"`
 }
 
+// OtelSpec defines OpenTelemetry (OTLP) trace exporter configurations.
+type OtelSpec struct {
+	EndpointAddress string `json:"endpointAddress"`
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+	// +optional
+	Headers string `json:"headers,omitempty"`
+}
+
 // MetricSpec defines metrics configuration.
 type MetricSpec struct {
 	Enabled bool `json:"enabled"`
+	// +optional
+	HTTP *MetricHTTP `json:"http,omitempty"`
+	// +optional
+	Otel *MetricOtelSpec `json:"otel,omitempty"`
+}
+
+// MetricOtelSpec defines OpenTelemetry (OTLP) metrics exporter configurations.
+type MetricOtelSpec struct {
+	EndpointAddress string `json:"endpointAddress"`
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+	// +optional
+	Headers string `json:"headers,omitempty"`
+	// +optional
+	Interval string `json:"interval,omitempty"`
+}
+
+// MetricHTTP defines the labels of the HTTP metrics.
+type MetricHTTP struct {
+	// +optional
+	PathTemplates []string `json:"pathTemplates,omitempty"`
+	// +optional
+	ExcludePath bool `json:"excludePath,omitempty"`
+	// +optional
+	MaxPathCardinality int `json:"maxPathCardinality,omitempty"`
 }
 
 // AppPolicySpec defines the policy data structure for each app.
@@ -149,6 +193,21 @@ type AppPolicySpec struct {
 	Namespace string `json:"namespace" yaml:"namespace"`
 	// +optional
 	AppOperationActions []AppOperationAction `json:"operations" yaml:"operations"`
+	// +optional
+	RateLimit RateLimitSpec `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
+	// +optional
+	PubSubActions []PubSubAction `json:"pubsub,omitempty" yaml:"pubsub,omitempty"`
+}
+
+// PubSubAction defines the action for publishing or subscribing to the topics of a pub/sub component.
+type PubSubAction struct {
+	// +optional
+	PubSubName string `json:"pubsubName" yaml:"pubsubName"`
+	// +optional
+	Topic string `json:"topic" yaml:"topic"`
+	// +optional
+	Operations []string `json:"operations" yaml:"operations"`
+	Action     string   `json:"action" yaml:"action"`
 }
 
 // AppOperationAction defines the data structure for each app operation.
@@ -157,6 +216,16 @@ type AppOperationAction struct {
 	// +optional
 	HTTPVerb []string `json:"httpVerb" yaml:"httpVerb"`
 	Action   string   `json:"action" yaml:"action"`
+	// +optional
+	RateLimit RateLimitSpec `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
+}
+
+// RateLimitSpec defines the rate limit of the requests of a calling app.
+type RateLimitSpec struct {
+	// +optional
+	RequestsPerSecond int `json:"requestsPerSecond,omitempty" yaml:"requestsPerSecond,omitempty"`
+	// +optional
+	Burst int `json:"burst,omitempty" yaml:"burst,omitempty"`
 }
 
 // AccessControlSpec is the spec object in ConfigurationSpec.
@@ -90,6 +90,7 @@ func (in *AppOperationAction) DeepCopyInto(out *AppOperationAction) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	out.RateLimit = in.RateLimit
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppOperationAction.
@@ -112,6 +113,14 @@ func (in *AppPolicySpec) DeepCopyInto(out *AppPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	out.RateLimit = in.RateLimit
+	if in.PubSubActions != nil {
+		in, out := &in.PubSubActions, &out.PubSubActions
+		*out = make([]PubSubAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppPolicySpec.
@@ -186,8 +195,10 @@ func (in *ConfigurationList) DeepCopyObject() runtime.Object {
 func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 	*out = *in
 	in.HTTPPipelineSpec.DeepCopyInto(&out.HTTPPipelineSpec)
+	in.GRPCPipelineSpec.DeepCopyInto(&out.GRPCPipelineSpec)
+	in.AppHTTPPipelineSpec.DeepCopyInto(&out.AppHTTPPipelineSpec)
 	out.TracingSpec = in.TracingSpec
-	out.MetricSpec = in.MetricSpec
+	in.MetricSpec.DeepCopyInto(&out.MetricSpec)
 	out.MTLSSpec = in.MTLSSpec
 	in.Secrets.DeepCopyInto(&out.Secrets)
 	in.AccessControlSpec.DeepCopyInto(&out.AccessControlSpec)
@@ -272,9 +283,54 @@ func (in *MTLSSpec) DeepCopy() *MTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricHTTP) DeepCopyInto(out *MetricHTTP) {
+	*out = *in
+	if in.PathTemplates != nil {
+		in, out := &in.PathTemplates, &out.PathTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricHTTP.
+func (in *MetricHTTP) DeepCopy() *MetricHTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricHTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricOtelSpec) DeepCopyInto(out *MetricOtelSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricOtelSpec.
+func (in *MetricOtelSpec) DeepCopy() *MetricOtelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricOtelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricSpec) DeepCopyInto(out *MetricSpec) {
 	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(MetricHTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Otel != nil {
+		in, out := &in.Otel, &out.Otel
+		*out = new(MetricOtelSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricSpec.
@@ -303,6 +359,21 @@ func (in *NameResolutionSpec) DeepCopy() *NameResolutionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OtelSpec) DeepCopyInto(out *OtelSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OtelSpec.
+func (in *OtelSpec) DeepCopy() *OtelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OtelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 	*out = *in
@@ -325,6 +396,41 @@ func (in *PipelineSpec) DeepCopy() *PipelineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PubSubAction) DeepCopyInto(out *PubSubAction) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PubSubAction.
+func (in *PubSubAction) DeepCopy() *PubSubAction {
+	if in == nil {
+		return nil
+	}
+	out := new(PubSubAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitSpec) DeepCopyInto(out *RateLimitSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSpec.
+func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretsScope) DeepCopyInto(out *SecretsScope) {
 	*out = *in
@@ -411,6 +517,7 @@ func (in *SelectorSpec) DeepCopy() *SelectorSpec {
 func (in *TracingSpec) DeepCopyInto(out *TracingSpec) {
 	*out = *in
 	out.Zipkin = in.Zipkin
+	out.Otel = in.Otel
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingSpec.
@@ -0,0 +1,11 @@
+// ------------------------------------------------------------
+// Copyright (c) Microsoft Corporation and Dapr Contributors.
+// Licensed under the MIT License.
+// ------------------------------------------------------------
+
+package httpendpoint
+
+const (
+	// GroupName is the API group name.
+	GroupName = "dapr.io"
+)
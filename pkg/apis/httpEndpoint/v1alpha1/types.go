@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+
+// HTTPEndpoint describes an external, non-Dapr HTTP endpoint that can be called with service invocation.
+// The endpoint is invoked by name, like an app by ID: it shadows the app with the same ID in the namespace of the caller.
+type HTTPEndpoint struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec HTTPEndpointSpec `json:"spec,omitempty"`
+	// +optional
+	Auth `json:"auth,omitempty"`
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// HTTPEndpointSpec is the spec for an HTTP endpoint.
+type HTTPEndpointSpec struct {
+	BaseURL string `json:"baseUrl"`
+	// +optional
+	Headers []Header `json:"headers,omitempty"`
+	// +optional
+	ClientTLS *TLS `json:"clientTLS,omitempty"`
+}
+
+// Header is a name/value pair for a header added to every request sent to the endpoint.
+type Header struct {
+	Name string `json:"name"`
+	// +optional
+	Value string `json:"value,omitempty"`
+	// +optional
+	SecretKeyRef SecretKeyRef `json:"secretKeyRef,omitempty"`
+}
+
+// TLS holds the TLS settings used to connect to the endpoint.
+type TLS struct {
+	// +optional
+	RootCA *TLSDocument `json:"rootCA,omitempty"`
+	// +optional
+	Certificate *TLSDocument `json:"certificate,omitempty"`
+	// +optional
+	PrivateKey *TLSDocument `json:"privateKey,omitempty"`
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// TLSDocument is a PEM encoded document, either given inline or held by a secret.
+type TLSDocument struct {
+	// +optional
+	Value string `json:"value,omitempty"`
+	// +optional
+	SecretKeyRef SecretKeyRef `json:"secretKeyRef,omitempty"`
+}
+
+// SecretKeyRef is a reference to a secret holding a value. Name is the secret name, and key is the field in the secret.
+type SecretKeyRef struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// Auth represents authentication details for the HTTP endpoint.
+type Auth struct {
+	SecretStore string `json:"secretStore"`
+}
+
+// +kubebuilder:object:root=true
+
+// HTTPEndpointList is a list of Dapr HTTP endpoints.
+type HTTPEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []HTTPEndpoint `json:"items"`
+}
@@ -40,6 +40,7 @@ type Policies struct {
 	Timeouts        map[string]string         `json:"timeouts,omitempty" yaml:"timeouts,omitempty"`
 	Retries         map[string]Retry          `json:"retries,omitempty" yaml:"retries,omitempty"`
 	CircuitBreakers map[string]CircuitBreaker `json:"circuitBreakers,omitempty" yaml:"circuitBreakers,omitempty"`
+	Bulkheads       map[string]Bulkhead       `json:"bulkheads,omitempty" yaml:"bulkheads,omitempty"`
 }
 
 type Retry struct {
@@ -47,6 +48,18 @@ type Retry struct {
 	Duration    string `json:"duration,omitempty" yaml:"duration,omitempty"`
 	MaxInterval string `json:"maxInterval,omitempty" yaml:"maxInterval,omitempty"`
 	MaxRetries  int    `json:"maxRetries,omitempty" yaml:"maxRetries,omitempty"`
+	// Jitter is the randomization factor applied to the intervals of the exponential policy, between 0 and 1.
+	Jitter   string        `json:"jitter,omitempty" yaml:"jitter,omitempty"`
+	Matching RetryMatching `json:"matching,omitempty" yaml:"matching,omitempty"`
+}
+
+// RetryMatching contains the comma-separated lists of HTTP status codes (or ranges such as 500-599)
+// and gRPC codes that are retriable or permanent.
+type RetryMatching struct {
+	HTTPStatusCodes          string `json:"httpStatusCodes,omitempty" yaml:"httpStatusCodes,omitempty"`
+	GRPCStatusCodes          string `json:"gRPCStatusCodes,omitempty" yaml:"gRPCStatusCodes,omitempty"`
+	PermanentHTTPStatusCodes string `json:"permanentHTTPStatusCodes,omitempty" yaml:"permanentHTTPStatusCodes,omitempty"`
+	PermanentGRPCStatusCodes string `json:"permanentGRPCStatusCodes,omitempty" yaml:"permanentGRPCStatusCodes,omitempty"`
 }
 
 type CircuitBreaker struct {
@@ -56,6 +69,11 @@ type CircuitBreaker struct {
 	Trip        string `json:"trip,omitempty" yaml:"trip,omitempty"`
 }
 
+type Bulkhead struct {
+	MaxConcurrent int    `json:"maxConcurrent,omitempty" yaml:"maxConcurrent,omitempty"`
+	MaxQueueWait  string `json:"maxQueueWait,omitempty" yaml:"maxQueueWait,omitempty"`
+}
+
 type Targets struct {
 	Apps       map[string]EndpointPolicyNames  `json:"apps,omitempty" yaml:"apps,omitempty"`
 	Actors     map[string]ActorPolicyNames     `json:"actors,omitempty" yaml:"actors,omitempty"`
@@ -71,6 +89,7 @@ type PolicyNames struct {
 	Timeout        string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 	Retry          string `json:"retry,omitempty" yaml:"retry,omitempty"`
 	CircuitBreaker string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
+	Bulkhead       string `json:"bulkhead,omitempty" yaml:"bulkhead,omitempty"`
 }
 
 type EndpointPolicyNames struct {
@@ -78,6 +97,7 @@ type EndpointPolicyNames struct {
 	Retry                   string `json:"retry,omitempty" yaml:"retry,omitempty"`
 	CircuitBreaker          string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
 	CircuitBreakerCacheSize int    `json:"circuitBreakerCacheSize,omitempty" yaml:"circuitBreakerCacheSize,omitempty"`
+	Bulkhead                string `json:"bulkhead,omitempty" yaml:"bulkhead,omitempty"`
 }
 
 type ActorPolicyNames struct {
@@ -86,6 +106,7 @@ type ActorPolicyNames struct {
 	CircuitBreaker          string `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
 	CircuitBreakerScope     string `json:"circuitBreakerScope,omitempty" yaml:"circuitBreakerScope,omitempty"`
 	CircuitBreakerCacheSize int    `json:"circuitBreakerCacheSize,omitempty" yaml:"circuitBreakerCacheSize,omitempty"`
+	Bulkhead                string `json:"bulkhead,omitempty" yaml:"bulkhead,omitempty"`
 }
 
 // ResiliencyList represents a list of `Resiliency` items.
@@ -40,6 +40,21 @@ func (in *ActorPolicyNames) DeepCopy() *ActorPolicyNames {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Bulkhead) DeepCopyInto(out *Bulkhead) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bulkhead.
+func (in *Bulkhead) DeepCopy() *Bulkhead {
+	if in == nil {
+		return nil
+	}
+	out := new(Bulkhead)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
 	*out = *in
@@ -111,6 +126,13 @@ func (in *Policies) DeepCopyInto(out *Policies) {
 			(*out)[key] = val
 		}
 	}
+	if in.Bulkheads != nil {
+		in, out := &in.Bulkheads, &out.Bulkheads
+		*out = make(map[string]Bulkhead, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policies.
@@ -221,6 +243,7 @@ func (in *ResiliencySpec) DeepCopy() *ResiliencySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Retry) DeepCopyInto(out *Retry) {
 	*out = *in
+	out.Matching = in.Matching
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
@@ -233,6 +256,21 @@ func (in *Retry) DeepCopy() *Retry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryMatching) DeepCopyInto(out *RetryMatching) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryMatching.
+func (in *RetryMatching) DeepCopy() *RetryMatching {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryMatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Targets) DeepCopyInto(out *Targets) {
 	*out = *in
@@ -45,6 +45,18 @@ type SubscriptionSpec struct {
 	Routes Routes `json:"routes"`
 	// The optional dead letter queue for this topic to send events to.
 	DeadLetterTopic string `json:"deadLetterTopic,omitempty"`
+	// The option to enable bulk subscription for this topic.
+	// +optional
+	BulkSubscribe BulkSubscribe `json:"bulkSubscribe,omitempty"`
+}
+
+// BulkSubscribe encapsulates the bulk subscription configuration for a topic.
+type BulkSubscribe struct {
+	Enabled bool `json:"enabled"`
+	// +optional
+	MaxMessagesCount int32 `json:"maxMessagesCount,omitempty"`
+	// +optional
+	MaxAwaitDurationMs int32 `json:"maxAwaitDurationMs,omitempty"`
 }
 
 // Routes encapsulates the rules and optional default path for a topic.
@@ -25,6 +25,21 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BulkSubscribe) DeepCopyInto(out *BulkSubscribe) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BulkSubscribe.
+func (in *BulkSubscribe) DeepCopy() *BulkSubscribe {
+	if in == nil {
+		return nil
+	}
+	out := new(BulkSubscribe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Routes) DeepCopyInto(out *Routes) {
 	*out = *in
@@ -134,6 +149,7 @@ func (in *SubscriptionSpec) DeepCopyInto(out *SubscriptionSpec) {
 		}
 	}
 	in.Routes.DeepCopyInto(&out.Routes)
+	out.BulkSubscribe = in.BulkSubscribe
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionSpec.
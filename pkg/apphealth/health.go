@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apphealth
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dapr/kit/logger"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+)
+
+const (
+	// DefaultHealthCheckPath is the default path of the HTTP health check endpoint of the app.
+	DefaultHealthCheckPath = "/healthz"
+	// DefaultProbeInterval is the default interval between two probes.
+	DefaultProbeInterval = 5 * time.Second
+	// DefaultProbeTimeout is the default timeout of a probe.
+	DefaultProbeTimeout = 500 * time.Millisecond
+	// DefaultThreshold is the default number of consecutive failed probes after which the app is considered unhealthy.
+	DefaultThreshold = 3
+
+	// StatusHealthy is the status of an app that responds successfully to the probes.
+	StatusHealthy = "healthy"
+	// StatusUnhealthy is the status of an app that failed too many consecutive probes.
+	StatusUnhealthy = "unhealthy"
+)
+
+var log = logger.NewLogger("dapr.apphealth")
+
+// Config holds the settings of the app health checks.
+type Config struct {
+	// Path of the health check endpoint, for HTTP apps.
+	Path          string
+	ProbeInterval time.Duration
+	ProbeTimeout  time.Duration
+	Threshold     int32
+}
+
+// ProbeFunction probes the app. It returns true if the app is healthy.
+type ProbeFunction func(ctx context.Context) (bool, error)
+
+// ChangeCallback is invoked when the health status of the app changes.
+type ChangeCallback func(healthy bool)
+
+// AppHealth periodically probes the app and keeps track of its health status.
+// The app is considered unhealthy until the first successful probe.
+type AppHealth struct {
+	config   Config
+	probeFn  ProbeFunction
+	changeCb ChangeCallback
+
+	healthy      atomic.Value
+	failureCount int32
+	lock         sync.Mutex
+}
+
+// NewAppHealth returns a new AppHealth that probes the app with probeFn.
+func NewAppHealth(config Config, probeFn ProbeFunction) *AppHealth {
+	if config.ProbeInterval <= 0 {
+		config.ProbeInterval = DefaultProbeInterval
+	}
+	if config.ProbeTimeout <= 0 {
+		config.ProbeTimeout = DefaultProbeTimeout
+	}
+	if config.Threshold <= 0 {
+		config.Threshold = DefaultThreshold
+	}
+
+	h := &AppHealth{
+		config:  config,
+		probeFn: probeFn,
+	}
+	h.healthy.Store(false)
+	return h
+}
+
+// Config returns the settings of the health checks.
+func (h *AppHealth) Config() Config {
+	return h.config
+}
+
+// OnHealthChange sets the callback invoked when the health status of the app changes.
+// It must be set before the probes are started.
+func (h *AppHealth) OnHealthChange(cb ChangeCallback) {
+	h.changeCb = cb
+}
+
+// StartProbes probes the app at every interval until ctx is canceled.
+// The first probe is sent immediately.
+func (h *AppHealth) StartProbes(ctx context.Context) {
+	log.Infof("app health checks started: probing every %v", h.config.ProbeInterval)
+
+	go func() {
+		ticker := time.NewTicker(h.config.ProbeInterval)
+		defer ticker.Stop()
+
+		for {
+			h.probe(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// IsHealthy returns true if the app is currently healthy.
+func (h *AppHealth) IsHealthy() bool {
+	return h.healthy.Load().(bool)
+}
+
+// Status returns the current health status of the app: StatusHealthy or StatusUnhealthy.
+func (h *AppHealth) Status() string {
+	if h.IsHealthy() {
+		return StatusHealthy
+	}
+	return StatusUnhealthy
+}
+
+func (h *AppHealth) probe(parentCtx context.Context) {
+	ctx, cancel := context.WithTimeout(parentCtx, h.config.ProbeTimeout)
+	defer cancel()
+
+	healthy, err := h.probeFn(ctx)
+	if err != nil {
+		log.Debugf("app health probe failed: %s", err)
+		healthy = false
+	}
+	h.setResult(healthy)
+}
+
+// setResult records the result of a probe and invokes the callback if the health status changed.
+func (h *AppHealth) setResult(successful bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if successful {
+		h.failureCount = 0
+		h.setHealthy(true)
+		return
+	}
+
+	diag.DefaultMonitoring.AppHealthProbeFailed()
+	h.failureCount++
+	if h.failureCount >= h.config.Threshold {
+		// Avoid overflowing the counter while the app stays unhealthy.
+		h.failureCount = h.config.Threshold
+		h.setHealthy(false)
+	}
+}
+
+func (h *AppHealth) setHealthy(healthy bool) {
+	if h.IsHealthy() == healthy {
+		return
+	}
+	h.healthy.Store(healthy)
+
+	if healthy {
+		log.Info("app is healthy")
+	} else {
+		log.Warnf("app is unhealthy: %d consecutive health probes failed", h.config.Threshold)
+	}
+	diag.DefaultMonitoring.AppHealthStatusChanged(healthy)
+
+	if h.changeCb != nil {
+		h.changeCb(healthy)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apphealth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAppHealthDefaults(t *testing.T) {
+	h := NewAppHealth(Config{Path: "/healthz"}, nil)
+
+	cfg := h.Config()
+	assert.Equal(t, "/healthz", cfg.Path)
+	assert.Equal(t, DefaultProbeInterval, cfg.ProbeInterval)
+	assert.Equal(t, DefaultProbeTimeout, cfg.ProbeTimeout)
+	assert.Equal(t, int32(DefaultThreshold), cfg.Threshold)
+	assert.False(t, h.IsHealthy())
+	assert.Equal(t, StatusUnhealthy, h.Status())
+}
+
+func TestAppHealthThreshold(t *testing.T) {
+	h := NewAppHealth(Config{Threshold: 2}, nil)
+
+	var changes []bool
+	h.OnHealthChange(func(healthy bool) {
+		changes = append(changes, healthy)
+	})
+
+	h.setResult(true)
+	assert.True(t, h.IsHealthy())
+	assert.Equal(t, StatusHealthy, h.Status())
+
+	h.setResult(false)
+	assert.True(t, h.IsHealthy(), "app must stay healthy until the threshold is reached")
+
+	h.setResult(true)
+	h.setResult(false)
+	assert.True(t, h.IsHealthy(), "failures must be consecutive")
+
+	h.setResult(false)
+	assert.False(t, h.IsHealthy())
+	h.setResult(false)
+	assert.False(t, h.IsHealthy())
+
+	h.setResult(true)
+	assert.True(t, h.IsHealthy())
+
+	assert.Equal(t, []bool{true, false, true}, changes)
+}
+
+func TestAppHealthProbes(t *testing.T) {
+	var healthy atomic.Value
+	healthy.Store(true)
+	h := NewAppHealth(Config{ProbeInterval: 10 * time.Millisecond, Threshold: 1}, func(ctx context.Context) (bool, error) {
+		if !healthy.Load().(bool) {
+			return false, errors.New("app is down")
+		}
+		return true, nil
+	})
+
+	changes := make(chan bool, 10)
+	h.OnHealthChange(func(healthy bool) {
+		changes <- healthy
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h.StartProbes(ctx)
+
+	assert.True(t, <-changes)
+	healthy.Store(false)
+	assert.False(t, <-changes)
+	healthy.Store(true)
+	assert.True(t, <-changes)
+}
+
+func TestHTTPProbe(t *testing.T) {
+	var code int32 = http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/healthz", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(int(atomic.LoadInt32(&code)))
+	}))
+	defer server.Close()
+
+	probe := NewHTTPProbe(server.URL+"/healthz", nil)
+
+	t.Run("2xx response is healthy", func(t *testing.T) {
+		ok, err := probe(context.Background())
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("error response is unhealthy", func(t *testing.T) {
+		atomic.StoreInt32(&code, http.StatusServiceUnavailable)
+		ok, err := probe(context.Background())
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("unreachable app is unhealthy", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		ok, err := NewHTTPProbe("http://127.0.0.1:1/healthz", nil)(ctx)
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+}
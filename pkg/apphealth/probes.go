@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apphealth
+
+import (
+	"context"
+	"crypto/tls"
+
+	"github.com/valyala/fasthttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// NewHTTPProbe returns a probe that sends a GET request to url.
+// The app is healthy if it responds with a 2xx status code.
+func NewHTTPProbe(url string, tlsConfig *tls.Config) ProbeFunction {
+	client := &fasthttp.Client{
+		MaxConnsPerHost:           5, // Limit Keep-Alive connections
+		MaxIdemponentCallAttempts: 1,
+		TLSConfig:                 tlsConfig,
+	}
+
+	return func(ctx context.Context) (bool, error) {
+		req := fasthttp.AcquireRequest()
+		defer fasthttp.ReleaseRequest(req)
+		req.SetRequestURI(url)
+		req.Header.SetMethod(fasthttp.MethodGet)
+
+		resp := fasthttp.AcquireResponse()
+		defer fasthttp.ReleaseResponse(resp)
+
+		var err error
+		if deadline, ok := ctx.Deadline(); ok {
+			err = client.DoDeadline(req, resp, deadline)
+		} else {
+			err = client.Do(req, resp)
+		}
+		if err != nil {
+			return false, err
+		}
+
+		code := resp.StatusCode()
+		return code >= 200 && code < 300, nil
+	}
+}
+
+// NewGRPCProbe returns a probe that calls the standard gRPC health checking service of the app.
+// The app is healthy if it reports that it is serving.
+func NewGRPCProbe(conn grpc.ClientConnInterface) ProbeFunction {
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	return func(ctx context.Context) (bool, error) {
+		resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		if err != nil {
+			return false, err
+		}
+		return resp.GetStatus() == grpc_health_v1.HealthCheckResponse_SERVING, nil
+	}
+}
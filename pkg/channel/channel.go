@@ -25,6 +25,13 @@ const (
 	DefaultChannelAddress = "127.0.0.1"
 )
 
+// AppConnectionConfig contains the settings of the connection to the app.
+type AppConnectionConfig struct {
+	Protocol       string
+	Port           int
+	MaxConcurrency int
+}
+
 // AppChannel is an abstraction over communications with user code.
 type AppChannel interface {
 	GetBaseAddress() string
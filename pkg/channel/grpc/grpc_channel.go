@@ -87,6 +87,11 @@ func (g *Channel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRe
 
 // invokeMethodV1 calls user applications using daprclient v1.
 func (g *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	// OnInvoke is unary, so a data stream is sent to the app in a single message.
+	if err := req.BufferStream(); err != nil {
+		return nil, err
+	}
+
 	if g.ch != nil {
 		g.ch <- 1
 	}
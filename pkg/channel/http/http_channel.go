@@ -18,8 +18,10 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	nethttp "net/http"
@@ -34,6 +36,7 @@ import (
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	http_middleware "github.com/dapr/dapr/pkg/middleware/http"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	auth "github.com/dapr/dapr/pkg/runtime/security"
@@ -51,16 +54,20 @@ const (
 // Channel is an HTTP implementation of an AppChannel.
 type Channel struct {
 	client              *fasthttp.Client
+	streamClient        *nethttp.Client
 	baseAddress         string
 	ch                  chan int
 	tracingSpec         config.TracingSpec
 	appHeaderToken      string
 	maxResponseBodySize int
+	headers             map[string]string
+	pipeline            http_middleware.Pipeline
 }
 
-// CreateLocalChannel creates an HTTP AppChannel
+// CreateLocalChannel creates an HTTP AppChannel.
+// The requests sent to the app and their responses go through pipeline.
 // nolint:gosec
-func CreateLocalChannel(port, maxConcurrency int, spec config.TracingSpec, sslEnabled bool, maxRequestBodySize int, readBufferSize int) (channel.AppChannel, error) {
+func CreateLocalChannel(port, maxConcurrency int, spec config.TracingSpec, sslEnabled bool, maxRequestBodySize int, readBufferSize int, pipeline http_middleware.Pipeline) (channel.AppChannel, error) {
 	scheme := httpScheme
 	if sslEnabled {
 		scheme = httpsScheme
@@ -74,14 +81,22 @@ func CreateLocalChannel(port, maxConcurrency int, spec config.TracingSpec, sslEn
 			ReadBufferSize:            readBufferSize * 1024,
 			DisablePathNormalizing:    true,
 		},
+		streamClient: &nethttp.Client{
+			Transport: &nethttp.Transport{
+				MaxIdleConnsPerHost: 1024,
+				ReadBufferSize:      readBufferSize * 1024,
+			},
+		},
 		baseAddress:         fmt.Sprintf("%s://%s:%d", scheme, channel.DefaultChannelAddress, port),
 		tracingSpec:         spec,
 		appHeaderToken:      auth.GetAppToken(),
 		maxResponseBodySize: maxRequestBodySize,
+		pipeline:            pipeline,
 	}
 
 	if sslEnabled {
 		c.client.TLSConfig = &tls.Config{InsecureSkipVerify: true}
+		c.streamClient.Transport.(*nethttp.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 	}
 
 	if maxConcurrency > 0 {
@@ -91,6 +106,38 @@ func CreateLocalChannel(port, maxConcurrency int, spec config.TracingSpec, sslEn
 	return c, nil
 }
 
+// CreateHTTPEndpointChannel creates an HTTP AppChannel to an external, non-Dapr HTTP endpoint.
+// The given headers are added to every request, and tlsConfig is used for HTTPS base URLs.
+// nolint:gosec
+func CreateHTTPEndpointChannel(baseURL string, headers map[string]string, tlsConfig *tls.Config, spec config.TracingSpec, maxRequestBodySize int, readBufferSize int) (channel.AppChannel, error) {
+	if !strings.HasPrefix(baseURL, httpScheme+"://") && !strings.HasPrefix(baseURL, httpsScheme+"://") {
+		return nil, fmt.Errorf("invalid base URL %s: the scheme must be %s or %s", baseURL, httpScheme, httpsScheme)
+	}
+
+	c := &Channel{
+		client: &fasthttp.Client{
+			MaxIdemponentCallAttempts: 0,
+			MaxResponseBodySize:       maxRequestBodySize * 1024 * 1024,
+			ReadBufferSize:            readBufferSize * 1024,
+			DisablePathNormalizing:    true,
+			TLSConfig:                 tlsConfig,
+		},
+		streamClient: &nethttp.Client{
+			Transport: &nethttp.Transport{
+				Proxy:           nethttp.ProxyFromEnvironment,
+				ReadBufferSize:  readBufferSize * 1024,
+				TLSClientConfig: tlsConfig,
+			},
+		},
+		baseAddress:         strings.TrimSuffix(baseURL, "/"),
+		tracingSpec:         spec,
+		maxResponseBodySize: maxRequestBodySize,
+		headers:             headers,
+	}
+
+	return c, nil
+}
+
 // GetBaseAddress returns the application base address.
 func (h *Channel) GetBaseAddress() string {
 	return h.baseAddress
@@ -153,7 +200,12 @@ func (h *Channel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRe
 	var err error
 	switch req.APIVersion() {
 	case internalv1pb.APIVersion_V1:
-		rsp, err = h.invokeMethodV1(ctx, req)
+		// Requests are buffered when they go through the pipeline, so that middleware can access their data.
+		if req.HasStream() && len(h.pipeline.Handlers) == 0 {
+			rsp, err = h.invokeMethodStreamV1(ctx, req)
+		} else {
+			rsp, err = h.invokeMethodV1(ctx, req)
+		}
 
 	default:
 		// Reject unsupported version
@@ -164,6 +216,9 @@ func (h *Channel) InvokeMethod(ctx context.Context, req *invokev1.InvokeMethodRe
 }
 
 func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	if err := req.BufferStream(); err != nil {
+		return nil, err
+	}
 	channelReq := h.constructRequest(ctx, req)
 
 	if h.ch != nil {
@@ -178,7 +233,7 @@ func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 	// Send request to user application
 	resp := fasthttp.AcquireResponse()
 
-	err := h.client.Do(channelReq, resp)
+	err := h.doRequest(channelReq, resp)
 	defer func() {
 		fasthttp.ReleaseRequest(channelReq)
 		fasthttp.ReleaseResponse(resp)
@@ -201,17 +256,132 @@ func (h *Channel) invokeMethodV1(ctx context.Context, req *invokev1.InvokeMethod
 	return rsp, nil
 }
 
+// doRequest sends the request to the app through the pipeline, if one is configured.
+// Middleware can alter the request before it is sent and the response before it is parsed.
+func (h *Channel) doRequest(req *fasthttp.Request, resp *fasthttp.Response) error {
+	if len(h.pipeline.Handlers) == 0 {
+		return h.client.Do(req, resp)
+	}
+
+	var (
+		reqCtx fasthttp.RequestCtx
+		err    error
+	)
+	// Init copies the request and sets up the context, which middleware may use.
+	reqCtx.Init(req, nil, nil)
+	h.pipeline.Apply(func(c *fasthttp.RequestCtx) {
+		err = h.client.Do(&c.Request, &c.Response)
+	})(&reqCtx)
+	reqCtx.Response.CopyTo(resp)
+
+	return err
+}
+
+// invokeMethodStreamV1 sends the request data stream to the app and returns
+// a response which streams the data sent back by the app, so that neither is buffered.
+func (h *Channel) invokeMethodStreamV1(ctx context.Context, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	contentType, body := req.RawDataStream()
+	verb := req.Message().HttpExtension.Verb.String()
+
+	uri := h.methodURI(req.Message().GetMethod())
+	if qs := req.EncodeHTTPQueryString(); qs != "" {
+		uri += "?" + qs
+	}
+	// The response body is read after the call returned: the request is canceled once it is closed.
+	streamCtx, received, cancel := invokev1.StreamContext(ctx)
+	channelReq, err := nethttp.NewRequestWithContext(streamCtx, verb, uri, body)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// Recover headers
+	invokev1.InternalMetadataToHTTPHeader(ctx, req.Metadata(), channelReq.Header.Set)
+	// The length of the stream is unknown: the request is sent with chunked encoding.
+	channelReq.Header.Del("Content-Length")
+
+	// HTTP client needs to inject traceparent header for proper tracing stack.
+	span := diag_utils.SpanFromContext(ctx)
+	httpFormat := &tracecontext.HTTPFormat{}
+	httpFormat.SpanContextToRequest(span.SpanContext(), channelReq)
+
+	if h.appHeaderToken != "" {
+		channelReq.Header.Set(auth.APITokenHeader, h.appHeaderToken)
+	}
+	for k, v := range h.headers {
+		channelReq.Header.Set(k, v)
+	}
+	channelReq.Header.Set("Content-Type", contentType)
+
+	if h.ch != nil {
+		h.ch <- 1
+	}
+
+	// Emit metric when request is sent
+	diag.DefaultHTTPMonitoring.ClientRequestStarted(ctx, verb, req.Message().Method, -1)
+	startRequest := time.Now()
+
+	// Send request to user application
+	resp, err := h.streamClient.Do(channelReq)
+
+	elapsedMs := float64(time.Since(startRequest) / time.Millisecond)
+
+	if err != nil {
+		cancel()
+		if h.ch != nil {
+			<-h.ch
+		}
+		diag.DefaultHTTPMonitoring.ClientRequestCompleted(ctx, verb, req.Message().GetMethod(), strconv.Itoa(nethttp.StatusInternalServerError), 0, elapsedMs)
+		return nil, err
+	}
+	received()
+
+	diag.DefaultHTTPMonitoring.ClientRequestCompleted(ctx, verb, req.Message().GetMethod(), strconv.Itoa(resp.StatusCode), resp.ContentLength, elapsedMs)
+
+	// The headers describing the encoding of the body don't apply to the response sent back by Dapr.
+	resp.Header.Del("Content-Length")
+	resp.Header.Del("Transfer-Encoding")
+
+	// The app is still sending the response until its body has been read.
+	respBody := &releaseOnCloseBody{ReadCloser: resp.Body, release: func() {
+		cancel()
+		if h.ch != nil {
+			<-h.ch
+		}
+	}}
+
+	rsp := invokev1.NewInvokeMethodResponse(int32(resp.StatusCode), "", nil)
+	rsp.WithHTTPHeaders(resp.Header).WithRawDataStream(respBody, resp.Header.Get("Content-Type"))
+
+	return rsp, nil
+}
+
+// releaseOnCloseBody is a response body which releases the request and its concurrency slot once closed.
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release   func()
+	closeOnce sync.Once
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.closeOnce.Do(b.release)
+	return err
+}
+
+// methodURI constructs app channel URI: http://localhost:3000/method
+func (h *Channel) methodURI(method string) string {
+	if strings.HasPrefix(method, "/") {
+		return fmt.Sprintf("%s%s", h.baseAddress, method)
+	}
+	return fmt.Sprintf("%s/%s", h.baseAddress, method)
+}
+
 func (h *Channel) constructRequest(ctx context.Context, req *invokev1.InvokeMethodRequest) *fasthttp.Request {
 	channelReq := fasthttp.AcquireRequest()
 
 	// Construct app channel URI: VERB http://localhost:3000/method?query1=value1
-	var uri string
-	method := req.Message().GetMethod()
-	if strings.HasPrefix(method, "/") {
-		uri = fmt.Sprintf("%s%s", h.baseAddress, method)
-	} else {
-		uri = fmt.Sprintf("%s/%s", h.baseAddress, method)
-	}
+	uri := h.methodURI(req.Message().GetMethod())
 	channelReq.URI().Update(uri)
 	channelReq.URI().DisablePathNormalizing = true
 	channelReq.URI().SetQueryString(req.EncodeHTTPQueryString())
@@ -232,6 +402,9 @@ func (h *Channel) constructRequest(ctx context.Context, req *invokev1.InvokeMeth
 	if h.appHeaderToken != "" {
 		channelReq.Header.Set(auth.APITokenHeader, h.appHeaderToken)
 	}
+	for k, v := range h.headers {
+		channelReq.Header.Set(k, v)
+	}
 
 	// Set Content body and types
 	contentType, body := req.RawData()
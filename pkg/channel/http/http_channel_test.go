@@ -19,14 +19,18 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/valyala/fasthttp"
 
 	"github.com/dapr/dapr/pkg/config"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	http_middleware "github.com/dapr/dapr/pkg/middleware/http"
 )
 
 type testConcurrencyHandler struct {
@@ -130,6 +134,111 @@ func TestInvokeMethod(t *testing.T) {
 	server.Close()
 }
 
+func TestInvokeMethodWithPipeline(t *testing.T) {
+	server := httptest.NewServer(&testHandlerHeaders{})
+	defer server.Close()
+	ctx := context.Background()
+
+	addHeader := func(h fasthttp.RequestHandler) fasthttp.RequestHandler {
+		return func(ctx *fasthttp.RequestCtx) {
+			ctx.Request.Header.Set("Authorization", "Bearer token")
+			h(ctx)
+			ctx.Response.Header.Set("X-Pipeline", "true")
+		}
+	}
+
+	t.Run("middleware alters request and response", func(t *testing.T) {
+		c := Channel{
+			baseAddress: server.URL,
+			client:      &fasthttp.Client{},
+			pipeline:    http_middleware.Pipeline{Handlers: []http_middleware.Middleware{addHeader}},
+		}
+		fakeReq := invokev1.NewInvokeMethodRequest("method")
+		fakeReq.WithHTTPExtension(http.MethodPost, "")
+
+		// act
+		response, err := c.InvokeMethod(ctx, fakeReq)
+
+		// assert
+		assert.NoError(t, err)
+		_, body := response.RawData()
+		actual := map[string]string{}
+		assert.NoError(t, json.Unmarshal(body, &actual))
+		assert.Equal(t, "Bearer token", actual["Authorization"])
+		assert.Equal(t, []string{"true"}, response.Headers()["X-Pipeline"].GetValues())
+	})
+
+	t.Run("streamed request goes through the pipeline", func(t *testing.T) {
+		c := Channel{
+			baseAddress: server.URL,
+			client:      &fasthttp.Client{},
+			pipeline:    http_middleware.Pipeline{Handlers: []http_middleware.Middleware{addHeader}},
+		}
+		fakeReq := invokev1.NewInvokeMethodRequest("method")
+		fakeReq.WithHTTPExtension(http.MethodPost, "")
+		fakeReq.WithRawDataStream(strings.NewReader("data"), "text/plain")
+
+		// act
+		response, err := c.InvokeMethod(ctx, fakeReq)
+
+		// assert
+		assert.NoError(t, err)
+		_, body := response.RawData()
+		actual := map[string]string{}
+		assert.NoError(t, json.Unmarshal(body, &actual))
+		assert.Equal(t, "Bearer token", actual["Authorization"])
+	})
+
+	t.Run("middleware short-circuits the request", func(t *testing.T) {
+		c := Channel{
+			baseAddress: server.URL,
+			client:      &fasthttp.Client{},
+			pipeline: http_middleware.Pipeline{Handlers: []http_middleware.Middleware{
+				func(h fasthttp.RequestHandler) fasthttp.RequestHandler {
+					return func(ctx *fasthttp.RequestCtx) {
+						ctx.Response.SetStatusCode(fasthttp.StatusForbidden)
+					}
+				},
+			}},
+		}
+		fakeReq := invokev1.NewInvokeMethodRequest("method")
+		fakeReq.WithHTTPExtension(http.MethodPost, "")
+
+		// act
+		response, err := c.InvokeMethod(ctx, fakeReq)
+
+		// assert
+		assert.NoError(t, err)
+		assert.Equal(t, int32(fasthttp.StatusForbidden), response.Status().Code)
+	})
+
+	t.Run("middleware gets an initialized request context", func(t *testing.T) {
+		var started time.Time
+		c := Channel{
+			baseAddress: server.URL,
+			client:      &fasthttp.Client{},
+			pipeline: http_middleware.Pipeline{Handlers: []http_middleware.Middleware{
+				func(h fasthttp.RequestHandler) fasthttp.RequestHandler {
+					return func(ctx *fasthttp.RequestCtx) {
+						started = ctx.Time()
+						ctx.Logger().Printf("request to %s", ctx.Path())
+						h(ctx)
+					}
+				},
+			}},
+		}
+		fakeReq := invokev1.NewInvokeMethodRequest("method")
+		fakeReq.WithHTTPExtension(http.MethodPost, "")
+
+		// act
+		_, err := c.InvokeMethod(ctx, fakeReq)
+
+		// assert
+		assert.NoError(t, err)
+		assert.False(t, started.IsZero())
+	})
+}
+
 func TestInvokeMethodMaxConcurrency(t *testing.T) {
 	ctx := context.Background()
 	t.Run("single concurrency", func(t *testing.T) {
@@ -356,7 +465,7 @@ func TestAppToken(t *testing.T) {
 
 func TestCreateChannel(t *testing.T) {
 	t.Run("ssl scheme", func(t *testing.T) {
-		ch, err := CreateLocalChannel(3000, 0, config.TracingSpec{}, true, 4, 4)
+		ch, err := CreateLocalChannel(3000, 0, config.TracingSpec{}, true, 4, 4, http_middleware.Pipeline{})
 		assert.NoError(t, err)
 
 		b := ch.GetBaseAddress()
@@ -364,10 +473,114 @@ func TestCreateChannel(t *testing.T) {
 	})
 
 	t.Run("non-ssl scheme", func(t *testing.T) {
-		ch, err := CreateLocalChannel(3000, 0, config.TracingSpec{}, false, 4, 4)
+		ch, err := CreateLocalChannel(3000, 0, config.TracingSpec{}, false, 4, 4, http_middleware.Pipeline{})
 		assert.NoError(t, err)
 
 		b := ch.GetBaseAddress()
 		assert.Equal(t, b, "http://127.0.0.1:3000")
 	})
 }
+
+func TestCreateHTTPEndpointChannel(t *testing.T) {
+	t.Run("trailing slash is removed from base url", func(t *testing.T) {
+		ch, err := CreateHTTPEndpointChannel("https://api.example.com/v1/", nil, nil, config.TracingSpec{}, 4, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://api.example.com/v1", ch.GetBaseAddress())
+	})
+
+	t.Run("invalid scheme", func(t *testing.T) {
+		_, err := CreateHTTPEndpointChannel("ftp://api.example.com", nil, nil, config.TracingSpec{}, 4, 4)
+		assert.Error(t, err)
+	})
+
+	t.Run("headers are added and the app token is not sent", func(t *testing.T) {
+		testServer := httptest.NewServer(&testHandlerHeaders{})
+		defer testServer.Close()
+
+		t.Setenv("APP_API_TOKEN", "token1")
+		ch, err := CreateHTTPEndpointChannel(testServer.URL, map[string]string{"Authorization": "Bearer abc"}, nil, config.TracingSpec{}, 4, 4)
+		assert.NoError(t, err)
+
+		req := invokev1.NewInvokeMethodRequest("method")
+		req.WithHTTPExtension(http.MethodGet, "")
+
+		response, err := ch.InvokeMethod(context.Background(), req)
+		assert.NoError(t, err)
+		_, body := response.RawData()
+
+		actual := map[string]string{}
+		json.Unmarshal(body, &actual)
+
+		assert.Equal(t, "Bearer abc", actual["Authorization"])
+		_, hasToken := actual["Dapr-Api-Token"]
+		assert.False(t, hasToken)
+	})
+}
+
+type testStreamHandler struct{}
+
+func (t *testStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, r.Body)
+}
+
+func TestInvokeMethodStream(t *testing.T) {
+	server := httptest.NewServer(&testStreamHandler{})
+	defer server.Close()
+
+	c := Channel{
+		baseAddress:  server.URL,
+		client:       &fasthttp.Client{},
+		streamClient: &http.Client{},
+		tracingSpec: config.TracingSpec{
+			SamplingRate: "0",
+		},
+	}
+
+	data := strings.Repeat("0123456789", 100000)
+	fakeReq := invokev1.NewInvokeMethodRequest("method")
+	fakeReq.WithHTTPExtension(http.MethodPost, "")
+	fakeReq.WithRawDataStream(strings.NewReader(data), "application/octet-stream")
+
+	// act
+	response, err := c.InvokeMethod(context.Background(), fakeReq)
+
+	// assert
+	assert.NoError(t, err)
+	assert.True(t, response.HasStream())
+	assert.Equal(t, int32(http.StatusOK), response.Status().Code)
+	contentType, body := response.RawDataStream()
+	defer body.Close()
+	assert.Equal(t, "application/octet-stream", contentType)
+	b, err := io.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, data, string(b))
+}
+
+func TestInvokeMethodStreamMaxConcurrency(t *testing.T) {
+	server := httptest.NewServer(&testStreamHandler{})
+	defer server.Close()
+
+	c := Channel{
+		baseAddress:  server.URL,
+		client:       &fasthttp.Client{},
+		streamClient: &http.Client{},
+		ch:           make(chan int, 1),
+		tracingSpec: config.TracingSpec{
+			SamplingRate: "0",
+		},
+	}
+
+	fakeReq := invokev1.NewInvokeMethodRequest("method")
+	fakeReq.WithHTTPExtension(http.MethodPost, "")
+	fakeReq.WithRawDataStream(strings.NewReader("data"), "application/octet-stream")
+
+	response, err := c.InvokeMethod(context.Background(), fakeReq)
+	require.NoError(t, err)
+
+	// The slot is held until the response body has been consumed.
+	assert.Len(t, c.ch, 1)
+	require.NoError(t, response.BufferStream())
+	assert.Len(t, c.ch, 0)
+}
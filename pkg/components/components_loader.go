@@ -13,9 +13,17 @@ limitations under the License.
 
 package components
 
-import components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+import (
+	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	httpendpoint_v1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+)
 
 // ComponentLoader is an interface for returning Dapr components.
 type ComponentLoader interface {
 	LoadComponents() ([]components_v1alpha1.Component, error)
 }
+
+// HTTPEndpointsLoader is an interface for returning Dapr HTTP endpoints.
+type HTTPEndpointsLoader interface {
+	LoadHTTPEndpoints() ([]httpendpoint_v1alpha1.HTTPEndpoint, error)
+}
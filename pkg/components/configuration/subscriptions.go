@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/kit/logger"
+)
+
+var log = logger.NewLogger("dapr.runtime.components.configuration")
+
+// Subscriptions holds the subscriptions to configuration stores created with the Dapr APIs, by subscription ID.
+type Subscriptions struct {
+	lock sync.Mutex
+	subs map[string]subscription
+}
+
+type subscription struct {
+	storeName string
+	stop      context.CancelFunc
+}
+
+// NewSubscriptions returns an empty set of subscriptions.
+func NewSubscriptions() *Subscriptions {
+	return &Subscriptions{
+		subs: map[string]subscription{},
+	}
+}
+
+// Has returns true if there is a subscription with the ID.
+func (s *Subscriptions) Has(id string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, ok := s.subs[id]
+	return ok
+}
+
+// Subscribe subscribes to the store in an attempt of a resiliency policy running with ctx, and returns the ID of the
+// subscription with a channel closed once it is stopped.
+// The subscription outlives the attempt: it is stopped when the subscription is removed with Unsubscribe.
+// The subscription of a failed attempt is removed from the store, so that a retried subscribe doesn't register it twice.
+func (s *Subscriptions) Subscribe(ctx context.Context, store configuration.Store, storeName string, req *configuration.SubscribeRequest, handler configuration.UpdateHandler) (string, <-chan struct{}, error) {
+	subscribeCtx, stop := context.WithCancel(context.Background())
+	subscribed := make(chan struct{})
+	go func() {
+		// The attempt is abandoned if ctx is done before the store returns.
+		select {
+		case <-ctx.Done():
+			stop()
+		case <-subscribed:
+		}
+	}()
+
+	id, err := store.Subscribe(subscribeCtx, req, handler)
+	close(subscribed)
+	if err == nil && ctx.Err() != nil {
+		// The attempt timed out and is retried.
+		err = ctx.Err()
+	}
+	if err != nil {
+		stop()
+		if id != "" {
+			if uerr := store.Unsubscribe(context.Background(), &configuration.UnsubscribeRequest{ID: id}); uerr != nil {
+				log.Warnf("failed to remove subscription %s of a failed subscribe attempt from configuration store %s: %s", id, storeName, uerr)
+			}
+		}
+		return "", nil, err
+	}
+
+	s.lock.Lock()
+	s.subs[id] = subscription{
+		storeName: storeName,
+		stop:      stop,
+	}
+	s.lock.Unlock()
+	return id, subscribeCtx.Done(), nil
+}
+
+// Unsubscribe removes the subscription with the ID from the store named storeName with unsubscribe, then stops it.
+// It returns false if the store has no such subscription.
+// The subscription is kept if unsubscribe fails, so that unsubscribing can be retried.
+func (s *Subscriptions) Unsubscribe(id, storeName string, unsubscribe func() error) (bool, error) {
+	// The subscription is removed while the store is called, so that concurrent unsubscribe requests don't both call it.
+	s.lock.Lock()
+	sub, ok := s.subs[id]
+	ok = ok && sub.storeName == storeName
+	if ok {
+		delete(s.subs, id)
+	}
+	s.lock.Unlock()
+
+	if !ok {
+		return false, nil
+	}
+
+	if err := unsubscribe(); err != nil {
+		s.lock.Lock()
+		s.subs[id] = sub
+		s.lock.Unlock()
+		return true, err
+	}
+
+	sub.stop()
+	return true, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/configuration"
+	configuration_loader "github.com/dapr/dapr/pkg/components/configuration"
+)
+
+type fakeStore struct {
+	configuration.Store
+	subscribeErr   error
+	unsubscribeErr error
+	ctx            context.Context
+	unsubscribed   []string
+}
+
+func (s *fakeStore) Subscribe(ctx context.Context, req *configuration.SubscribeRequest, handler configuration.UpdateHandler) (string, error) {
+	s.ctx = ctx
+	return "id", s.subscribeErr
+}
+
+func (s *fakeStore) Unsubscribe(ctx context.Context, req *configuration.UnsubscribeRequest) error {
+	s.unsubscribed = append(s.unsubscribed, req.ID)
+	return s.unsubscribeErr
+}
+
+func TestSubscriptions(t *testing.T) {
+	t.Run("subscription outlives the attempt until unsubscribed", func(t *testing.T) {
+		subs := configuration_loader.NewSubscriptions()
+		store := &fakeStore{}
+
+		attemptCtx, cancel := context.WithCancel(context.Background())
+		id, stopped, err := subs.Subscribe(attemptCtx, store, "store1", &configuration.SubscribeRequest{}, nil)
+		cancel()
+		require.NoError(t, err)
+		assert.Equal(t, "id", id)
+		assert.True(t, subs.Has("id"))
+		assert.NoError(t, store.ctx.Err())
+
+		// The subscription belongs to another store.
+		found, err := subs.Unsubscribe("id", "store2", func() error { return nil })
+		assert.False(t, found)
+		assert.NoError(t, err)
+
+		found, err = subs.Unsubscribe("id", "store1", func() error { return nil })
+		assert.True(t, found)
+		assert.NoError(t, err)
+		assert.False(t, subs.Has("id"))
+		assert.Error(t, store.ctx.Err())
+		<-stopped
+	})
+
+	t.Run("subscription is kept when unsubscribing fails", func(t *testing.T) {
+		subs := configuration_loader.NewSubscriptions()
+		store := &fakeStore{}
+		_, _, err := subs.Subscribe(context.Background(), store, "store1", &configuration.SubscribeRequest{}, nil)
+		require.NoError(t, err)
+
+		found, err := subs.Unsubscribe("id", "store1", func() error { return errors.New("failed") })
+		assert.True(t, found)
+		assert.Error(t, err)
+		assert.True(t, subs.Has("id"))
+		assert.NoError(t, store.ctx.Err())
+	})
+
+	t.Run("subscription of a failed attempt is removed", func(t *testing.T) {
+		subs := configuration_loader.NewSubscriptions()
+		store := &fakeStore{subscribeErr: errors.New("failed")}
+		_, _, err := subs.Subscribe(context.Background(), store, "store1", &configuration.SubscribeRequest{}, nil)
+		assert.Error(t, err)
+		assert.False(t, subs.Has("id"))
+		assert.Equal(t, []string{"id"}, store.unsubscribed)
+		assert.Error(t, store.ctx.Err())
+	})
+
+	t.Run("subscription of a timed out attempt is removed", func(t *testing.T) {
+		subs := configuration_loader.NewSubscriptions()
+		store := &fakeStore{}
+		attemptCtx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _, err := subs.Subscribe(attemptCtx, store, "store1", &configuration.SubscribeRequest{}, nil)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.False(t, subs.Has("id"))
+		assert.Equal(t, []string{"id"}, store.unsubscribed)
+	})
+}
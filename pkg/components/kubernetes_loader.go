@@ -23,6 +23,7 @@ import (
 	"github.com/dapr/kit/logger"
 
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	httpendpoint_v1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	config "github.com/dapr/dapr/pkg/config/modes"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 )
@@ -76,3 +77,25 @@ func (k *KubernetesComponents) LoadComponents() ([]components_v1alpha1.Component
 	}
 	return components, nil
 }
+
+// LoadHTTPEndpoints returns HTTP endpoints from a given control plane address.
+func (k *KubernetesComponents) LoadHTTPEndpoints() ([]httpendpoint_v1alpha1.HTTPEndpoint, error) {
+	resp, err := k.client.ListHTTPEndpoints(context.Background(), &operatorv1pb.ListHTTPEndpointsRequest{
+		Namespace: k.namespace,
+	}, grpc_retry.WithMax(operatorMaxRetries), grpc_retry.WithPerRetryTimeout(operatorCallTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := []httpendpoint_v1alpha1.HTTPEndpoint{}
+	for _, e := range resp.GetHttpEndpoints() {
+		var endpoint httpendpoint_v1alpha1.HTTPEndpoint
+		err := json.Unmarshal(e, &endpoint)
+		if err != nil {
+			log.Warnf("error deserializing http endpoint: %s", err)
+			continue
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
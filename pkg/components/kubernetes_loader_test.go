@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	httpendpoint_v1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	subscriptions "github.com/dapr/dapr/pkg/apis/subscriptions/v1alpha1"
 	config "github.com/dapr/dapr/pkg/config/modes"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
@@ -57,6 +58,20 @@ func (o *mockOperator) ListSubscriptionsV2(ctx context.Context, in *operatorv1pb
 	}, nil
 }
 
+func (o *mockOperator) ListHTTPEndpoints(ctx context.Context, in *operatorv1pb.ListHTTPEndpointsRequest) (*operatorv1pb.ListHTTPEndpointsResponse, error) {
+	endpoint := httpendpoint_v1alpha1.HTTPEndpoint{}
+	endpoint.ObjectMeta.Name = "test"
+	endpoint.ObjectMeta.Namespace = in.Namespace
+	endpoint.Spec = httpendpoint_v1alpha1.HTTPEndpointSpec{
+		BaseURL: "http://api.example.com",
+	}
+	b, _ := json.Marshal(&endpoint)
+
+	return &operatorv1pb.ListHTTPEndpointsResponse{
+		HttpEndpoints: [][]byte{b},
+	}, nil
+}
+
 func (o *mockOperator) ComponentUpdate(in *operatorv1pb.ComponentUpdateRequest, srv operatorv1pb.Operator_ComponentUpdateServer) error {
 	return nil
 }
@@ -96,3 +111,34 @@ func TestLoadComponents(t *testing.T) {
 	assert.Equal(t, "testtype", response[0].Spec.Type)
 	assert.Equal(t, "testPodName", response[0].ObjectMeta.Labels["podName"])
 }
+
+func TestLoadHTTPEndpoints(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	assert.NoError(t, err)
+
+	s := grpc.NewServer()
+	operatorv1pb.RegisterOperatorServer(s, &mockOperator{})
+	defer s.Stop()
+
+	go func() {
+		s.Serve(lis)
+	}()
+
+	time.Sleep(time.Second * 1)
+
+	request := &KubernetesComponents{
+		client: getOperatorClient(fmt.Sprintf("localhost:%d", port)),
+		config: config.KubernetesConfig{
+			ControlPlaneAddress: fmt.Sprintf("localhost:%v", port),
+		},
+		namespace: "testNamespace",
+	}
+
+	response, err := request.LoadHTTPEndpoints()
+	assert.NoError(t, err)
+	assert.Len(t, response, 1)
+	assert.Equal(t, "test", response[0].Name)
+	assert.Equal(t, "testNamespace", response[0].Namespace)
+	assert.Equal(t, "http://api.example.com", response[0].Spec.BaseURL)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	middleware "github.com/dapr/components-contrib/middleware"
+
+	"github.com/dapr/dapr/pkg/components"
+	grpc_middleware "github.com/dapr/dapr/pkg/middleware/grpc"
+)
+
+type (
+	// Middleware is a gRPC middleware component definition.
+	Middleware struct {
+		Names         []string
+		FactoryMethod FactoryMethod
+	}
+
+	// Registry is the interface for callers to get registered gRPC middleware.
+	Registry interface {
+		Register(components ...Middleware)
+		Create(name, version string, metadata middleware.Metadata) (grpc_middleware.Middleware, error)
+	}
+
+	grpcMiddlewareRegistry struct {
+		middleware map[string]FactoryMethod
+	}
+
+	// FactoryMethod is the method creating middleware from metadata.
+	FactoryMethod func(metadata middleware.Metadata) (grpc_middleware.Middleware, error)
+)
+
+// New creates a Middleware.
+func New(name string, factoryMethod FactoryMethod, aliases ...string) Middleware {
+	names := []string{name}
+	if len(aliases) > 0 {
+		names = append(names, aliases...)
+	}
+	return Middleware{
+		Names:         names,
+		FactoryMethod: factoryMethod,
+	}
+}
+
+// NewRegistry returns a new gRPC middleware registry.
+func NewRegistry() Registry {
+	return &grpcMiddlewareRegistry{
+		middleware: map[string]FactoryMethod{},
+	}
+}
+
+// Register registers one or more new gRPC middlewares.
+func (p *grpcMiddlewareRegistry) Register(components ...Middleware) {
+	for _, component := range components {
+		for _, name := range component.Names {
+			p.middleware[createFullName(name)] = component.FactoryMethod
+		}
+	}
+}
+
+// Create instantiates a gRPC middleware based on `name`.
+func (p *grpcMiddlewareRegistry) Create(name, version string, metadata middleware.Metadata) (grpc_middleware.Middleware, error) {
+	if method, ok := p.getMiddleware(name, version); ok {
+		mid, err := method(metadata)
+		if err != nil {
+			return grpc_middleware.Middleware{}, errors.Errorf("error creating gRPC middleware %s/%s: %s", name, version, err)
+		}
+		return mid, nil
+	}
+	return grpc_middleware.Middleware{}, errors.Errorf("gRPC middleware %s/%s has not been registered", name, version)
+}
+
+func (p *grpcMiddlewareRegistry) getMiddleware(name, version string) (FactoryMethod, bool) {
+	nameLower := strings.ToLower(name)
+	versionLower := strings.ToLower(version)
+	middlewareFn, ok := p.middleware[nameLower+"/"+versionLower]
+	if ok {
+		return middlewareFn, true
+	}
+	if components.IsInitialVersion(versionLower) {
+		middlewareFn, ok = p.middleware[nameLower]
+	}
+	return middlewareFn, ok
+}
+
+func createFullName(name string) string {
+	return strings.ToLower("middleware.grpc." + name)
+}
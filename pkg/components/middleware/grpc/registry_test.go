@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	grpc_go "google.golang.org/grpc"
+
+	h "github.com/dapr/components-contrib/middleware"
+
+	"github.com/dapr/dapr/pkg/components/middleware/grpc"
+	grpc_middleware "github.com/dapr/dapr/pkg/middleware/grpc"
+)
+
+func TestRegistry(t *testing.T) {
+	testRegistry := grpc.NewRegistry()
+
+	t.Run("middleware is registered", func(t *testing.T) {
+		const (
+			middlewareName   = "mockMiddleware"
+			middlewareNameV2 = "mockMiddleware/v2"
+			componentName    = "middleware.grpc." + middlewareName
+		)
+
+		// Initiate mock object
+		mock := grpc_middleware.Middleware{
+			Unary: func(ctx context.Context, req interface{}, info *grpc_go.UnaryServerInfo, handler grpc_go.UnaryHandler) (interface{}, error) {
+				return nil, nil
+			},
+		}
+		mockV2 := grpc_middleware.Middleware{
+			Unary: func(ctx context.Context, req interface{}, info *grpc_go.UnaryServerInfo, handler grpc_go.UnaryHandler) (interface{}, error) {
+				return nil, nil
+			},
+		}
+		metadata := h.Metadata{}
+
+		// act
+		testRegistry.Register(grpc.New(middlewareName, func(h.Metadata) (grpc_middleware.Middleware, error) {
+			return mock, nil
+		}))
+		testRegistry.Register(grpc.New(middlewareNameV2, func(h.Metadata) (grpc_middleware.Middleware, error) {
+			return mockV2, nil
+		}))
+
+		// Function values are not comparable, so their addresses are compared instead.
+
+		// assert v0 and v1
+		p, e := testRegistry.Create(componentName, "v0", metadata)
+		assert.NoError(t, e)
+		assert.Equal(t, fmt.Sprintf("%v", mock.Unary), fmt.Sprintf("%v", p.Unary))
+		p, e = testRegistry.Create(componentName, "v1", metadata)
+		assert.NoError(t, e)
+		assert.Equal(t, fmt.Sprintf("%v", mock.Unary), fmt.Sprintf("%v", p.Unary))
+
+		// assert v2
+		pV2, e := testRegistry.Create(componentName, "v2", metadata)
+		assert.NoError(t, e)
+		assert.Equal(t, fmt.Sprintf("%v", mockV2.Unary), fmt.Sprintf("%v", pV2.Unary))
+
+		// check case-insensitivity
+		pV2, e = testRegistry.Create(strings.ToUpper(componentName), "V2", metadata)
+		assert.NoError(t, e)
+		assert.Equal(t, fmt.Sprintf("%v", mockV2.Unary), fmt.Sprintf("%v", pV2.Unary))
+	})
+
+	t.Run("middleware is not registered", func(t *testing.T) {
+		const (
+			middlewareName = "fakeMiddleware"
+			componentName  = "middleware.grpc." + middlewareName
+		)
+
+		metadata := h.Metadata{}
+
+		// act
+		_, actualError := testRegistry.Create(componentName, "v1", metadata)
+		expectedError := errors.Errorf("gRPC middleware %s/v1 has not been registered", componentName)
+
+		// assert
+		assert.Equal(t, expectedError.Error(), actualError.Error())
+	})
+}
@@ -24,12 +24,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	httpendpoint_v1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	config "github.com/dapr/dapr/pkg/config/modes"
 )
 
 const (
-	yamlSeparator = "\n---"
-	componentKind = "Component"
+	yamlSeparator    = "\n---"
+	componentKind    = "Component"
+	httpEndpointKind = "HTTPEndpoint"
 )
 
 // StandaloneComponents loads components in a standalone mode environment.
@@ -83,6 +85,35 @@ func (s *StandaloneComponents) loadComponentsFromFile(filename string) []compone
 	return components
 }
 
+// LoadHTTPEndpoints loads dapr HTTP endpoints from the components directory.
+func (s *StandaloneComponents) LoadHTTPEndpoints() ([]httpendpoint_v1alpha1.HTTPEndpoint, error) {
+	files, err := os.ReadDir(s.config.ComponentsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	list := []httpendpoint_v1alpha1.HTTPEndpoint{}
+
+	for _, file := range files {
+		if !file.IsDir() && s.isYaml(file.Name()) {
+			path := filepath.Join(s.config.ComponentsPath, file.Name())
+			b, err := os.ReadFile(path)
+			if err != nil {
+				log.Warnf("daprd load http endpoints error when reading file %s : %s", path, err)
+				continue
+			}
+
+			endpoints, errors := s.decodeHTTPEndpointsYaml(b)
+			for _, err := range errors {
+				log.Warnf("daprd load http endpoints error when parsing http endpoints yaml resource in %s : %s", path, err)
+			}
+			list = append(list, endpoints...)
+		}
+	}
+
+	return list, nil
+}
+
 // isYaml checks whether the file is yaml or not.
 func (s *StandaloneComponents) isYaml(fileName string) bool {
 	extension := strings.ToLower(filepath.Ext(fileName))
@@ -95,6 +126,38 @@ func (s *StandaloneComponents) isYaml(fileName string) bool {
 // decodeYaml decodes the yaml document.
 func (s *StandaloneComponents) decodeYaml(b []byte) ([]components_v1alpha1.Component, []error) {
 	list := []components_v1alpha1.Component{}
+	errors := s.decodeYamlDocs(b, componentKind, func(doc []byte) error {
+		var comp components_v1alpha1.Component
+		comp.Spec = components_v1alpha1.ComponentSpec{}
+		if err := yaml.Unmarshal(doc, &comp); err != nil {
+			return err
+		}
+
+		list = append(list, comp)
+		return nil
+	})
+
+	return list, errors
+}
+
+// decodeHTTPEndpointsYaml decodes the HTTP endpoints of the yaml document.
+func (s *StandaloneComponents) decodeHTTPEndpointsYaml(b []byte) ([]httpendpoint_v1alpha1.HTTPEndpoint, []error) {
+	list := []httpendpoint_v1alpha1.HTTPEndpoint{}
+	errors := s.decodeYamlDocs(b, httpEndpointKind, func(doc []byte) error {
+		var endpoint httpendpoint_v1alpha1.HTTPEndpoint
+		if err := yaml.Unmarshal(doc, &endpoint); err != nil {
+			return err
+		}
+
+		list = append(list, endpoint)
+		return nil
+	})
+
+	return list, errors
+}
+
+// decodeYamlDocs calls decode for each document of the given kind in the yaml file.
+func (s *StandaloneComponents) decodeYamlDocs(b []byte, kind string, decode func(doc []byte) error) []error {
 	errors := []error{}
 	scanner := bufio.NewScanner(bytes.NewReader(b))
 	scanner.Split(s.splitYamlDoc)
@@ -123,22 +186,18 @@ func (s *StandaloneComponents) decodeYaml(b []byte) ([]components_v1alpha1.Compo
 			continue
 		}
 
-		if ti.Kind != componentKind {
+		if ti.Kind != kind {
 			continue
 		}
 
-		var comp components_v1alpha1.Component
-		comp.Spec = components_v1alpha1.ComponentSpec{}
-		if err := yaml.Unmarshal(scannerBytes, &comp); err != nil {
+		if err := decode(scannerBytes); err != nil {
 			errors = append(errors, err)
 
 			continue
 		}
-
-		list = append(list, comp)
 	}
 
-	return list, errors
+	return errors
 }
 
 // splitYamlDoc - splits the yaml docs.
@@ -283,3 +283,51 @@ spec:
 	assert.Equal(t, "prop3", components[1].Spec.Metadata[0].Name)
 	assert.Equal(t, "value3", components[1].Spec.Metadata[0].Value.String())
 }
+
+func TestStandaloneDecodeHTTPEndpointsYaml(t *testing.T) {
+	request := &StandaloneComponents{
+		config: config.StandaloneConfig{
+			ComponentsPath: "test_component_path",
+		},
+	}
+	yaml := `
+apiVersion: dapr.io/v1alpha1
+kind: Component
+metadata:
+    name: statestore1
+spec:
+  type: state.couchbase
+---
+apiVersion: dapr.io/v1alpha1
+kind: HTTPEndpoint
+metadata:
+    name: github
+spec:
+  baseUrl: https://api.github.com
+  headers:
+    - name: Accept
+      value: application/json
+    - name: Authorization
+      secretKeyRef:
+        name: github-token
+        key: token
+  clientTLS:
+    insecureSkipVerify: true
+auth:
+  secretStore: local
+`
+	endpoints, errs := request.decodeHTTPEndpointsYaml([]byte(yaml))
+	assert.Empty(t, errs)
+	assert.Len(t, endpoints, 1)
+	assert.Equal(t, "github", endpoints[0].Name)
+	assert.Equal(t, "https://api.github.com", endpoints[0].Spec.BaseURL)
+	assert.Len(t, endpoints[0].Spec.Headers, 2)
+	assert.Equal(t, "application/json", endpoints[0].Spec.Headers[0].Value)
+	assert.Equal(t, "github-token", endpoints[0].Spec.Headers[1].SecretKeyRef.Name)
+	assert.True(t, endpoints[0].Spec.ClientTLS.InsecureSkipVerify)
+	assert.Equal(t, "local", endpoints[0].Auth.SecretStore)
+
+	components, errs := request.decodeYaml([]byte(yaml))
+	assert.Empty(t, errs)
+	assert.Len(t, components, 1)
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package concurrency
 
 import (
+	"context"
 	"sync/atomic"
 )
 
@@ -70,6 +71,28 @@ func (c *Limiter) Execute(job func(param interface{}), param interface{}) int {
 	return ticket
 }
 
+// Acquire blocks until a go routine is available or ctx is done, and returns
+// the function releasing it. It lets the caller run its job synchronously.
+func (c *Limiter) Acquire(ctx context.Context) (release func(), err error) {
+	var ticket int
+	select {
+	case ticket = <-c.tickets:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	atomic.AddInt32(&c.numInProgress, 1)
+	return func() {
+		c.tickets <- ticket
+		atomic.AddInt32(&c.numInProgress, -1)
+	}, nil
+}
+
+// InProgress returns the number of jobs currently running.
+func (c *Limiter) InProgress() int {
+	return int(atomic.LoadInt32(&c.numInProgress))
+}
+
 // Wait will block all the previously Executed jobs completed running.
 //
 // IMPORTANT: calling the Wait function while keep calling Execute leads to
@@ -23,6 +23,7 @@ import (
 
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 	yaml "gopkg.in/yaml.v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -31,20 +32,22 @@ import (
 )
 
 const (
-	operatorCallTimeout          = time.Second * 5
-	operatorMaxRetries           = 100
-	AllowAccess                  = "allow"
-	DenyAccess                   = "deny"
-	DefaultTrustDomain           = "public"
-	DefaultNamespace             = "default"
-	ActionPolicyApp              = "app"
-	ActionPolicyGlobal           = "global"
-	SpiffeIDPrefix               = "spiffe://"
-	HTTPProtocol                 = "http"
-	GRPCProtocol                 = "grpc"
-	PubSubRouting        Feature = "PubSub.Routing"
-	Resiliency           Feature = "Resiliency"
-	NoDefaultContentType Feature = "ServiceInvocation.NoDefaultContentType"
+	operatorCallTimeout              = time.Second * 5
+	operatorMaxRetries               = 100
+	AllowAccess                      = "allow"
+	DenyAccess                       = "deny"
+	DefaultTrustDomain               = "public"
+	DefaultNamespace                 = "default"
+	ActionPolicyApp                  = "app"
+	ActionPolicyGlobal               = "global"
+	SpiffeIDPrefix                   = "spiffe://"
+	HTTPProtocol                     = "http"
+	GRPCProtocol                     = "grpc"
+	PubSubPublishOperation           = "publish"
+	PubSubSubscribeOperation         = "subscribe"
+	PubSubRouting            Feature = "PubSub.Routing"
+	Resiliency               Feature = "Resiliency"
+	NoDefaultContentType     Feature = "ServiceInvocation.NoDefaultContentType"
 )
 
 type Feature string
@@ -74,6 +77,10 @@ type AccessControlListPolicySpec struct {
 	TrustDomain         string
 	Namespace           string
 	AppOperationActions *Trie
+	// RateLimiter limits the requests of the app to all operations. Nil if there is no limit.
+	RateLimiter *rate.Limiter
+	// PubSubActions contains the topic actions of the app by pub/sub name, with "*" for all the pub/sub components.
+	PubSubActions map[string]*Trie
 }
 
 // AccessControlListOperationAction is an in-memory access control list config per operation for fast lookup.
@@ -81,18 +88,22 @@ type AccessControlListOperationAction struct {
 	VerbAction      map[string]string
 	OperationName   string
 	OperationAction string
+	// RateLimiter limits the requests of the app to the operation. Nil if there is no limit.
+	RateLimiter *rate.Limiter
 }
 
 type ConfigurationSpec struct {
-	HTTPPipelineSpec   PipelineSpec       `json:"httpPipeline,omitempty" yaml:"httpPipeline,omitempty"`
-	TracingSpec        TracingSpec        `json:"tracing,omitempty" yaml:"tracing,omitempty"`
-	MTLSSpec           MTLSSpec           `json:"mtls,omitempty" yaml:"mtls,omitempty"`
-	MetricSpec         MetricSpec         `json:"metric,omitempty" yaml:"metric,omitempty"`
-	Secrets            SecretsSpec        `json:"secrets,omitempty" yaml:"secrets,omitempty"`
-	AccessControlSpec  AccessControlSpec  `json:"accessControl,omitempty" yaml:"accessControl,omitempty"`
-	NameResolutionSpec NameResolutionSpec `json:"nameResolution,omitempty" yaml:"nameResolution,omitempty"`
-	Features           []FeatureSpec      `json:"features,omitempty" yaml:"features,omitempty"`
-	APISpec            APISpec            `json:"api,omitempty" yaml:"api,omitempty"`
+	HTTPPipelineSpec    PipelineSpec       `json:"httpPipeline,omitempty" yaml:"httpPipeline,omitempty"`
+	GRPCPipelineSpec    PipelineSpec       `json:"grpcPipeline,omitempty" yaml:"grpcPipeline,omitempty"`
+	AppHTTPPipelineSpec PipelineSpec       `json:"appHttpPipeline,omitempty" yaml:"appHttpPipeline,omitempty"`
+	TracingSpec         TracingSpec        `json:"tracing,omitempty" yaml:"tracing,omitempty"`
+	MTLSSpec            MTLSSpec           `json:"mtls,omitempty" yaml:"mtls,omitempty"`
+	MetricSpec          MetricSpec         `json:"metric,omitempty" yaml:"metric,omitempty"`
+	Secrets             SecretsSpec        `json:"secrets,omitempty" yaml:"secrets,omitempty"`
+	AccessControlSpec   AccessControlSpec  `json:"accessControl,omitempty" yaml:"accessControl,omitempty"`
+	NameResolutionSpec  NameResolutionSpec `json:"nameResolution,omitempty" yaml:"nameResolution,omitempty"`
+	Features            []FeatureSpec      `json:"features,omitempty" yaml:"features,omitempty"`
+	APISpec             APISpec            `json:"api,omitempty" yaml:"api,omitempty"`
 }
 
 type SecretsSpec struct {
@@ -143,6 +154,7 @@ type TracingSpec struct {
 	SamplingRate string     `json:"samplingRate" yaml:"samplingRate"`
 	Stdout       bool       `json:"stdout" yaml:"stdout"`
 	Zipkin       ZipkinSpec `json:"zipkin" yaml:"zipkin"`
+	Otel         OtelSpec   `json:"otel" yaml:"otel"`
 }
 
 // ZipkinSpec defines Zipkin trace configurations.
@@ -150,9 +162,45 @@ type ZipkinSpec struct {
 	EndpointAddress string `json:"endpointAddress" yaml:"endpointAddress"`
 }
 
+// OtelSpec defines OpenTelemetry (OTLP) trace exporter configurations.
+type OtelSpec struct {
+	EndpointAddress string `json:"endpointAddress" yaml:"endpointAddress"`
+	// Protocol is either "grpc" (default) or "http".
+	Protocol string `json:"protocol" yaml:"protocol"`
+	Insecure bool   `json:"insecure" yaml:"insecure"`
+	// Headers are added to every export request, in the `key1=value1,key2=value2` format.
+	Headers string `json:"headers" yaml:"headers"`
+}
+
 // MetricSpec configuration for metrics.
 type MetricSpec struct {
-	Enabled bool `json:"enabled" yaml:"enabled"`
+	Enabled bool            `json:"enabled" yaml:"enabled"`
+	HTTP    *MetricHTTP     `json:"http,omitempty" yaml:"http,omitempty"`
+	Otel    *MetricOtelSpec `json:"otel,omitempty" yaml:"otel,omitempty"`
+}
+
+// MetricOtelSpec defines OpenTelemetry (OTLP) metrics exporter configurations.
+type MetricOtelSpec struct {
+	EndpointAddress string `json:"endpointAddress" yaml:"endpointAddress"`
+	// Protocol is either "grpc" (default) or "http".
+	Protocol string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Insecure bool   `json:"insecure,omitempty" yaml:"insecure,omitempty"`
+	// Headers are added to every export request, in the `key1=value1,key2=value2` format.
+	Headers string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Interval is the period between two pushes, such as 30s.
+	Interval string `json:"interval,omitempty" yaml:"interval,omitempty"`
+}
+
+// MetricHTTP defines the labels of the HTTP metrics.
+type MetricHTTP struct {
+	// PathTemplates are paths such as /orders/{id}, where a segment in braces matches any value.
+	// Paths matching a template are reported with the template as label.
+	PathTemplates []string `json:"pathTemplates,omitempty" yaml:"pathTemplates,omitempty"`
+	// ExcludePath removes the path label from the HTTP metrics.
+	ExcludePath bool `json:"excludePath,omitempty" yaml:"excludePath,omitempty"`
+	// MaxPathCardinality is the maximum number of distinct path labels of each metric.
+	// Additional paths are reported as "_other". Zero means no limit.
+	MaxPathCardinality int `json:"maxPathCardinality,omitempty" yaml:"maxPathCardinality,omitempty"`
 }
 
 // AppPolicySpec defines the policy data structure for each app.
@@ -162,13 +210,33 @@ type AppPolicySpec struct {
 	TrustDomain         string         `json:"trustDomain" yaml:"trustDomain"`
 	Namespace           string         `json:"namespace" yaml:"namespace"`
 	AppOperationActions []AppOperation `json:"operations" yaml:"operations"`
+	RateLimit           RateLimitSpec  `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
+	PubSubActions       []PubSubAction `json:"pubsub,omitempty" yaml:"pubsub,omitempty"`
+}
+
+// PubSubAction defines the action for publishing or subscribing to the topics of a pub/sub component.
+// An empty PubSubName matches all the pub/sub components, and an empty Topic matches all the topics.
+// The action applies to the listed operations (publish, subscribe or "*"), or to both if none are listed.
+type PubSubAction struct {
+	PubSubName string   `json:"pubsubName" yaml:"pubsubName"`
+	Topic      string   `json:"topic" yaml:"topic"`
+	Operations []string `json:"operations" yaml:"operations"`
+	Action     string   `json:"action" yaml:"action"`
 }
 
 // AppOperation defines the data structure for each app operation.
 type AppOperation struct {
-	Operation string   `json:"name" yaml:"name"`
-	HTTPVerb  []string `json:"httpVerb" yaml:"httpVerb"`
-	Action    string   `json:"action" yaml:"action"`
+	Operation string        `json:"name" yaml:"name"`
+	HTTPVerb  []string      `json:"httpVerb" yaml:"httpVerb"`
+	Action    string        `json:"action" yaml:"action"`
+	RateLimit RateLimitSpec `json:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
+}
+
+// RateLimitSpec defines the rate limit of the requests of a calling app.
+// A zero RequestsPerSecond means no limit. Burst defaults to RequestsPerSecond.
+type RateLimitSpec struct {
+	RequestsPerSecond int `json:"requestsPerSecond,omitempty" yaml:"requestsPerSecond,omitempty"`
+	Burst             int `json:"burst,omitempty" yaml:"burst,omitempty"`
 }
 
 // AccessControlSpec is the spec object in ConfigurationSpec.
@@ -344,6 +412,17 @@ func IsFeatureEnabled(features []FeatureSpec, target Feature) bool {
 	return false
 }
 
+// EnabledFeatures returns the names of the enabled features.
+func EnabledFeatures(features []FeatureSpec) []string {
+	enabled := make([]string, 0, len(features))
+	for _, feature := range features {
+		if feature.Enabled {
+			enabled = append(enabled, string(feature.Name))
+		}
+	}
+	return enabled
+}
+
 // GetNoDefaultContentType returns the value of the noDefaultContentType flag.
 // It requires the configuration to be loaded, otherwise it returns false.
 func GetNoDefaultContentType() bool {
@@ -316,6 +316,7 @@ func TestFeatureEnabled(t *testing.T) {
 		assert.True(t, IsFeatureEnabled(features, "testEnabled"))
 		assert.False(t, IsFeatureEnabled(features, "testDisabled"))
 		assert.False(t, IsFeatureEnabled(features, "testMissing"))
+		assert.Equal(t, []string{"testEnabled"}, EnabledFeatures(features))
 	})
 }
 
@@ -42,8 +42,9 @@ type componentMetrics struct {
 	outputBindingCount   *stats.Int64Measure
 	outputBindingLatency *stats.Float64Measure
 
-	stateCount   *stats.Int64Measure
-	stateLatency *stats.Float64Measure
+	stateCount       *stats.Int64Measure
+	stateLatency     *stats.Float64Measure
+	stateReEncrypted *stats.Int64Measure
 
 	configurationCount   *stats.Int64Measure
 	configurationLatency *stats.Float64Measure
@@ -99,6 +100,10 @@ func newComponentMetrics() *componentMetrics {
 			"component/state/latencies",
 			"The latency of the response from the state component.",
 			stats.UnitMilliseconds),
+		stateReEncrypted: stats.Int64(
+			"component/state/reencryption/count",
+			"The number of values scanned by the re-encryption job of the encrypted state component, by process status.",
+			stats.UnitDimensionless),
 		configurationCount: stats.Int64(
 			"component/configuration/count",
 			"The number of operations performed on the configuration component.",
@@ -135,6 +140,7 @@ func (c *componentMetrics) Init(appID, namespace string) error {
 		diag_utils.NewMeasureView(c.outputBindingCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
 		diag_utils.NewMeasureView(c.stateLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, defaultLatencyDistribution),
 		diag_utils.NewMeasureView(c.stateCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
+		diag_utils.NewMeasureView(c.stateReEncrypted, []tag.Key{appIDKey, componentKey, namespaceKey, processStatusKey}, view.Count()),
 		diag_utils.NewMeasureView(c.configurationLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, defaultLatencyDistribution),
 		diag_utils.NewMeasureView(c.configurationCount, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, view.Count()),
 		diag_utils.NewMeasureView(c.secretLatency, []tag.Key{appIDKey, componentKey, namespaceKey, operationKey, successKey}, defaultLatencyDistribution),
@@ -227,6 +233,17 @@ func (c *componentMetrics) StateInvoked(ctx context.Context, component, operatio
 	}
 }
 
+// StateReEncrypted records the metrics for a value scanned by the re-encryption job of a state component.
+// The process status is one of reencrypted, skipped and failed.
+func (c *componentMetrics) StateReEncrypted(ctx context.Context, component, processStatus string) {
+	if c.enabled {
+		stats.RecordWithTags(
+			ctx,
+			diag_utils.WithTags(appIDKey, c.appID, componentKey, component, namespaceKey, c.namespace, processStatusKey, processStatus),
+			c.stateReEncrypted.M(1))
+	}
+}
+
 // ConfigurationInvoked records the metrics for a configuration event.
 func (c *componentMetrics) ConfigurationInvoked(ctx context.Context, component, operation string, success bool, elapsed float64) {
 	if c.enabled {
@@ -156,6 +156,17 @@ func TestState(t *testing.T) {
 		allTagsPresent(t, v, viewData[0].Tags)
 		assert.Equal(t, float64(1), viewData[0].Data.(*view.DistributionData).Min)
 	})
+
+	t.Run("record state re-encryption", func(t *testing.T) {
+		c := componentsMetrics()
+
+		c.StateReEncrypted(context.Background(), componentName, "reencrypted")
+
+		viewData, _ := view.RetrieveData("component/state/reencryption/count")
+		v := view.Find("component/state/reencryption/count")
+
+		allTagsPresent(t, v, viewData[0].Tags)
+	})
 }
 
 func TestConfiguration(t *testing.T) {
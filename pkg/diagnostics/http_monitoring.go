@@ -17,6 +17,7 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/valyala/fasthttp"
@@ -24,6 +25,7 @@ import (
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
 
+	"github.com/dapr/dapr/pkg/config"
 	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
 )
 
@@ -37,6 +39,9 @@ var (
 	httpMethodKey     = tag.MustNewKey("method")
 )
 
+// otherPathLabel is the path label of the requests exceeding the path cardinality limit.
+const otherPathLabel = "_other"
+
 // Default distributions.
 var (
 	defaultSizeDistribution    = view.Distribution(1024, 2048, 4096, 16384, 65536, 262144, 1048576, 4194304, 16777216, 67108864, 268435456, 1073741824, 4294967296)
@@ -57,6 +62,18 @@ type httpMetrics struct {
 
 	appID   string
 	enabled bool
+
+	pathTemplates      []pathTemplate
+	excludePath        bool
+	maxPathCardinality int
+	pathLabels         map[string]map[string]struct{}
+	pathLabelsLock     sync.Mutex
+}
+
+// pathTemplate is a path such as /orders/{id}, where a segment in braces matches any value.
+type pathTemplate struct {
+	label    string
+	segments []string
 }
 
 func newHTTPMetrics() *httpMetrics {
@@ -110,7 +127,7 @@ func (h *httpMetrics) ServerRequestReceived(ctx context.Context, method, path st
 	if h.enabled {
 		stats.RecordWithTags(
 			ctx,
-			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, path, httpMethodKey, method),
+			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, h.limitPathLabel(h.serverRequestCount, path), httpMethodKey, method),
 			h.serverRequestCount.M(1))
 		stats.RecordWithTags(
 			ctx, diag_utils.WithTags(appIDKey, h.appID),
@@ -122,11 +139,11 @@ func (h *httpMetrics) ServerRequestCompleted(ctx context.Context, method, path,
 	if h.enabled {
 		stats.RecordWithTags(
 			ctx,
-			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, path, httpMethodKey, method, httpStatusCodeKey, status),
+			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, h.limitPathLabel(h.serverResponseCount, path), httpMethodKey, method, httpStatusCodeKey, status),
 			h.serverResponseCount.M(1))
 		stats.RecordWithTags(
 			ctx,
-			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, path, httpMethodKey, method, httpStatusCodeKey, status),
+			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, h.limitPathLabel(h.serverLatency, path), httpMethodKey, method, httpStatusCodeKey, status),
 			h.serverLatency.M(elapsed))
 		stats.RecordWithTags(
 			ctx, diag_utils.WithTags(appIDKey, h.appID),
@@ -136,22 +153,24 @@ func (h *httpMetrics) ServerRequestCompleted(ctx context.Context, method, path,
 
 func (h *httpMetrics) ClientRequestStarted(ctx context.Context, method, path string, contentSize int64) {
 	if h.enabled {
+		path = h.convertPathToMetricLabel(path)
 		stats.RecordWithTags(
 			ctx,
-			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, h.convertPathToMetricLabel(path), httpMethodKey, method),
+			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, h.limitPathLabel(h.clientSentBytes, path), httpMethodKey, method),
 			h.clientSentBytes.M(contentSize))
 	}
 }
 
 func (h *httpMetrics) ClientRequestCompleted(ctx context.Context, method, path, status string, contentSize int64, elapsed float64) {
 	if h.enabled {
+		path = h.convertPathToMetricLabel(path)
 		stats.RecordWithTags(
 			ctx,
-			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, h.convertPathToMetricLabel(path), httpMethodKey, method, httpStatusCodeKey, status),
+			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, h.limitPathLabel(h.clientCompletedCount, path), httpMethodKey, method, httpStatusCodeKey, status),
 			h.clientCompletedCount.M(1))
 		stats.RecordWithTags(
 			ctx,
-			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, h.convertPathToMetricLabel(path), httpMethodKey, method, httpStatusCodeKey, status),
+			diag_utils.WithTags(appIDKey, h.appID, httpPathKey, h.limitPathLabel(h.clientRoundtripLatency, path), httpMethodKey, method, httpStatusCodeKey, status),
 			h.clientRoundtripLatency.M(elapsed))
 		stats.RecordWithTags(
 			ctx, diag_utils.WithTags(appIDKey, h.appID),
@@ -159,24 +178,79 @@ func (h *httpMetrics) ClientRequestCompleted(ctx context.Context, method, path,
 	}
 }
 
-func (h *httpMetrics) Init(appID string) error {
+// Init initializes the metrics views. The path label of the views is configured by spec.
+func (h *httpMetrics) Init(appID string, spec *config.MetricHTTP) error {
 	h.appID = appID
 	h.enabled = true
 
+	h.pathTemplates = nil
+	h.excludePath = false
+	h.maxPathCardinality = 0
+	h.pathLabels = map[string]map[string]struct{}{}
+	if spec != nil {
+		for _, t := range spec.PathTemplates {
+			h.pathTemplates = append(h.pathTemplates, pathTemplate{
+				label:    t,
+				segments: splitPath(t),
+			})
+		}
+		h.excludePath = spec.ExcludePath
+		h.maxPathCardinality = spec.MaxPathCardinality
+	}
+
 	tags := []tag.Key{appIDKey}
 	return view.Register(
-		diag_utils.NewMeasureView(h.serverRequestCount, []tag.Key{appIDKey, httpPathKey, httpMethodKey}, view.Count()),
+		diag_utils.NewMeasureView(h.serverRequestCount, h.withPathKey(appIDKey, httpPathKey, httpMethodKey), view.Count()),
 		diag_utils.NewMeasureView(h.serverRequestBytes, tags, defaultSizeDistribution),
 		diag_utils.NewMeasureView(h.serverResponseBytes, tags, defaultSizeDistribution),
-		diag_utils.NewMeasureView(h.serverLatency, []tag.Key{appIDKey, httpMethodKey, httpPathKey, httpStatusCodeKey}, defaultLatencyDistribution),
-		diag_utils.NewMeasureView(h.serverResponseCount, []tag.Key{appIDKey, httpMethodKey, httpPathKey, httpStatusCodeKey}, view.Count()),
-		diag_utils.NewMeasureView(h.clientSentBytes, []tag.Key{appIDKey, httpMethodKey, httpPathKey, httpStatusCodeKey}, defaultSizeDistribution),
+		diag_utils.NewMeasureView(h.serverLatency, h.withPathKey(appIDKey, httpMethodKey, httpPathKey, httpStatusCodeKey), defaultLatencyDistribution),
+		diag_utils.NewMeasureView(h.serverResponseCount, h.withPathKey(appIDKey, httpMethodKey, httpPathKey, httpStatusCodeKey), view.Count()),
+		diag_utils.NewMeasureView(h.clientSentBytes, h.withPathKey(appIDKey, httpMethodKey, httpPathKey, httpStatusCodeKey), defaultSizeDistribution),
 		diag_utils.NewMeasureView(h.clientReceivedBytes, tags, defaultSizeDistribution),
-		diag_utils.NewMeasureView(h.clientRoundtripLatency, []tag.Key{appIDKey, httpMethodKey, httpPathKey, httpStatusCodeKey}, defaultLatencyDistribution),
-		diag_utils.NewMeasureView(h.clientCompletedCount, []tag.Key{appIDKey, httpMethodKey, httpPathKey, httpStatusCodeKey}, view.Count()),
+		diag_utils.NewMeasureView(h.clientRoundtripLatency, h.withPathKey(appIDKey, httpMethodKey, httpPathKey, httpStatusCodeKey), defaultLatencyDistribution),
+		diag_utils.NewMeasureView(h.clientCompletedCount, h.withPathKey(appIDKey, httpMethodKey, httpPathKey, httpStatusCodeKey), view.Count()),
 	)
 }
 
+// withPathKey returns the tag keys, without the path key if the path label is excluded.
+func (h *httpMetrics) withPathKey(keys ...tag.Key) []tag.Key {
+	if !h.excludePath {
+		return keys
+	}
+	res := make([]tag.Key, 0, len(keys))
+	for _, k := range keys {
+		if k != httpPathKey {
+			res = append(res, k)
+		}
+	}
+	return res
+}
+
+// limitPathLabel returns the path label of the measure, or _other if the measure already has
+// the maximum number of distinct path labels.
+func (h *httpMetrics) limitPathLabel(measure stats.Measure, path string) string {
+	if h.maxPathCardinality <= 0 || path == "" {
+		return path
+	}
+
+	h.pathLabelsLock.Lock()
+	defer h.pathLabelsLock.Unlock()
+
+	labels, ok := h.pathLabels[measure.Name()]
+	if !ok {
+		labels = map[string]struct{}{}
+		h.pathLabels[measure.Name()] = labels
+	}
+	if _, ok := labels[path]; ok {
+		return path
+	}
+	if len(labels) >= h.maxPathCardinality {
+		return otherPathLabel
+	}
+	labels[path] = struct{}{}
+	return path
+}
+
 // FastHTTPMiddleware is the middleware to track http server-side requests.
 func (h *httpMetrics) FastHTTPMiddleware(next fasthttp.RequestHandler) fasthttp.RequestHandler {
 	return func(ctx *fasthttp.RequestCtx) {
@@ -203,9 +277,19 @@ func (h *httpMetrics) FastHTTPMiddleware(next fasthttp.RequestHandler) fasthttp.
 
 // convertPathToMetricLabel removes the variant parameters in URL path for low cardinality label space
 // For example, it removes {keys} param from /v1/state/statestore/{keys}.
+// Paths matching a configured path template are reported with the template as label.
 func (h *httpMetrics) convertPathToMetricLabel(path string) string {
-	if path == "" {
-		return path
+	if path == "" || h.excludePath {
+		return ""
+	}
+
+	if len(h.pathTemplates) > 0 {
+		segments := splitPath(path)
+		for _, t := range h.pathTemplates {
+			if t.match(segments) {
+				return t.label
+			}
+		}
 	}
 
 	p := path
@@ -244,3 +328,24 @@ func (h *httpMetrics) convertPathToMetricLabel(path string) string {
 
 	return path
 }
+
+func (t pathTemplate) match(segments []string) bool {
+	if len(segments) != len(t.segments) {
+		return false
+	}
+	for i, s := range t.segments {
+		if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+			continue
+		}
+		if s != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// splitPath returns the segments of a URL path, without the query string.
+func splitPath(path string) []string {
+	path, _, _ = strings.Cut(path, "?")
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
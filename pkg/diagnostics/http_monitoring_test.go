@@ -8,6 +8,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/valyala/fasthttp"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/dapr/dapr/pkg/config"
 )
 
 func TestFastHTTPMiddleware(t *testing.T) {
@@ -23,7 +26,7 @@ func TestFastHTTPMiddleware(t *testing.T) {
 
 	// create test httpMetrics
 	testHTTP := newHTTPMetrics()
-	testHTTP.Init("fakeID")
+	testHTTP.Init("fakeID", nil)
 
 	handler := testHTTP.FastHTTPMiddleware(fakeHandler)
 
@@ -74,7 +77,7 @@ func TestFastHTTPMiddlewareWhenMetricsDisabled(t *testing.T) {
 	testHTTP := newHTTPMetrics()
 	testHTTP.enabled = false
 
-	testHTTP.Init("fakeID")
+	testHTTP.Init("fakeID", nil)
 	v := view.Find("http/server/request_count")
 	views := []*view.View{v}
 	view.Unregister(views...)
@@ -121,6 +124,54 @@ func TestConvertPathToMethodName(t *testing.T) {
 	}
 }
 
+func TestConvertPathToMetricLabelWithTemplates(t *testing.T) {
+	testHTTP := newHTTPMetrics()
+	testHTTP.Init("fakeID", &config.MetricHTTP{
+		PathTemplates: []string{
+			"/v1.0/invoke/{app}/method/orders/{id}",
+			"/orders/{id}/items",
+		},
+	})
+
+	convertTests := []struct {
+		in  string
+		out string
+	}{
+		{"/v1.0/invoke/checkout/method/orders/1234", "/v1.0/invoke/{app}/method/orders/{id}"},
+		{"/v1.0/invoke/checkout/method/orders/1234?a=b", "/v1.0/invoke/{app}/method/orders/{id}"},
+		{"/orders/1234/items", "/orders/{id}/items"},
+		{"/orders/1234", "/orders/1234"},
+		{"/v1/state/statestore/key", "/v1/state/statestore"},
+	}
+	for _, tt := range convertTests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.out, testHTTP.convertPathToMetricLabel(tt.in))
+		})
+	}
+}
+
+func TestExcludePathLabel(t *testing.T) {
+	testHTTP := newHTTPMetrics()
+	testHTTP.excludePath = true
+
+	assert.Equal(t, "", testHTTP.convertPathToMetricLabel("/v1/state/statestore/key"))
+	assert.Equal(t, []tag.Key{appIDKey, httpMethodKey}, testHTTP.withPathKey(appIDKey, httpPathKey, httpMethodKey))
+}
+
+func TestLimitPathLabel(t *testing.T) {
+	testHTTP := newHTTPMetrics()
+	testHTTP.maxPathCardinality = 2
+	testHTTP.pathLabels = map[string]map[string]struct{}{}
+
+	assert.Equal(t, "/a", testHTTP.limitPathLabel(testHTTP.serverRequestCount, "/a"))
+	assert.Equal(t, "/b", testHTTP.limitPathLabel(testHTTP.serverRequestCount, "/b"))
+	assert.Equal(t, otherPathLabel, testHTTP.limitPathLabel(testHTTP.serverRequestCount, "/c"))
+	assert.Equal(t, "/a", testHTTP.limitPathLabel(testHTTP.serverRequestCount, "/a"))
+
+	// The limit applies to each metric separately.
+	assert.Equal(t, "/c", testHTTP.limitPathLabel(testHTTP.serverLatency, "/c"))
+}
+
 func fakeFastHTTPRequestCtx(expectedBody string) *fasthttp.RequestCtx {
 	expectedMethod := fasthttp.MethodPost
 	expectedRequestURI := "/invoke/method/testmethod"
@@ -18,6 +18,8 @@ import (
 
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
+
+	"github.com/dapr/dapr/pkg/config"
 )
 
 // appIDKey is a tag key for App ID.
@@ -35,10 +37,12 @@ var (
 	DefaultHTTPMonitoring = newHTTPMetrics()
 	// DefaultComponentMonitoring holds component specific metrics.
 	DefaultComponentMonitoring = newComponentMetrics()
+	// DefaultResiliencyMonitoring holds resiliency policies metrics.
+	DefaultResiliencyMonitoring = newResiliencyMetrics()
 )
 
 // InitMetrics initializes metrics.
-func InitMetrics(appID, namespace string) error {
+func InitMetrics(appID, namespace string, spec config.MetricSpec) error {
 	if err := DefaultMonitoring.Init(appID); err != nil {
 		return err
 	}
@@ -47,7 +51,7 @@ func InitMetrics(appID, namespace string) error {
 		return err
 	}
 
-	if err := DefaultHTTPMonitoring.Init(appID); err != nil {
+	if err := DefaultHTTPMonitoring.Init(appID, spec.HTTP); err != nil {
 		return err
 	}
 
@@ -55,6 +59,10 @@ func InitMetrics(appID, namespace string) error {
 		return err
 	}
 
+	if err := DefaultResiliencyMonitoring.Init(appID); err != nil {
+		return err
+	}
+
 	// Set reporting period of views
 	view.SetReportingPeriod(DefaultReportingPeriod)
 
@@ -0,0 +1,94 @@
+package diagnostics
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
+)
+
+var (
+	policyKey  = tag.MustNewKey("policy")
+	cbStateKey = tag.MustNewKey("state")
+	targetKey  = tag.MustNewKey("target")
+)
+
+// resiliencyMetrics holds the resiliency policies metric monitoring methods.
+type resiliencyMetrics struct {
+	circuitBreakerStateChanged *stats.Int64Measure
+	retriesAttempted           *stats.Int64Measure
+	timeoutsHit                *stats.Int64Measure
+
+	appID   string
+	ctx     context.Context
+	enabled bool
+}
+
+// newResiliencyMetrics returns resiliencyMetrics instance with default resiliency metric stats.
+func newResiliencyMetrics() *resiliencyMetrics {
+	return &resiliencyMetrics{
+		circuitBreakerStateChanged: stats.Int64(
+			"resiliency/circuitbreaker/state_changes_total",
+			"The number of circuit breaker state transitions, by new state.",
+			stats.UnitDimensionless),
+		retriesAttempted: stats.Int64(
+			"resiliency/retries_total",
+			"The number of retries attempted by resiliency policies.",
+			stats.UnitDimensionless),
+		timeoutsHit: stats.Int64(
+			"resiliency/timeouts_total",
+			"The number of operations canceled by resiliency timeout policies.",
+			stats.UnitDimensionless),
+
+		// TODO: use the correct context for each request
+		ctx:     context.Background(),
+		enabled: false,
+	}
+}
+
+// Init initialize metrics views for metrics.
+func (r *resiliencyMetrics) Init(appID string) error {
+	r.appID = appID
+	r.enabled = true
+	return view.Register(
+		diag_utils.NewMeasureView(r.circuitBreakerStateChanged, []tag.Key{appIDKey, policyKey, targetKey, cbStateKey}, view.Count()),
+		diag_utils.NewMeasureView(r.retriesAttempted, []tag.Key{appIDKey, targetKey}, view.Count()),
+		diag_utils.NewMeasureView(r.timeoutsHit, []tag.Key{appIDKey, targetKey}, view.Count()),
+	)
+}
+
+// CircuitBreakerStateChanged records the transition of a circuit breaker of the policy and target to a new state.
+// The state is one of closed, half-open and open.
+// The circuit breaker instances of a target, such as the ones of its actors, are not distinguished to bound the cardinality,
+// so the transitions are only counted: the state of a single instance can't be told apart from the others.
+func (r *resiliencyMetrics) CircuitBreakerStateChanged(policy string, target string, state string) {
+	if r.enabled {
+		stats.RecordWithTags(
+			r.ctx,
+			diag_utils.WithTags(appIDKey, r.appID, policyKey, policy, targetKey, target, cbStateKey, state),
+			r.circuitBreakerStateChanged.M(1))
+	}
+}
+
+// RetryAttempted records metric when an operation of the target is retried.
+func (r *resiliencyMetrics) RetryAttempted(target string) {
+	if r.enabled {
+		stats.RecordWithTags(
+			r.ctx,
+			diag_utils.WithTags(appIDKey, r.appID, targetKey, target),
+			r.retriesAttempted.M(1))
+	}
+}
+
+// TimeoutHit records metric when an operation of the target times out.
+func (r *resiliencyMetrics) TimeoutHit(target string) {
+	if r.enabled {
+		stats.RecordWithTags(
+			r.ctx,
+			diag_utils.WithTags(appIDKey, r.appID, targetKey, target),
+			r.timeoutsHit.M(1))
+	}
+}
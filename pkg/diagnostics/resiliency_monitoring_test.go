@@ -0,0 +1,54 @@
+package diagnostics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+)
+
+func resiliencyMetricsForTest() *resiliencyMetrics {
+	r := newResiliencyMetrics()
+	r.Init("test")
+
+	return r
+}
+
+func TestResiliencyMetrics(t *testing.T) {
+	t.Run("record circuit breaker state change", func(t *testing.T) {
+		r := resiliencyMetricsForTest()
+
+		r.CircuitBreakerStateChanged("cb", "component-statestore", "open")
+
+		viewData, _ := view.RetrieveData("resiliency/circuitbreaker/state_changes_total")
+		v := view.Find("resiliency/circuitbreaker/state_changes_total")
+		require.NotEmpty(t, viewData)
+		allTagsPresent(t, v, viewData[0].Tags)
+
+		// Only the transitions are recorded, as the instances of a target share the tags.
+		assert.Nil(t, view.Find("resiliency/circuitbreaker/state"))
+	})
+
+	t.Run("record retry", func(t *testing.T) {
+		r := resiliencyMetricsForTest()
+
+		r.RetryAttempted("component-statestore-outbound")
+
+		viewData, _ := view.RetrieveData("resiliency/retries_total")
+		v := view.Find("resiliency/retries_total")
+		require.NotEmpty(t, viewData)
+		allTagsPresent(t, v, viewData[0].Tags)
+	})
+
+	t.Run("record timeout", func(t *testing.T) {
+		r := resiliencyMetricsForTest()
+
+		r.TimeoutHit("app-appB")
+
+		viewData, _ := view.RetrieveData("resiliency/timeouts_total")
+		v := view.Find("resiliency/timeouts_total")
+		require.NotEmpty(t, viewData)
+		allTagsPresent(t, v, viewData[0].Tags)
+	})
+}
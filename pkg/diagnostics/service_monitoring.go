@@ -48,6 +48,11 @@ type serviceMetrics struct {
 	globalPolicyActionAllowed *stats.Int64Measure
 	appPolicyActionBlocked    *stats.Int64Measure
 	globalPolicyActionBlocked *stats.Int64Measure
+	appPolicyRateLimited      *stats.Int64Measure
+
+	// App health checks metrics
+	appHealthStatus           *stats.Int64Measure
+	appHealthProbeFailedTotal *stats.Int64Measure
 
 	appID   string
 	ctx     context.Context
@@ -136,6 +141,20 @@ func newServiceMetrics() *serviceMetrics {
 			"runtime/acl/global_policy_action_blocked_total",
 			"The number of requests blocked by the global action specified in the access control policy.",
 			stats.UnitDimensionless),
+		appPolicyRateLimited: stats.Int64(
+			"runtime/acl/app_policy_rate_limited_total",
+			"The number of requests rejected by the rate limits specified in the access control policy for the app.",
+			stats.UnitDimensionless),
+
+		// App health checks
+		appHealthStatus: stats.Int64(
+			"runtime/app/health_status",
+			"The health status of the app: 1 if healthy, 0 if unhealthy.",
+			stats.UnitDimensionless),
+		appHealthProbeFailedTotal: stats.Int64(
+			"runtime/app/health_probe_fail_total",
+			"The number of failed app health probes.",
+			stats.UnitDimensionless),
 
 		// TODO: use the correct context for each request
 		ctx:     context.Background(),
@@ -169,6 +188,10 @@ func (s *serviceMetrics) Init(appID string) error {
 		diag_utils.NewMeasureView(s.globalPolicyActionAllowed, []tag.Key{appIDKey, trustDomainKey, namespaceKey, operationKey, httpMethodKey, policyActionKey}, view.Count()),
 		diag_utils.NewMeasureView(s.appPolicyActionBlocked, []tag.Key{appIDKey, trustDomainKey, namespaceKey, operationKey, httpMethodKey, policyActionKey}, view.Count()),
 		diag_utils.NewMeasureView(s.globalPolicyActionBlocked, []tag.Key{appIDKey, trustDomainKey, namespaceKey, operationKey, httpMethodKey, policyActionKey}, view.Count()),
+		diag_utils.NewMeasureView(s.appPolicyRateLimited, []tag.Key{appIDKey, trustDomainKey, namespaceKey, operationKey, httpMethodKey}, view.Count()),
+
+		diag_utils.NewMeasureView(s.appHealthStatus, []tag.Key{appIDKey}, view.LastValue()),
+		diag_utils.NewMeasureView(s.appHealthProbeFailedTotal, []tag.Key{appIDKey}, view.Count()),
 	)
 }
 
@@ -361,3 +384,36 @@ func (s *serviceMetrics) RequestBlockedByGlobalAction(appID, trustDomain, namesp
 			s.globalPolicyActionBlocked.M(1))
 	}
 }
+
+// RequestRateLimitedByAppPolicy records the requests rejected due to the rate limits in the access control policy for the app.
+func (s *serviceMetrics) RequestRateLimitedByAppPolicy(appID, trustDomain, namespace, operation, httpverb string) {
+	if s.enabled {
+		stats.RecordWithTags(
+			s.ctx,
+			diag_utils.WithTags(
+				appIDKey, appID,
+				trustDomainKey, trustDomain,
+				namespaceKey, namespace,
+				operationKey, operation,
+				httpMethodKey, httpverb),
+			s.appPolicyRateLimited.M(1))
+	}
+}
+
+// AppHealthStatusChanged records the health status of the app when it changes.
+func (s *serviceMetrics) AppHealthStatusChanged(healthy bool) {
+	if s.enabled {
+		var v int64
+		if healthy {
+			v = 1
+		}
+		stats.RecordWithTags(s.ctx, diag_utils.WithTags(appIDKey, s.appID), s.appHealthStatus.M(v))
+	}
+}
+
+// AppHealthProbeFailed records metric when an app health probe fails.
+func (s *serviceMetrics) AppHealthProbeFailed() {
+	if s.enabled {
+		stats.RecordWithTags(s.ctx, diag_utils.WithTags(appIDKey, s.appID), s.appHealthProbeFailedTotal.M(1))
+	}
+}
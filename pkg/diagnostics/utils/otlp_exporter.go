@@ -0,0 +1,422 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opencensus.io/trace"
+	"go.opencensus.io/trace/tracestate"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// OTLPProtocolGRPC ships spans with the OTLP/gRPC protocol.
+	OTLPProtocolGRPC = "grpc"
+	// OTLPProtocolHTTP ships spans with the OTLP/HTTP protocol using protobuf payloads.
+	OTLPProtocolHTTP = "http"
+
+	otlpTraceServiceMethod = "/opentelemetry.proto.collector.trace.v1.TraceService/Export"
+	otlpHTTPTracesPath     = "/v1/traces"
+	otlpScopeName          = "dapr"
+
+	otlpExportTimeout = 10 * time.Second
+	otlpFlushInterval = 5 * time.Second
+	otlpMaxBatchSize  = 512
+	otlpMaxQueueSize  = 2048
+)
+
+// OTLPExporterOptions configures the OTLP trace exporter.
+type OTLPExporterOptions struct {
+	// ServiceName is reported as the `service.name` resource attribute.
+	ServiceName string
+	// Endpoint is the collector address. For gRPC it is `host:port`, for HTTP it can also be a full URL.
+	Endpoint string
+	// Protocol is either "grpc" (default) or "http".
+	Protocol string
+	// Insecure disables TLS on the connection to the collector.
+	Insecure bool
+	// Headers are sent with every export request.
+	Headers map[string]string
+}
+
+// OTLPExporter is an open census exporter that ships spans to an OpenTelemetry collector over OTLP.
+// Spans are batched in memory and exported in the background.
+type OTLPExporter struct {
+	opts   OTLPExporterOptions
+	client *otlpClient
+
+	spansCh   chan *trace.SpanData
+	closeCh   chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+var _ trace.Exporter = &OTLPExporter{}
+
+// NewOTLPExporter creates a new OTLP exporter and starts its background export loop.
+func NewOTLPExporter(opts OTLPExporterOptions) (*OTLPExporter, error) {
+	if opts.Endpoint == "" {
+		return nil, fmt.Errorf("otlp endpoint address is required")
+	}
+	if opts.Protocol == "" {
+		opts.Protocol = OTLPProtocolGRPC
+	}
+
+	client, err := newOTLPClient(opts.Endpoint, opts.Protocol, opts.Insecure, opts.Headers, otlpTraceServiceMethod, otlpHTTPTracesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &OTLPExporter{
+		opts:    opts,
+		client:  client,
+		spansCh: make(chan *trace.SpanData, otlpMaxQueueSize),
+		closeCh: make(chan struct{}),
+	}
+
+	e.wg.Add(1)
+	go e.run()
+	return e, nil
+}
+
+// otlpClient sends encoded OTLP export requests to a collector, over gRPC or HTTP.
+type otlpClient struct {
+	grpcConn   *grpc.ClientConn
+	grpcMethod string
+	httpClient *http.Client
+	httpURL    string
+	headers    map[string]string
+}
+
+func newOTLPClient(endpoint, protocol string, useInsecure bool, headers map[string]string, grpcMethod, httpPath string) (*otlpClient, error) {
+	c := &otlpClient{
+		grpcMethod: grpcMethod,
+		headers:    headers,
+	}
+
+	switch strings.ToLower(protocol) {
+	case OTLPProtocolGRPC:
+		creds := credentials.NewTLS(&tls.Config{
+			MinVersion: tls.VersionTLS12,
+		})
+		if useInsecure {
+			creds = insecure.NewCredentials()
+		}
+		conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create otlp grpc connection: %w", err)
+		}
+		c.grpcConn = conn
+	case OTLPProtocolHTTP:
+		u, err := otlpHTTPURL(endpoint, useInsecure, httpPath)
+		if err != nil {
+			return nil, err
+		}
+		c.httpURL = u
+		c.httpClient = &http.Client{Timeout: otlpExportTimeout}
+	default:
+		return nil, fmt.Errorf("unsupported otlp protocol %q", protocol)
+	}
+	return c, nil
+}
+
+// send sends the export request, encoded as protobuf.
+// The response of the collector is not used, its fields are discarded as unknown fields.
+func (c *otlpClient) send(ctx context.Context, req proto.Message) error {
+	if c.grpcConn != nil {
+		if len(c.headers) > 0 {
+			ctx = metadata.NewOutgoingContext(ctx, metadata.New(c.headers))
+		}
+		return c.grpcConn.Invoke(ctx, c.grpcMethod, req, &emptypb.Empty{})
+	}
+
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.httpURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range c.headers {
+		httpReq.Header.Set(k, v)
+	}
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("otlp collector responded with status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (c *otlpClient) close() {
+	if c.grpcConn != nil {
+		c.grpcConn.Close()
+	}
+}
+
+// ParseOTLPHeaders parses headers in the `key1=value1,key2=value2` format
+// used by the OTEL_EXPORTER_OTLP_HEADERS environment variable.
+func ParseOTLPHeaders(val string) map[string]string {
+	headers := map[string]string{}
+	for _, pair := range strings.Split(val, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			continue
+		}
+		headers[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+	return headers
+}
+
+func otlpHTTPURL(endpoint string, insecure bool, defaultPath string) (string, error) {
+	if !strings.Contains(endpoint, "://") {
+		scheme := "https://"
+		if insecure {
+			scheme = "http://"
+		}
+		endpoint = scheme + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid otlp endpoint address %s: %w", endpoint, err)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = defaultPath
+	}
+	return u.String(), nil
+}
+
+// ExportSpan implements the open census exporter interface.
+// The span is queued for export and dropped if the queue is full.
+func (e *OTLPExporter) ExportSpan(sd *trace.SpanData) {
+	select {
+	case <-e.closeCh:
+	case e.spansCh <- sd:
+	default:
+		log.Debugf("otlp exporter queue is full, dropping span %s", sd.SpanID)
+	}
+}
+
+// Close flushes the queued spans and releases the exporter's resources.
+func (e *OTLPExporter) Close() error {
+	e.closeOnce.Do(func() {
+		close(e.closeCh)
+		e.wg.Wait()
+		e.client.close()
+	})
+	return nil
+}
+
+func (e *OTLPExporter) run() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(otlpFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*trace.SpanData, 0, otlpMaxBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := e.export(batch); err != nil {
+			log.Warnf("failed to export %d spans over otlp: %s", len(batch), err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case sd := <-e.spansCh:
+			batch = append(batch, sd)
+			if len(batch) >= otlpMaxBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-e.closeCh:
+			for {
+				select {
+				case sd := <-e.spansCh:
+					batch = append(batch, sd)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (e *OTLPExporter) export(spans []*trace.SpanData) error {
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+
+	return e.client.send(ctx, otlpTraceRequest(e.opts.ServiceName, spans))
+}
+
+// otlpTraceRequest converts the spans to an OTLP ExportTraceServiceRequest message.
+// TracesData has the same wire format as ExportTraceServiceRequest, and doesn't depend on the gRPC gateway
+// that the collector package pulls in.
+func otlpTraceRequest(serviceName string, spans []*trace.SpanData) *tracepb.TracesData {
+	scopeSpans := &tracepb.ScopeSpans{
+		Scope: &commonpb.InstrumentationScope{Name: otlpScopeName},
+		Spans: make([]*tracepb.Span, len(spans)),
+	}
+	for i, sd := range spans {
+		scopeSpans.Spans[i] = otlpSpan(sd)
+	}
+
+	return &tracepb.TracesData{
+		ResourceSpans: []*tracepb.ResourceSpans{
+			{
+				Resource:   otlpResource(serviceName),
+				ScopeSpans: []*tracepb.ScopeSpans{scopeSpans},
+			},
+		},
+	}
+}
+
+func otlpResource(serviceName string) *resourcepb.Resource {
+	return &resourcepb.Resource{
+		Attributes: []*commonpb.KeyValue{otlpKeyValue("service.name", serviceName)},
+	}
+}
+
+func otlpSpan(sd *trace.SpanData) *tracepb.Span {
+	span := &tracepb.Span{
+		TraceId:                sd.TraceID[:],
+		SpanId:                 sd.SpanID[:],
+		TraceState:             encodeTracestate(sd.Tracestate),
+		Name:                   sd.Name,
+		Kind:                   otlpSpanKind(sd.SpanKind),
+		StartTimeUnixNano:      uint64(sd.StartTime.UnixNano()),
+		EndTimeUnixNano:        uint64(sd.EndTime.UnixNano()),
+		Attributes:             otlpAttributes(sd.Attributes),
+		DroppedAttributesCount: uint32(sd.DroppedAttributeCount),
+		DroppedEventsCount:     uint32(sd.DroppedAnnotationCount + sd.DroppedMessageEventCount),
+		DroppedLinksCount:      uint32(sd.DroppedLinkCount),
+		Status:                 &tracepb.Status{Message: sd.Status.Message},
+	}
+	if sd.ParentSpanID != (trace.SpanID{}) {
+		span.ParentSpanId = sd.ParentSpanID[:]
+	}
+	// OpenCensus uses gRPC status codes, any non-OK code is an error in OpenTelemetry.
+	if sd.Status.Code != 0 {
+		span.Status.Code = tracepb.Status_STATUS_CODE_ERROR
+	}
+
+	for _, a := range sd.Annotations {
+		span.Events = append(span.Events, &tracepb.Span_Event{
+			TimeUnixNano: uint64(a.Time.UnixNano()),
+			Name:         a.Message,
+			Attributes:   otlpAttributes(a.Attributes),
+		})
+	}
+	for _, m := range sd.MessageEvents {
+		name := "message.received"
+		if m.EventType == trace.MessageEventTypeSent {
+			name = "message.sent"
+		}
+		span.Events = append(span.Events, &tracepb.Span_Event{
+			TimeUnixNano: uint64(m.Time.UnixNano()),
+			Name:         name,
+			Attributes: []*commonpb.KeyValue{
+				otlpKeyValue("message.id", m.MessageID),
+				otlpKeyValue("message.uncompressed_size", m.UncompressedByteSize),
+				otlpKeyValue("message.compressed_size", m.CompressedByteSize),
+			},
+		})
+	}
+	for _, l := range sd.Links {
+		span.Links = append(span.Links, &tracepb.Span_Link{
+			TraceId:    l.TraceID[:],
+			SpanId:     l.SpanID[:],
+			Attributes: otlpAttributes(l.Attributes),
+		})
+	}
+	return span
+}
+
+// otlpSpanKind maps an OpenCensus span kind to the OTLP span kind.
+func otlpSpanKind(kind int) tracepb.Span_SpanKind {
+	switch kind {
+	case trace.SpanKindServer:
+		return tracepb.Span_SPAN_KIND_SERVER
+	case trace.SpanKindClient:
+		return tracepb.Span_SPAN_KIND_CLIENT
+	default:
+		return tracepb.Span_SPAN_KIND_INTERNAL
+	}
+}
+
+func encodeTracestate(ts *tracestate.Tracestate) string {
+	entries := ts.Entries()
+	pairs := make([]string, len(entries))
+	for i, e := range entries {
+		pairs[i] = e.Key + "=" + e.Value
+	}
+	return strings.Join(pairs, ",")
+}
+
+func otlpAttributes(attributes map[string]interface{}) []*commonpb.KeyValue {
+	if len(attributes) == 0 {
+		return nil
+	}
+	kvs := make([]*commonpb.KeyValue, 0, len(attributes))
+	for k, v := range attributes {
+		kvs = append(kvs, otlpKeyValue(k, v))
+	}
+	return kvs
+}
+
+func otlpKeyValue(key string, val interface{}) *commonpb.KeyValue {
+	value := &commonpb.AnyValue{}
+	switch v := val.(type) {
+	case string:
+		value.Value = &commonpb.AnyValue_StringValue{StringValue: v}
+	case bool:
+		value.Value = &commonpb.AnyValue_BoolValue{BoolValue: v}
+	case int64:
+		value.Value = &commonpb.AnyValue_IntValue{IntValue: v}
+	case float64:
+		value.Value = &commonpb.AnyValue_DoubleValue{DoubleValue: v}
+	default:
+		value.Value = &commonpb.AnyValue_StringValue{StringValue: fmt.Sprintf("%v", v)}
+	}
+	return &commonpb.KeyValue{Key: key, Value: value}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseOTLPHeaders(t *testing.T) {
+	headers := ParseOTLPHeaders("api-key=secret, x-tenant = dapr,invalid,=novalue")
+	assert.Equal(t, map[string]string{
+		"api-key":  "secret",
+		"x-tenant": "dapr",
+	}, headers)
+	assert.Empty(t, ParseOTLPHeaders(""))
+}
+
+func TestOTLPHTTPURL(t *testing.T) {
+	u, err := otlpHTTPURL("localhost:4318", true, otlpHTTPTracesPath)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:4318/v1/traces", u)
+
+	u, err = otlpHTTPURL("localhost:4318", false, otlpHTTPTracesPath)
+	require.NoError(t, err)
+	assert.Equal(t, "https://localhost:4318/v1/traces", u)
+
+	u, err = otlpHTTPURL("http://collector:4318/custom/traces", false, otlpHTTPTracesPath)
+	require.NoError(t, err)
+	assert.Equal(t, "http://collector:4318/custom/traces", u)
+}
+
+func TestOTLPExporter(t *testing.T) {
+	t.Run("unsupported protocol", func(t *testing.T) {
+		_, err := NewOTLPExporter(OTLPExporterOptions{Endpoint: "localhost:4317", Protocol: "udp"})
+		assert.Error(t, err)
+	})
+
+	t.Run("missing endpoint", func(t *testing.T) {
+		_, err := NewOTLPExporter(OTLPExporterOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("spans are exported over http on close", func(t *testing.T) {
+		reqCh := make(chan *http.Request, 1)
+		bodyCh := make(chan []byte, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			reqCh <- r
+			bodyCh <- body
+		}))
+		defer server.Close()
+
+		exporter, err := NewOTLPExporter(OTLPExporterOptions{
+			ServiceName: "myapp",
+			Endpoint:    server.URL,
+			Protocol:    OTLPProtocolHTTP,
+			Headers:     map[string]string{"api-key": "secret"},
+		})
+		require.NoError(t, err)
+
+		sd := &trace.SpanData{
+			SpanContext: trace.SpanContext{
+				TraceID: trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+				SpanID:  trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+			},
+			Name:       "CallLocal/myapp/mymethod",
+			SpanKind:   trace.SpanKindServer,
+			StartTime:  time.Now().Add(-time.Second),
+			EndTime:    time.Now(),
+			Attributes: map[string]interface{}{"rpc.service": "ServiceInvocation"},
+		}
+		exporter.ExportSpan(sd)
+		require.NoError(t, exporter.Close())
+
+		select {
+		case r := <-reqCh:
+			body := <-bodyCh
+			assert.Equal(t, "/v1/traces", r.URL.Path)
+			assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+			assert.Equal(t, "secret", r.Header.Get("api-key"))
+
+			var req tracepb.TracesData
+			require.NoError(t, proto.Unmarshal(body, &req))
+			require.Len(t, req.ResourceSpans, 1)
+			resource := req.ResourceSpans[0].Resource
+			require.Len(t, resource.Attributes, 1)
+			assert.Equal(t, "service.name", resource.Attributes[0].Key)
+			assert.Equal(t, "myapp", resource.Attributes[0].Value.GetStringValue())
+
+			require.Len(t, req.ResourceSpans[0].ScopeSpans, 1)
+			spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+			require.Len(t, spans, 1)
+			assert.Equal(t, sd.Name, spans[0].Name)
+			assert.Equal(t, sd.TraceID[:], spans[0].TraceId)
+			assert.Equal(t, sd.SpanID[:], spans[0].SpanId)
+			assert.Empty(t, spans[0].ParentSpanId)
+			assert.Equal(t, tracepb.Span_SPAN_KIND_SERVER, spans[0].Kind)
+			require.Len(t, spans[0].Attributes, 1)
+			assert.Equal(t, "ServiceInvocation", spans[0].Attributes[0].Value.GetStringValue())
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "spans were not exported")
+		}
+	})
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/metric/metricexport"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+const (
+	otlpMetricsServiceMethod = "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export"
+	otlpHTTPMetricsPath      = "/v1/metrics"
+)
+
+// OTLPMetricsExporterOptions configures the OTLP metrics exporter.
+type OTLPMetricsExporterOptions struct {
+	// ServiceName is reported as the `service.name` resource attribute.
+	ServiceName string
+	// Namespace is prepended to the metric names, as the Prometheus exporter does.
+	Namespace string
+	// Endpoint is the collector address. For gRPC it is `host:port`, for HTTP it can also be a full URL.
+	Endpoint string
+	// Protocol is either "grpc" (default) or "http".
+	Protocol string
+	// Insecure disables TLS on the connection to the collector.
+	Insecure bool
+	// Headers are sent with every export request.
+	Headers map[string]string
+}
+
+// OTLPMetricsExporter is an open census metrics exporter that pushes metrics to an OpenTelemetry collector over OTLP.
+// It is meant to be used with a metricexport.IntervalReader, which reads the data of all the registered views.
+type OTLPMetricsExporter struct {
+	opts   OTLPMetricsExporterOptions
+	client *otlpClient
+}
+
+var _ metricexport.Exporter = &OTLPMetricsExporter{}
+
+// NewOTLPMetricsExporter creates a new OTLP metrics exporter.
+func NewOTLPMetricsExporter(opts OTLPMetricsExporterOptions) (*OTLPMetricsExporter, error) {
+	if opts.Endpoint == "" {
+		return nil, fmt.Errorf("otlp endpoint address is required")
+	}
+	if opts.Protocol == "" {
+		opts.Protocol = OTLPProtocolGRPC
+	}
+
+	client, err := newOTLPClient(opts.Endpoint, opts.Protocol, opts.Insecure, opts.Headers, otlpMetricsServiceMethod, otlpHTTPMetricsPath)
+	if err != nil {
+		return nil, err
+	}
+	return &OTLPMetricsExporter{
+		opts:   opts,
+		client: client,
+	}, nil
+}
+
+// ExportMetrics implements the open census metrics exporter interface.
+func (e *OTLPMetricsExporter) ExportMetrics(ctx context.Context, data []*metricdata.Metric) error {
+	req := otlpMetricsRequest(e.opts.ServiceName, e.opts.Namespace, data)
+	if req == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, otlpExportTimeout)
+	defer cancel()
+	if err := e.client.send(ctx, req); err != nil {
+		// The metrics reader discards the returned error.
+		log.Warnf("failed to export %d metrics over otlp: %s", len(data), err)
+		return err
+	}
+	return nil
+}
+
+// Close releases the exporter's resources.
+func (e *OTLPMetricsExporter) Close() error {
+	e.client.close()
+	return nil
+}
+
+// otlpMetricsRequest converts the metrics to an OTLP ExportMetricsServiceRequest message.
+// MetricsData has the same wire format as ExportMetricsServiceRequest, as TracesData for the traces.
+// It returns nil if there is no metric to export.
+func otlpMetricsRequest(serviceName, namespace string, data []*metricdata.Metric) *metricspb.MetricsData {
+	scopeMetrics := &metricspb.ScopeMetrics{
+		Scope: &commonpb.InstrumentationScope{Name: otlpScopeName},
+	}
+	for _, m := range data {
+		if metric := otlpMetric(namespace, m); metric != nil {
+			scopeMetrics.Metrics = append(scopeMetrics.Metrics, metric)
+		}
+	}
+	if len(scopeMetrics.Metrics) == 0 {
+		return nil
+	}
+
+	return &metricspb.MetricsData{
+		ResourceMetrics: []*metricspb.ResourceMetrics{
+			{
+				Resource:     otlpResource(serviceName),
+				ScopeMetrics: []*metricspb.ScopeMetrics{scopeMetrics},
+			},
+		},
+	}
+}
+
+// otlpMetric converts an open census metric to an OTLP metric.
+// It returns nil for the metric types the open census views don't produce.
+func otlpMetric(namespace string, m *metricdata.Metric) *metricspb.Metric {
+	metric := &metricspb.Metric{
+		Name:        otlpMetricName(namespace, m.Descriptor.Name),
+		Description: m.Descriptor.Description,
+		Unit:        string(m.Descriptor.Unit),
+	}
+	switch m.Descriptor.Type {
+	case metricdata.TypeGaugeInt64, metricdata.TypeGaugeFloat64:
+		metric.Data = &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+			DataPoints: otlpNumberDataPoints(m),
+		}}
+	case metricdata.TypeCumulativeInt64, metricdata.TypeCumulativeFloat64:
+		metric.Data = &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+			DataPoints:             otlpNumberDataPoints(m),
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			IsMonotonic:            true,
+		}}
+	case metricdata.TypeCumulativeDistribution:
+		metric.Data = &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+			DataPoints:             otlpHistogramDataPoints(m),
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+		}}
+	default:
+		return nil
+	}
+	return metric
+}
+
+// otlpMetricName returns the metric name with the namespace, replacing the characters
+// the Prometheus exporter doesn't allow, so that both exporters report the same names.
+func otlpMetricName(namespace, name string) string {
+	name = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+	if namespace == "" {
+		return name
+	}
+	return namespace + "_" + name
+}
+
+func otlpNumberDataPoints(m *metricdata.Metric) []*metricspb.NumberDataPoint {
+	var dps []*metricspb.NumberDataPoint
+	for _, ts := range m.TimeSeries {
+		for _, p := range ts.Points {
+			dp := &metricspb.NumberDataPoint{
+				TimeUnixNano: uint64(p.Time.UnixNano()),
+				Attributes:   otlpLabels(m.Descriptor.LabelKeys, ts.LabelValues),
+			}
+			if !ts.StartTime.IsZero() {
+				// Gauges have no start time.
+				dp.StartTimeUnixNano = uint64(ts.StartTime.UnixNano())
+			}
+			switch v := p.Value.(type) {
+			case int64:
+				dp.Value = &metricspb.NumberDataPoint_AsInt{AsInt: v}
+			case float64:
+				dp.Value = &metricspb.NumberDataPoint_AsDouble{AsDouble: v}
+			default:
+				continue
+			}
+			dps = append(dps, dp)
+		}
+	}
+	return dps
+}
+
+func otlpHistogramDataPoints(m *metricdata.Metric) []*metricspb.HistogramDataPoint {
+	var dps []*metricspb.HistogramDataPoint
+	for _, ts := range m.TimeSeries {
+		for _, p := range ts.Points {
+			d, ok := p.Value.(*metricdata.Distribution)
+			if !ok {
+				continue
+			}
+			sum := d.Sum
+			dp := &metricspb.HistogramDataPoint{
+				TimeUnixNano: uint64(p.Time.UnixNano()),
+				Count:        uint64(d.Count),
+				Sum:          &sum,
+				Attributes:   otlpLabels(m.Descriptor.LabelKeys, ts.LabelValues),
+			}
+			if !ts.StartTime.IsZero() {
+				dp.StartTimeUnixNano = uint64(ts.StartTime.UnixNano())
+			}
+			for _, bucket := range d.Buckets {
+				dp.BucketCounts = append(dp.BucketCounts, uint64(bucket.Count))
+			}
+			if d.BucketOptions != nil {
+				dp.ExplicitBounds = d.BucketOptions.Bounds
+			}
+			dps = append(dps, dp)
+		}
+	}
+	return dps
+}
+
+// otlpLabels returns the label values that are present as attributes.
+func otlpLabels(keys []metricdata.LabelKey, values []metricdata.LabelValue) []*commonpb.KeyValue {
+	var kvs []*commonpb.KeyValue
+	for i, v := range values {
+		if i >= len(keys) || !v.Present {
+			continue
+		}
+		kvs = append(kvs, otlpKeyValue(keys[i].Key, v.Value))
+	}
+	return kvs
+}
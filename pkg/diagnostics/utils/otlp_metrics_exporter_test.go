@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/metric/metricdata"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestOTLPMetricName(t *testing.T) {
+	assert.Equal(t, "dapr_http_server_request_count", otlpMetricName("dapr", "http/server/request_count"))
+	assert.Equal(t, "runtime_component_loaded", otlpMetricName("", "runtime/component/loaded"))
+}
+
+func TestOTLPMetricsExporter(t *testing.T) {
+	t.Run("missing endpoint", func(t *testing.T) {
+		_, err := NewOTLPMetricsExporter(OTLPMetricsExporterOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("metrics are exported over http", func(t *testing.T) {
+		var (
+			req  *http.Request
+			body []byte
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req = r
+			body, _ = io.ReadAll(r.Body)
+		}))
+		defer server.Close()
+
+		exporter, err := NewOTLPMetricsExporter(OTLPMetricsExporterOptions{
+			ServiceName: "myapp",
+			Namespace:   "dapr",
+			Endpoint:    server.URL,
+			Protocol:    OTLPProtocolHTTP,
+			Headers:     map[string]string{"api-key": "secret"},
+		})
+		require.NoError(t, err)
+		defer exporter.Close()
+
+		now := time.Now()
+		err = exporter.ExportMetrics(context.Background(), []*metricdata.Metric{
+			{
+				Descriptor: metricdata.Descriptor{
+					Name:      "http/server/request_count",
+					Unit:      metricdata.UnitDimensionless,
+					Type:      metricdata.TypeCumulativeInt64,
+					LabelKeys: []metricdata.LabelKey{{Key: "app_id"}},
+				},
+				TimeSeries: []*metricdata.TimeSeries{{
+					LabelValues: []metricdata.LabelValue{metricdata.NewLabelValue("myapp")},
+					Points:      []metricdata.Point{metricdata.NewInt64Point(now, 3)},
+					StartTime:   now.Add(-time.Minute),
+				}},
+			},
+			{
+				Descriptor: metricdata.Descriptor{
+					Name: "http/server/latency",
+					Unit: metricdata.UnitMilliseconds,
+					Type: metricdata.TypeCumulativeDistribution,
+				},
+				TimeSeries: []*metricdata.TimeSeries{{
+					Points: []metricdata.Point{metricdata.NewDistributionPoint(now, &metricdata.Distribution{
+						Count:         2,
+						Sum:           15,
+						BucketOptions: &metricdata.BucketOptions{Bounds: []float64{10}},
+						Buckets:       []metricdata.Bucket{{Count: 1}, {Count: 1}},
+					})},
+					StartTime: now.Add(-time.Minute),
+				}},
+			},
+		})
+		require.NoError(t, err)
+
+		require.NotNil(t, req)
+		assert.Equal(t, "/v1/metrics", req.URL.Path)
+		assert.Equal(t, "application/x-protobuf", req.Header.Get("Content-Type"))
+		assert.Equal(t, "secret", req.Header.Get("api-key"))
+
+		var data metricspb.MetricsData
+		require.NoError(t, proto.Unmarshal(body, &data))
+		require.Len(t, data.ResourceMetrics, 1)
+		resource := data.ResourceMetrics[0].Resource
+		require.Len(t, resource.Attributes, 1)
+		assert.Equal(t, "myapp", resource.Attributes[0].Value.GetStringValue())
+
+		require.Len(t, data.ResourceMetrics[0].ScopeMetrics, 1)
+		metrics := data.ResourceMetrics[0].ScopeMetrics[0].Metrics
+		require.Len(t, metrics, 2)
+
+		assert.Equal(t, "dapr_http_server_request_count", metrics[0].Name)
+		sum := metrics[0].GetSum()
+		require.NotNil(t, sum)
+		assert.True(t, sum.IsMonotonic)
+		assert.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, sum.AggregationTemporality)
+		require.Len(t, sum.DataPoints, 1)
+		assert.Equal(t, int64(3), sum.DataPoints[0].GetAsInt())
+		assert.Equal(t, uint64(now.UnixNano()), sum.DataPoints[0].TimeUnixNano)
+		require.Len(t, sum.DataPoints[0].Attributes, 1)
+		assert.Equal(t, "app_id", sum.DataPoints[0].Attributes[0].Key)
+		assert.Equal(t, "myapp", sum.DataPoints[0].Attributes[0].Value.GetStringValue())
+
+		assert.Equal(t, "dapr_http_server_latency", metrics[1].Name)
+		histogram := metrics[1].GetHistogram()
+		require.NotNil(t, histogram)
+		require.Len(t, histogram.DataPoints, 1)
+		assert.Equal(t, uint64(2), histogram.DataPoints[0].Count)
+		assert.Equal(t, float64(15), histogram.DataPoints[0].GetSum())
+		assert.Equal(t, []uint64{1, 1}, histogram.DataPoints[0].BucketCounts)
+		assert.Equal(t, []float64{10}, histogram.DataPoints[0].ExplicitBounds)
+	})
+
+	t.Run("nothing is exported without metrics", func(t *testing.T) {
+		called := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer server.Close()
+
+		exporter, err := NewOTLPMetricsExporter(OTLPMetricsExporterOptions{
+			Endpoint: server.URL,
+			Protocol: OTLPProtocolHTTP,
+		})
+		require.NoError(t, err)
+		defer exporter.Close()
+
+		require.NoError(t, exporter.ExportMetrics(context.Background(), nil))
+		assert.False(t, called)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"io"
 
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/chacha20poly1305"
 
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
@@ -30,10 +31,14 @@ import (
 type Algorithm string
 
 const (
-	primaryEncryptionKey   = "primaryEncryptionKey"
-	secondaryEncryptionKey = "secondaryEncryptionKey"
-	errPrefix              = "failed to extract encryption key"
-	AESGCMAlgorithm        = "AES-GCM"
+	primaryEncryptionKey         = "primaryEncryptionKey"
+	secondaryEncryptionKey       = "secondaryEncryptionKey"
+	encryptionAlgorithm          = "encryptionAlgorithm"
+	secondaryEncryptionAlgorithm = "secondaryEncryptionAlgorithm"
+	errPrefix                    = "failed to extract encryption key"
+	AESGCMAlgorithm              = "AES-GCM"
+	ChaCha20Poly1305Algorithm    = "ChaCha20-Poly1305"
+	XChaCha20Poly1305Algorithm   = "XChaCha20-Poly1305"
 )
 
 // ComponentEncryptionKeys holds the encryption keys set for a component.
@@ -44,8 +49,11 @@ type ComponentEncryptionKeys struct {
 
 // Key holds the key to encrypt an arbitrary object.
 type Key struct {
-	Key       string
-	Name      string
+	Key  string
+	Name string
+	// Algorithm is the authenticated cipher used with the key.
+	// The primary key defaults to AES-GCM, and the secondary key to the algorithm of the primary key.
+	Algorithm Algorithm
 	cipherObj cipher.AEAD
 }
 
@@ -55,7 +63,9 @@ func ComponentEncryptionKey(component v1alpha1.Component, secretStore secretstor
 		return ComponentEncryptionKeys{}, nil
 	}
 
-	var cek ComponentEncryptionKeys
+	cek := ComponentEncryptionKeys{}
+	primaryAlgorithm := Algorithm(AESGCMAlgorithm)
+	var secondaryAlgorithm Algorithm
 
 	for _, m := range component.Spec.Metadata {
 		// search for primary encryption key
@@ -84,6 +94,17 @@ func ComponentEncryptionKey(component v1alpha1.Component, secretStore secretstor
 			}
 
 			valid = true
+		} else if m.Name == encryptionAlgorithm {
+			if v := m.Value.String(); v != "" {
+				primaryAlgorithm = Algorithm(v)
+			}
+
+			continue
+		} else if m.Name == secondaryEncryptionAlgorithm {
+			// The secondary key can keep the algorithm the data was encrypted with when the primary key switches to another one.
+			secondaryAlgorithm = Algorithm(m.Value.String())
+
+			continue
 		}
 
 		if !valid {
@@ -102,8 +123,13 @@ func ComponentEncryptionKey(component v1alpha1.Component, secretStore secretstor
 		}
 	}
 
+	if secondaryAlgorithm == "" {
+		secondaryAlgorithm = primaryAlgorithm
+	}
+
 	if cek.Primary.Key != "" {
-		cipherObj, err := createCipher(cek.Primary, AESGCMAlgorithm)
+		cek.Primary.Algorithm = primaryAlgorithm
+		cipherObj, err := createCipher(cek.Primary, cek.Primary.Algorithm)
 		if err != nil {
 			return ComponentEncryptionKeys{}, err
 		}
@@ -111,7 +137,8 @@ func ComponentEncryptionKey(component v1alpha1.Component, secretStore secretstor
 		cek.Primary.cipherObj = cipherObj
 	}
 	if cek.Secondary.Key != "" {
-		cipherObj, err := createCipher(cek.Secondary, AESGCMAlgorithm)
+		cek.Secondary.Algorithm = secondaryAlgorithm
+		cipherObj, err := createCipher(cek.Secondary, cek.Secondary.Algorithm)
 		if err != nil {
 			return ComponentEncryptionKeys{}, err
 		}
@@ -174,6 +201,9 @@ func decrypt(value []byte, key Key) ([]byte, error) {
 	}
 
 	nsize := key.cipherObj.NonceSize()
+	if len(enc) < nsize {
+		return value, errors.New("encrypted value is too short")
+	}
 	nonce, ciphertext := enc[:nsize], enc[nsize:]
 
 	return key.cipherObj.Open(nil, nonce, ciphertext, nil)
@@ -186,13 +216,16 @@ func createCipher(key Key, algorithm Algorithm) (cipher.AEAD, error) {
 	}
 
 	switch algorithm {
-	// Other authenticated ciphers can be added if needed, e.g. golang.org/x/crypto/chacha20poly1305
 	case AESGCMAlgorithm:
 		block, err := aes.NewCipher(keyBytes)
 		if err != nil {
 			return nil, err
 		}
 		return cipher.NewGCM(block)
+	case ChaCha20Poly1305Algorithm:
+		return chacha20poly1305.New(keyBytes)
+	case XChaCha20Poly1305Algorithm:
+		return chacha20poly1305.NewX(keyBytes)
 	}
 
 	return nil, errors.New("unsupported algorithm")
@@ -15,6 +15,7 @@ package encryption
 
 import (
 	"crypto/rand"
+	b64 "encoding/base64"
 	"encoding/hex"
 	"testing"
 
@@ -22,6 +23,8 @@ import (
 	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -103,6 +106,154 @@ func TestComponentEncryptionKey(t *testing.T) {
 		assert.Equal(t, secondaryKey, keys.Secondary.Key)
 	})
 
+	t.Run("component sets the encryption algorithm", func(t *testing.T) {
+		component := v1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "statestore",
+			},
+			Spec: v1alpha1.ComponentSpec{
+				Metadata: []v1alpha1.MetadataItem{
+					{
+						Name: primaryEncryptionKey,
+						SecretKeyRef: v1alpha1.SecretKeyRef{
+							Name: "primaryKey",
+						},
+					},
+					{
+						Name: encryptionAlgorithm,
+						Value: v1alpha1.DynamicValue{
+							JSON: v1.JSON{Raw: []byte(`"XChaCha20-Poly1305"`)},
+						},
+					},
+				},
+			},
+		}
+
+		bytes := make([]byte, 32)
+		rand.Read(bytes)
+
+		secretStore := &mockSecretStore{}
+		secretStore.Init(secretstores.Metadata{
+			Properties: map[string]string{
+				"primaryKey": hex.EncodeToString(bytes),
+			},
+		})
+
+		keys, err := ComponentEncryptionKey(component, secretStore)
+		assert.NoError(t, err)
+		assert.Equal(t, Algorithm(XChaCha20Poly1305Algorithm), keys.Primary.Algorithm)
+		assert.NotNil(t, keys.Primary.cipherObj)
+	})
+
+	t.Run("component sets the algorithm of the secondary key", func(t *testing.T) {
+		component := v1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "statestore",
+			},
+			Spec: v1alpha1.ComponentSpec{
+				Metadata: []v1alpha1.MetadataItem{
+					{
+						Name: primaryEncryptionKey,
+						SecretKeyRef: v1alpha1.SecretKeyRef{
+							Name: "primaryKey",
+						},
+					},
+					{
+						Name: secondaryEncryptionKey,
+						SecretKeyRef: v1alpha1.SecretKeyRef{
+							Name: "secondaryKey",
+						},
+					},
+					{
+						Name: encryptionAlgorithm,
+						Value: v1alpha1.DynamicValue{
+							JSON: v1.JSON{Raw: []byte(`"ChaCha20-Poly1305"`)},
+						},
+					},
+					{
+						Name: secondaryEncryptionAlgorithm,
+						Value: v1alpha1.DynamicValue{
+							JSON: v1.JSON{Raw: []byte(`"AES-GCM"`)},
+						},
+					},
+				},
+			},
+		}
+
+		primaryBytes := make([]byte, 32)
+		rand.Read(primaryBytes)
+		secondaryBytes := make([]byte, 16)
+		rand.Read(secondaryBytes)
+
+		secretStore := &mockSecretStore{}
+		secretStore.Init(secretstores.Metadata{
+			Properties: map[string]string{
+				"primaryKey":   hex.EncodeToString(primaryBytes),
+				"secondaryKey": hex.EncodeToString(secondaryBytes),
+			},
+		})
+
+		keys, err := ComponentEncryptionKey(component, secretStore)
+		require.NoError(t, err)
+		assert.Equal(t, Algorithm(ChaCha20Poly1305Algorithm), keys.Primary.Algorithm)
+		assert.Equal(t, Algorithm(AESGCMAlgorithm), keys.Secondary.Algorithm)
+
+		// Data encrypted with the secondary key before the algorithm switched can still be decrypted.
+		encrypted, err := encrypt([]byte("hello"), keys.Secondary)
+		require.NoError(t, err)
+		decrypted, err := decrypt([]byte(b64.StdEncoding.EncodeToString(encrypted)), keys.Secondary)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(decrypted))
+	})
+
+	t.Run("secondary key defaults to the algorithm of the primary key", func(t *testing.T) {
+		component := v1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "statestore",
+			},
+			Spec: v1alpha1.ComponentSpec{
+				Metadata: []v1alpha1.MetadataItem{
+					{
+						Name: primaryEncryptionKey,
+						SecretKeyRef: v1alpha1.SecretKeyRef{
+							Name: "primaryKey",
+						},
+					},
+					{
+						Name: secondaryEncryptionKey,
+						SecretKeyRef: v1alpha1.SecretKeyRef{
+							Name: "secondaryKey",
+						},
+					},
+					{
+						Name: encryptionAlgorithm,
+						Value: v1alpha1.DynamicValue{
+							JSON: v1.JSON{Raw: []byte(`"ChaCha20-Poly1305"`)},
+						},
+					},
+				},
+			},
+		}
+
+		primaryBytes := make([]byte, 32)
+		rand.Read(primaryBytes)
+		secondaryBytes := make([]byte, 32)
+		rand.Read(secondaryBytes)
+
+		secretStore := &mockSecretStore{}
+		secretStore.Init(secretstores.Metadata{
+			Properties: map[string]string{
+				"primaryKey":   hex.EncodeToString(primaryBytes),
+				"secondaryKey": hex.EncodeToString(secondaryBytes),
+			},
+		})
+
+		keys, err := ComponentEncryptionKey(component, secretStore)
+		require.NoError(t, err)
+		assert.Equal(t, Algorithm(ChaCha20Poly1305Algorithm), keys.Secondary.Algorithm)
+		assert.NotNil(t, keys.Secondary.cipherObj)
+	})
+
 	t.Run("keys empty when no secret store is present and no error", func(t *testing.T) {
 		component := v1alpha1.Component{
 			ObjectMeta: metav1.ObjectMeta{
@@ -232,6 +383,48 @@ func TestCreateCipher(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("valid ChaCha20-Poly1305 key", func(t *testing.T) {
+		bytes := make([]byte, 32)
+		rand.Read(bytes)
+
+		key := hex.EncodeToString(bytes)
+
+		cipherObj, err := createCipher(Key{
+			Key: key,
+		}, ChaCha20Poly1305Algorithm)
+
+		assert.NotNil(t, cipherObj)
+		assert.NoError(t, err)
+	})
+
+	t.Run("valid XChaCha20-Poly1305 key", func(t *testing.T) {
+		bytes := make([]byte, 32)
+		rand.Read(bytes)
+
+		key := hex.EncodeToString(bytes)
+
+		cipherObj, err := createCipher(Key{
+			Key: key,
+		}, XChaCha20Poly1305Algorithm)
+
+		assert.NotNil(t, cipherObj)
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid ChaCha20-Poly1305 key size", func(t *testing.T) {
+		bytes := make([]byte, 16)
+		rand.Read(bytes)
+
+		key := hex.EncodeToString(bytes)
+
+		cipherObj, err := createCipher(Key{
+			Key: key,
+		}, ChaCha20Poly1305Algorithm)
+
+		assert.Nil(t, cipherObj)
+		assert.Error(t, err)
+	})
+
 	t.Run("invalid algorithm", func(t *testing.T) {
 		bytes := make([]byte, 32)
 		rand.Read(bytes)
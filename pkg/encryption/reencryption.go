@@ -0,0 +1,265 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/components-contrib/state/query"
+	"github.com/dapr/kit/logger"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+)
+
+// States of a re-encryption job.
+const (
+	ReEncryptionRunning   = "running"
+	ReEncryptionCompleted = "completed"
+	ReEncryptionFailed    = "failed"
+)
+
+// Process statuses of the values scanned by a re-encryption job, reported in the metrics.
+const (
+	reEncryptedStatus = "reencrypted"
+	skippedStatus     = "skipped"
+	noETagStatus      = "noetag"
+	failedStatus      = "failed"
+)
+
+const reEncryptionPageSize = 100
+
+var log = logger.NewLogger("dapr.encryption")
+
+// ReEncryptionStatus is the progress of the job rewriting the values of an encrypted state store with its primary key.
+type ReEncryptionStatus struct {
+	StoreName  string `json:"storeName"`
+	State      string `json:"state"`
+	PrimaryKey string `json:"primaryKey"`
+	// Scanned is the number of values read from the state store.
+	Scanned int64 `json:"scanned"`
+	// ReEncrypted is the number of values rewritten with the primary key.
+	ReEncrypted int64 `json:"reEncrypted"`
+	// NoETag is the number of values left as is because the state store didn't return their ETag,
+	// so they couldn't be rewritten without overwriting concurrent updates.
+	NoETag int64 `json:"noETag"`
+	// Failed is the number of values that couldn't be decrypted or rewritten.
+	Failed      int64      `json:"failed"`
+	StartedAt   time.Time  `json:"startedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// ReEncryptionJobs runs the re-encryption jobs of the encrypted state stores of a runtime, and keeps their status.
+type ReEncryptionJobs struct {
+	ctx      context.Context
+	statuses map[string]*ReEncryptionStatus
+	lock     sync.RWMutex
+}
+
+// NewReEncryptionJobs returns the re-encryption jobs of a runtime. The jobs are canceled with the context.
+func NewReEncryptionJobs(ctx context.Context) *ReEncryptionJobs {
+	return &ReEncryptionJobs{
+		ctx:      ctx,
+		statuses: map[string]*ReEncryptionStatus{},
+	}
+}
+
+// Statuses returns the status of the re-encryption jobs, sorted by state store name.
+func (j *ReEncryptionJobs) Statuses() []ReEncryptionStatus {
+	j.lock.RLock()
+	defer j.lock.RUnlock()
+
+	res := make([]ReEncryptionStatus, 0, len(j.statuses))
+	for _, s := range j.statuses {
+		res = append(res, *s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].StoreName < res[j].StoreName
+	})
+	return res
+}
+
+func (j *ReEncryptionJobs) updateStatus(storeName string, fn func(s *ReEncryptionStatus)) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if s, ok := j.statuses[storeName]; ok {
+		fn(s)
+	}
+}
+
+// Start starts a job scanning the values of an encrypted state store and rewriting the ones that are not
+// encrypted with the primary key, so that the secondary key can be removed afterwards.
+// The state store must support the query API to list its keys. Values updated while the job is running
+// are not overwritten, as they are rewritten with the ETag that was read; values without an ETag are skipped.
+// It returns an error if the job can't be started; the progress of the job is reported by Statuses.
+func (j *ReEncryptionJobs) Start(storeName string, store state.Store) error {
+	keys, ok := getEncryptionKeys(storeName)
+	if !ok {
+		return errors.Errorf("state store %s is not encrypted", storeName)
+	}
+	querier, ok := store.(state.Querier)
+	if !ok {
+		return errors.Errorf("state store %s doesn't support the query API, which is required to list its keys", storeName)
+	}
+
+	j.lock.Lock()
+	if s, ok := j.statuses[storeName]; ok && s.State == ReEncryptionRunning {
+		j.lock.Unlock()
+		return errors.Errorf("a re-encryption job is already running for state store %s", storeName)
+	}
+	j.statuses[storeName] = &ReEncryptionStatus{
+		StoreName:  storeName,
+		State:      ReEncryptionRunning,
+		PrimaryKey: keys.Primary.Name,
+		StartedAt:  time.Now().UTC(),
+	}
+	j.lock.Unlock()
+
+	go func() {
+		log.Infof("re-encrypting the values of state store %s with the primary key", storeName)
+		err := j.reEncryptState(storeName, keys, store, querier)
+
+		j.updateStatus(storeName, func(s *ReEncryptionStatus) {
+			now := time.Now().UTC()
+			s.CompletedAt = &now
+			if err != nil {
+				s.State = ReEncryptionFailed
+				s.Error = err.Error()
+			} else {
+				s.State = ReEncryptionCompleted
+			}
+		})
+		if err != nil {
+			log.Errorf("error re-encrypting state store %s: %s", storeName, err)
+			return
+		}
+		log.Infof("re-encryption of state store %s completed", storeName)
+	}()
+	return nil
+}
+
+func (j *ReEncryptionJobs) reEncryptState(storeName string, keys ComponentEncryptionKeys, store state.Store, querier state.Querier) error {
+	req := &state.QueryRequest{
+		Query: query.Query{
+			Page: query.Pagination{
+				Limit: reEncryptionPageSize,
+			},
+		},
+	}
+	for {
+		if j.ctx.Err() != nil {
+			return j.ctx.Err()
+		}
+
+		resp, err := querier.Query(req)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range resp.Results {
+			status := reEncryptItem(storeName, keys, store, item)
+			diag.DefaultComponentMonitoring.StateReEncrypted(j.ctx, storeName, status)
+			j.updateStatus(storeName, func(s *ReEncryptionStatus) {
+				s.Scanned++
+				switch status {
+				case reEncryptedStatus:
+					s.ReEncrypted++
+				case noETagStatus:
+					s.NoETag++
+				case failedStatus:
+					s.Failed++
+				}
+			})
+		}
+
+		if resp.Token == "" || len(resp.Results) == 0 {
+			return nil
+		}
+		req.Query.Page.Token = resp.Token
+	}
+}
+
+// reEncryptItem rewrites the value with the primary key if it was encrypted with another key.
+// Values without an ETag are not rewritten, as a concurrent update would be overwritten with the old value.
+func reEncryptItem(storeName string, keys ComponentEncryptionKeys, store state.Store, item state.QueryItem) string {
+	if item.Error != "" {
+		log.Warnf("failed to re-encrypt key %s of state store %s: %s", item.Key, storeName, item.Error)
+		return failedStatus
+	}
+
+	value := encryptedValue(item.Data)
+	ind := bytes.LastIndex(value, []byte(separator))
+	if ind < 0 {
+		log.Warnf("failed to re-encrypt key %s of state store %s: value is not encrypted", item.Key, storeName)
+		return failedStatus
+	}
+	if string(value[ind+len(separator):]) == keys.Primary.Name {
+		return skippedStatus
+	}
+	if item.ETag == nil {
+		log.Warnf("failed to re-encrypt key %s of state store %s: the state store didn't return its ETag", item.Key, storeName)
+		return noETagStatus
+	}
+
+	plain, err := TryDecryptValue(storeName, value)
+	if err != nil {
+		log.Warnf("failed to re-encrypt key %s of state store %s: %s", item.Key, storeName, err)
+		return failedStatus
+	}
+	enc, err := TryEncryptValue(storeName, plain)
+	if err != nil {
+		log.Warnf("failed to re-encrypt key %s of state store %s: %s", item.Key, storeName, err)
+		return failedStatus
+	}
+
+	setReq := &state.SetRequest{
+		Key:   item.Key,
+		Value: enc,
+		ETag:  item.ETag,
+		Options: state.SetStateOption{
+			Concurrency: state.FirstWrite,
+		},
+	}
+	if err = store.Set(setReq); err != nil {
+		var etagErr *state.ETagError
+		if errors.As(err, &etagErr) && etagErr.Kind() == state.ETagMismatch {
+			// The value was updated since it was read, so it's already encrypted with the primary key.
+			return skippedStatus
+		}
+		log.Warnf("failed to re-encrypt key %s of state store %s: %s", item.Key, storeName, err)
+		return failedStatus
+	}
+	return reEncryptedStatus
+}
+
+// encryptedValue returns the encrypted value of the query result.
+// State stores that return the results as JSON return the encrypted value as a JSON string.
+func encryptedValue(data []byte) []byte {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err == nil {
+			return []byte(s)
+		}
+	}
+	return data
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+type fakeQuerierStore struct {
+	state.Store
+	items []state.QueryItem
+	sets  map[string][]byte
+	lock  sync.Mutex
+}
+
+func (s *fakeQuerierStore) Query(req *state.QueryRequest) (*state.QueryResponse, error) {
+	// Returns one item per page.
+	i := 0
+	if req.Query.Page.Token != "" {
+		i = int(req.Query.Page.Token[0] - '0')
+	}
+	if i >= len(s.items) {
+		return &state.QueryResponse{}, nil
+	}
+	return &state.QueryResponse{
+		Results: s.items[i : i+1],
+		Token:   string(rune('0' + i + 1)),
+	}, nil
+}
+
+func (s *fakeQuerierStore) Set(req *state.SetRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.sets[req.Key] = req.Value.([]byte)
+	return nil
+}
+
+func testKey(t *testing.T, name string) Key {
+	b := make([]byte, 32)
+	rand.Read(b)
+	key := Key{
+		Name: name,
+		Key:  hex.EncodeToString(b),
+	}
+	cipherObj, err := createCipher(key, ChaCha20Poly1305Algorithm)
+	require.NoError(t, err)
+	key.cipherObj = cipherObj
+	return key
+}
+
+// waitForJob waits for the re-encryption job of the state store to complete and returns its status.
+func waitForJob(t *testing.T, jobs *ReEncryptionJobs, storeName string) ReEncryptionStatus {
+	var status ReEncryptionStatus
+	require.Eventually(t, func() bool {
+		for _, s := range jobs.Statuses() {
+			if s.StoreName == storeName {
+				status = s
+			}
+		}
+		return status.State != ReEncryptionRunning
+	}, time.Second, 10*time.Millisecond)
+	return status
+}
+
+func TestReEncryptionJobs(t *testing.T) {
+	oldKey := testKey(t, "old")
+	newKey := testKey(t, "new")
+
+	t.Run("values encrypted with the secondary key are rewritten", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: oldKey})
+		oldValue, err := TryEncryptValue("test", []byte("hello"))
+		require.NoError(t, err)
+		otherOldValue, err := TryEncryptValue("test", []byte("bye"))
+		require.NoError(t, err)
+
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: newKey, Secondary: oldKey})
+		newValue, err := TryEncryptValue("test", []byte("world"))
+		require.NoError(t, err)
+
+		etag := "1"
+		store := &fakeQuerierStore{
+			items: []state.QueryItem{
+				{Key: "app||a", Data: oldValue, ETag: &etag},
+				{Key: "app||b", Data: newValue, ETag: &etag},
+				{Key: "app||c", Data: []byte("plain"), ETag: &etag},
+				{Key: "app||d", Data: otherOldValue},
+			},
+			sets: map[string][]byte{},
+		}
+
+		jobs := NewReEncryptionJobs(context.Background())
+		require.NoError(t, jobs.Start("test", store))
+		status := waitForJob(t, jobs, "test")
+
+		require.Len(t, store.sets, 1)
+		assert.True(t, bytes.HasSuffix(store.sets["app||a"], []byte(separator+"new")))
+		plain, err := TryDecryptValue("test", store.sets["app||a"])
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), plain)
+
+		assert.Equal(t, ReEncryptionCompleted, status.State)
+		assert.Equal(t, "new", status.PrimaryKey)
+		assert.Equal(t, int64(4), status.Scanned)
+		assert.Equal(t, int64(1), status.ReEncrypted)
+		assert.Equal(t, int64(1), status.NoETag)
+		assert.Equal(t, int64(1), status.Failed)
+		assert.NotNil(t, status.CompletedAt)
+	})
+
+	t.Run("statuses are kept per runtime", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: newKey})
+
+		jobs := NewReEncryptionJobs(context.Background())
+		require.NoError(t, jobs.Start("test", &fakeQuerierStore{}))
+		waitForJob(t, jobs, "test")
+
+		assert.Len(t, jobs.Statuses(), 1)
+		assert.Empty(t, NewReEncryptionJobs(context.Background()).Statuses())
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{Primary: newKey})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		jobs := NewReEncryptionJobs(ctx)
+		require.NoError(t, jobs.Start("test", &fakeQuerierStore{}))
+		status := waitForJob(t, jobs, "test")
+
+		assert.Equal(t, ReEncryptionFailed, status.State)
+		assert.NotEmpty(t, status.Error)
+	})
+
+	t.Run("state store without query API", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("noquery", ComponentEncryptionKeys{Primary: newKey})
+
+		jobs := NewReEncryptionJobs(context.Background())
+		assert.Error(t, jobs.Start("noquery", struct{ state.Store }{}))
+		assert.Empty(t, jobs.Statuses())
+	})
+
+	t.Run("state store not encrypted", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+
+		jobs := NewReEncryptionJobs(context.Background())
+		assert.Error(t, jobs.Start("test", &fakeQuerierStore{}))
+		assert.Empty(t, jobs.Statuses())
+	})
+}
+
+func TestEncryptedValue(t *testing.T) {
+	assert.Equal(t, []byte("abc||key"), encryptedValue([]byte(`"abc||key"`)))
+	assert.Equal(t, []byte("abc||key"), encryptedValue([]byte("abc||key")))
+}
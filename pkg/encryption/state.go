@@ -16,11 +16,15 @@ package encryption
 import (
 	"bytes"
 	b64 "encoding/base64"
+	"sync"
 
 	"github.com/pkg/errors"
 )
 
-var encryptedStateStores = map[string]ComponentEncryptionKeys{}
+var (
+	encryptedStateStores     = map[string]ComponentEncryptionKeys{}
+	encryptedStateStoresLock sync.RWMutex
+)
 
 const (
 	separator = "||"
@@ -28,6 +32,9 @@ const (
 
 // AddEncryptedStateStore adds an encrypted state store and an associated encryption key to a list.
 func AddEncryptedStateStore(storeName string, keys ComponentEncryptionKeys) bool {
+	encryptedStateStoresLock.Lock()
+	defer encryptedStateStoresLock.Unlock()
+
 	if _, ok := encryptedStateStores[storeName]; ok {
 		return false
 	}
@@ -36,17 +43,33 @@ func AddEncryptedStateStore(storeName string, keys ComponentEncryptionKeys) bool
 	return true
 }
 
+// RemoveEncryptedStateStore removes the encryption keys of a state store, when the state store is deleted.
+func RemoveEncryptedStateStore(storeName string) {
+	encryptedStateStoresLock.Lock()
+	defer encryptedStateStoresLock.Unlock()
+
+	delete(encryptedStateStores, storeName)
+}
+
 // EncryptedStateStore returns a bool that indicates if a state stores supports encryption.
 func EncryptedStateStore(storeName string) bool {
-	_, ok := encryptedStateStores[storeName]
+	_, ok := getEncryptionKeys(storeName)
 	return ok
 }
 
+func getEncryptionKeys(storeName string) (ComponentEncryptionKeys, bool) {
+	encryptedStateStoresLock.RLock()
+	defer encryptedStateStoresLock.RUnlock()
+
+	keys, ok := encryptedStateStores[storeName]
+	return keys, ok
+}
+
 // TryEncryptValue will try to encrypt a byte array if the state store has associated encryption keys.
 // The function will append the name of the key to the value for later extraction.
 // If no encryption keys exist, the function will return the bytes unmodified.
 func TryEncryptValue(storeName string, value []byte) ([]byte, error) {
-	keys := encryptedStateStores[storeName]
+	keys, _ := getEncryptionKeys(storeName)
 	enc, err := encrypt(value, keys.Primary)
 	if err != nil {
 		return value, err
@@ -59,7 +82,7 @@ func TryEncryptValue(storeName string, value []byte) ([]byte, error) {
 // TryDecryptValue will try to decrypt a byte array if the state store has associated encryption keys.
 // If no encryption keys exist, the function will return the bytes unmodified.
 func TryDecryptValue(storeName string, value []byte) ([]byte, error) {
-	keys := encryptedStateStores[storeName]
+	keys, _ := getEncryptionKeys(storeName)
 	// extract the decryption key that should be appended to the value
 	ind := bytes.LastIndex(value, []byte(separator))
 	keyName := string(value[ind+len(separator):])
@@ -76,5 +99,9 @@ func TryDecryptValue(storeName string, value []byte) ([]byte, error) {
 		key = keys.Secondary
 	}
 
+	if key.cipherObj == nil {
+		return value, errors.Errorf("could not decrypt data for state store %s: encryption key %s not found", storeName, keyName)
+	}
+
 	return decrypt(value[:ind], key)
 }
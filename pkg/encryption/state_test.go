@@ -215,4 +215,13 @@ func TestEncryptedStateStore(t *testing.T) {
 
 		assert.False(t, ok)
 	})
+
+	t.Run("store removed", func(t *testing.T) {
+		encryptedStateStores = map[string]ComponentEncryptionKeys{}
+		AddEncryptedStateStore("test", ComponentEncryptionKeys{})
+		RemoveEncryptedStateStore("test")
+
+		assert.False(t, EncryptedStateStore("test"))
+		assert.True(t, AddEncryptedStateStore("test", ComponentEncryptionKeys{}))
+	})
 }
@@ -26,7 +26,9 @@ func Watch(ctx context.Context, dir string, eventCh chan<- struct{}) error {
 		// watch for events
 		case event := <-watcher.Events:
 			if event.Op&fsnotify.Create == fsnotify.Create ||
-				event.Op&fsnotify.Write == fsnotify.Write {
+				event.Op&fsnotify.Write == fsnotify.Write ||
+				event.Op&fsnotify.Remove == fsnotify.Remove ||
+				event.Op&fsnotify.Rename == fsnotify.Rename {
 				if strings.Contains(event.Name, dir) {
 					// give time for other updates to occur
 					time.Sleep(time.Second * 1)
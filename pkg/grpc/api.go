@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/dapr/components-contrib/lock"
+	configuration_loader "github.com/dapr/dapr/pkg/components/configuration"
 	lock_loader "github.com/dapr/dapr/pkg/components/lock"
 
 	"github.com/dapr/components-contrib/configuration"
@@ -44,6 +45,7 @@ import (
 	"github.com/dapr/dapr/pkg/acl"
 	"github.com/dapr/dapr/pkg/actors"
 	components_v1alpha "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/apphealth"
 	"github.com/dapr/dapr/pkg/channel"
 	state_loader "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/concurrency"
@@ -54,6 +56,7 @@ import (
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/outbox"
 	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
@@ -71,9 +74,11 @@ type API interface {
 	// DaprInternal Service methods
 	CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error)
 	CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error)
+	CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStreamServer) error
 
 	// Dapr Service methods
 	PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequest) (*emptypb.Empty, error)
+	BulkPublishEvent(ctx context.Context, in *runtimev1pb.BulkPublishRequest) (*runtimev1pb.BulkPublishResponse, error)
 	InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRequest) (*commonv1pb.InvokeResponse, error)
 	InvokeBinding(ctx context.Context, in *runtimev1pb.InvokeBindingRequest) (*runtimev1pb.InvokeBindingResponse, error)
 	GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*runtimev1pb.GetStateResponse, error)
@@ -91,11 +96,19 @@ type API interface {
 	SetAppChannel(appChannel channel.AppChannel)
 	SetDirectMessaging(directMessaging messaging.DirectMessaging)
 	SetActorRuntime(actor actors.Actors)
+	SetAppHealth(appHealth *apphealth.AppHealth)
+	SetAppConnectionConfig(appConnectionConfig channel.AppConnectionConfig)
+	SetEnabledFeatures(enabledFeatures []string)
+	SetSubscriptionsFn(getSubscriptionsFn func() []runtime_pubsub.Subscription)
+	SetComponentsCapabilitiesFn(getCapabilitiesFn func() map[string][]string)
+	SetReEncryptionJobs(reEncryptionJobs *encryption.ReEncryptionJobs)
 	RegisterActorTimer(ctx context.Context, in *runtimev1pb.RegisterActorTimerRequest) (*emptypb.Empty, error)
 	UnregisterActorTimer(ctx context.Context, in *runtimev1pb.UnregisterActorTimerRequest) (*emptypb.Empty, error)
 	RegisterActorReminder(ctx context.Context, in *runtimev1pb.RegisterActorReminderRequest) (*emptypb.Empty, error)
 	UnregisterActorReminder(ctx context.Context, in *runtimev1pb.UnregisterActorReminderRequest) (*emptypb.Empty, error)
 	RenameActorReminder(ctx context.Context, in *runtimev1pb.RenameActorReminderRequest) (*emptypb.Empty, error)
+	ListActorReminders(ctx context.Context, in *runtimev1pb.ListActorRemindersRequest) (*runtimev1pb.ListActorRemindersResponse, error)
+	DeleteActorReminders(ctx context.Context, in *runtimev1pb.DeleteActorRemindersRequest) (*emptypb.Empty, error)
 	GetActorState(ctx context.Context, in *runtimev1pb.GetActorStateRequest) (*runtimev1pb.GetActorStateResponse, error)
 	ExecuteActorStateTransaction(ctx context.Context, in *runtimev1pb.ExecuteActorStateTransactionRequest) (*emptypb.Empty, error)
 	InvokeActor(ctx context.Context, in *runtimev1pb.InvokeActorRequest) (*runtimev1pb.InvokeActorResponse, error)
@@ -112,32 +125,42 @@ type API interface {
 }
 
 type api struct {
-	actor                      actors.Actors
-	directMessaging            messaging.DirectMessaging
-	appChannel                 channel.AppChannel
-	resiliency                 resiliency.Provider
-	stateStores                map[string]state.Store
-	transactionalStateStores   map[string]state.TransactionalStore
-	secretStores               map[string]secretstores.SecretStore
-	secretsConfiguration       map[string]config.SecretsScope
-	configurationStores        map[string]configuration.Store
-	configurationSubscribe     map[string]chan struct{} // store map[storeName||key1,key2] -> stopChan
-	configurationSubscribeLock sync.Mutex
-	lockStores                 map[string]lock.Store
-	pubsubAdapter              runtime_pubsub.Adapter
-	id                         string
-	sendToOutputBindingFn      func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
-	tracingSpec                config.TracingSpec
-	accessControlList          *config.AccessControlList
-	appProtocol                string
-	extendedMetadata           sync.Map
-	components                 []components_v1alpha.Component
-	shutdown                   func()
+	actor                  actors.Actors
+	appHealth              *apphealth.AppHealth
+	appConnectionConfig    channel.AppConnectionConfig
+	enabledFeatures        []string
+	getSubscriptionsFn     func() []runtime_pubsub.Subscription
+	getCapabilitiesFn      func() map[string][]string
+	reEncryptionJobs       *encryption.ReEncryptionJobs
+	directMessaging        messaging.DirectMessaging
+	appChannel             channel.AppChannel
+	resiliency             resiliency.Provider
+	stateStores            map[string]state.Store
+	secretStores           map[string]secretstores.SecretStore
+	secretsConfiguration   map[string]config.SecretsScope
+	configurationStores    map[string]configuration.Store
+	configurationSubscribe *configuration_loader.Subscriptions
+	lockStores             map[string]lock.Store
+	componentsLock         *sync.RWMutex // guards the component stores, updated when components are hot reloaded
+	pubsubAdapter          runtime_pubsub.Adapter
+	id                     string
+	sendToOutputBindingFn  func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	tracingSpec            config.TracingSpec
+	accessControlList      *config.AccessControlList
+	appProtocol            string
+	extendedMetadata       sync.Map
+	getComponentsFn        func() []components_v1alpha.Component
+	shutdown               func()
 }
 
 func (a *api) TryLockAlpha1(ctx context.Context, req *runtimev1pb.TryLockRequest) (*runtimev1pb.TryLockResponse, error) {
+	unlock := a.rlockComponents()
+	store, ok := a.lockStores[req.StoreName]
+	lockStoresCount := len(a.lockStores)
+	unlock()
+
 	// 1. validate
-	if a.lockStores == nil || len(a.lockStores) == 0 {
+	if lockStoresCount == 0 {
 		err := status.Error(codes.FailedPrecondition, messages.ErrLockStoresNotConfigured)
 		apiServerLogger.Debug(err)
 		return &runtimev1pb.TryLockResponse{}, err
@@ -155,7 +178,6 @@ func (a *api) TryLockAlpha1(ctx context.Context, req *runtimev1pb.TryLockRequest
 		return &runtimev1pb.TryLockResponse{}, err
 	}
 	// 2. find lock component
-	store, ok := a.lockStores[req.StoreName]
 	if !ok {
 		return &runtimev1pb.TryLockResponse{}, status.Errorf(codes.InvalidArgument, messages.ErrLockStoreNotFound, req.StoreName)
 	}
@@ -180,8 +202,13 @@ func (a *api) TryLockAlpha1(ctx context.Context, req *runtimev1pb.TryLockRequest
 }
 
 func (a *api) UnlockAlpha1(ctx context.Context, req *runtimev1pb.UnlockRequest) (*runtimev1pb.UnlockResponse, error) {
+	unlock := a.rlockComponents()
+	store, ok := a.lockStores[req.StoreName]
+	lockStoresCount := len(a.lockStores)
+	unlock()
+
 	// 1. validate
-	if a.lockStores == nil || len(a.lockStores) == 0 {
+	if lockStoresCount == 0 {
 		err := status.Error(codes.FailedPrecondition, messages.ErrLockStoresNotConfigured)
 		apiServerLogger.Debug(err)
 		return newInternalErrorUnlockResponse(), err
@@ -195,7 +222,6 @@ func (a *api) UnlockAlpha1(ctx context.Context, req *runtimev1pb.UnlockRequest)
 		return newInternalErrorUnlockResponse(), err
 	}
 	// 2. find store component
-	store, ok := a.lockStores[req.StoreName]
 	if !ok {
 		return newInternalErrorUnlockResponse(), status.Errorf(codes.InvalidArgument, messages.ErrLockStoreNotFound, req.StoreName)
 	}
@@ -281,34 +307,29 @@ func NewAPI(
 	accessControlList *config.AccessControlList,
 	appProtocol string,
 	getComponentsFn func() []components_v1alpha.Component,
+	componentsLock *sync.RWMutex,
 	shutdown func(),
 ) API {
-	transactionalStateStores := map[string]state.TransactionalStore{}
-	for key, store := range stateStores {
-		if state.FeatureTransactional.IsPresent(store.Features()) {
-			transactionalStateStores[key] = store.(state.TransactionalStore)
-		}
-	}
-
 	return &api{
-		directMessaging:          directMessaging,
-		actor:                    actor,
-		id:                       appID,
-		resiliency:               resiliency,
-		appChannel:               appChannel,
-		pubsubAdapter:            pubsubAdapter,
-		stateStores:              stateStores,
-		transactionalStateStores: transactionalStateStores,
-		secretStores:             secretStores,
-		configurationStores:      configurationStores,
-		configurationSubscribe:   make(map[string]chan struct{}),
-		lockStores:               lockStores,
-		secretsConfiguration:     secretsConfiguration,
-		sendToOutputBindingFn:    sendToOutputBindingFn,
-		tracingSpec:              tracingSpec,
-		accessControlList:        accessControlList,
-		appProtocol:              appProtocol,
-		shutdown:                 shutdown,
+		directMessaging:        directMessaging,
+		actor:                  actor,
+		id:                     appID,
+		resiliency:             resiliency,
+		appChannel:             appChannel,
+		pubsubAdapter:          pubsubAdapter,
+		stateStores:            stateStores,
+		secretStores:           secretStores,
+		configurationStores:    configurationStores,
+		configurationSubscribe: configuration_loader.NewSubscriptions(),
+		lockStores:             lockStores,
+		componentsLock:         componentsLock,
+		secretsConfiguration:   secretsConfiguration,
+		sendToOutputBindingFn:  sendToOutputBindingFn,
+		tracingSpec:            tracingSpec,
+		accessControlList:      accessControlList,
+		appProtocol:            appProtocol,
+		getComponentsFn:        getComponentsFn,
+		shutdown:               shutdown,
 	}
 }
 
@@ -323,22 +344,8 @@ func (a *api) CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequ
 		return nil, status.Errorf(codes.InvalidArgument, messages.ErrInternalInvokeRequest, err.Error())
 	}
 
-	if a.accessControlList != nil {
-		// An access control policy has been specified for the app. Apply the policies.
-		operation := req.Message().Method
-		var httpVerb commonv1pb.HTTPExtension_Verb
-		// Get the http verb in case the application protocol is http
-		if a.appProtocol == config.HTTPProtocol && req.Metadata() != nil && len(req.Metadata()) > 0 {
-			httpExt := req.Message().GetHttpExtension()
-			if httpExt != nil {
-				httpVerb = httpExt.GetVerb()
-			}
-		}
-		callAllowed, errMsg := acl.ApplyAccessControlPolicies(ctx, operation, httpVerb, a.appProtocol, a.accessControlList)
-
-		if !callAllowed {
-			return nil, status.Errorf(codes.PermissionDenied, errMsg)
-		}
+	if err = a.applyAccessControlPolicies(ctx, req); err != nil {
+		return nil, err
 	}
 
 	resp, err := a.appChannel.InvokeMethod(ctx, req)
@@ -346,7 +353,54 @@ func (a *api) CallLocal(ctx context.Context, in *internalv1pb.InternalInvokeRequ
 		err = status.Errorf(codes.Internal, messages.ErrChannelInvoke, err)
 		return nil, err
 	}
-	return resp.Proto(), err
+	if err = resp.BufferStream(); err != nil {
+		return nil, status.Errorf(codes.Internal, messages.ErrChannelInvoke, err)
+	}
+	return resp.Proto(), nil
+}
+
+// CallLocalStream is used for internal dapr to dapr calls, streaming the request and response data in chunks.
+func (a *api) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStreamServer) error {
+	if a.appChannel == nil {
+		return status.Error(codes.Internal, messages.ErrChannelNotFound)
+	}
+
+	req, err := invokev1.InternalInvokeRequestFromStream(stream.Recv)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, messages.ErrInternalInvokeRequest, err.Error())
+	}
+
+	ctx := stream.Context()
+	if err = a.applyAccessControlPolicies(ctx, req); err != nil {
+		return err
+	}
+
+	resp, err := a.appChannel.InvokeMethod(ctx, req)
+	if err != nil {
+		return status.Errorf(codes.Internal, messages.ErrChannelInvoke, err)
+	}
+	defer resp.Close()
+
+	return resp.SendStream(stream.Send)
+}
+
+// applyAccessControlPolicies returns an error if the access control policies of the app don't allow the call.
+func (a *api) applyAccessControlPolicies(ctx context.Context, req *invokev1.InvokeMethodRequest) error {
+	if a.accessControlList == nil {
+		return nil
+	}
+
+	// An access control policy has been specified for the app. Apply the policies.
+	operation := req.Message().Method
+	var httpVerb commonv1pb.HTTPExtension_Verb
+	// Get the http verb in case the application protocol is http
+	if a.appProtocol == config.HTTPProtocol && req.Metadata() != nil && len(req.Metadata()) > 0 {
+		httpExt := req.Message().GetHttpExtension()
+		if httpExt != nil {
+			httpVerb = httpExt.GetVerb()
+		}
+	}
+	return acl.ApplyAccessControlPolicies(ctx, operation, httpVerb, a.appProtocol, a.accessControlList)
 }
 
 // CallActor invokes a virtual actor.
@@ -469,6 +523,145 @@ func (a *api) PublishEvent(ctx context.Context, in *runtimev1pb.PublishEventRequ
 	return &emptypb.Empty{}, nil
 }
 
+func (a *api) BulkPublishEvent(ctx context.Context, in *runtimev1pb.BulkPublishRequest) (*runtimev1pb.BulkPublishResponse, error) {
+	if a.pubsubAdapter == nil {
+		err := status.Error(codes.FailedPrecondition, messages.ErrPubsubNotConfigured)
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.BulkPublishResponse{}, err
+	}
+
+	pubsubName := in.PubsubName
+	if pubsubName == "" {
+		err := status.Error(codes.InvalidArgument, messages.ErrPubsubEmpty)
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.BulkPublishResponse{}, err
+	}
+
+	thepubsub := a.pubsubAdapter.GetPubSub(pubsubName)
+	if thepubsub == nil {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrPubsubNotFound, pubsubName)
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.BulkPublishResponse{}, err
+	}
+
+	topic := in.Topic
+	if topic == "" {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrTopicEmpty, pubsubName)
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.BulkPublishResponse{}, err
+	}
+
+	if len(in.Entries) == 0 {
+		err := status.Errorf(codes.InvalidArgument, messages.ErrPubsubBulkEntriesEmpty, topic, pubsubName)
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.BulkPublishResponse{}, err
+	}
+
+	span := diag_utils.SpanFromContext(ctx)
+	// Populate W3C traceparent to cloudevent envelope
+	corID := diag.SpanContextToW3CString(span.SpanContext())
+	// Populate W3C tracestate to cloudevent envelope
+	traceState := diag.TraceStateToW3CString(span.SpanContext())
+
+	features := thepubsub.Features()
+	entryIDs := make(map[string]struct{}, len(in.Entries))
+	req := runtime_pubsub.BulkPublishRequest{
+		PubsubName: pubsubName,
+		Topic:      topic,
+		Entries:    make([]runtime_pubsub.BulkMessageEntry, len(in.Entries)),
+		Metadata:   in.Metadata,
+	}
+	for i, entry := range in.Entries {
+		if _, ok := entryIDs[entry.EntryId]; ok || entry.EntryId == "" {
+			err := status.Errorf(codes.InvalidArgument, messages.ErrPubsubBulkEntryID, entry.EntryId, topic, pubsubName)
+			apiServerLogger.Debug(err)
+			return &runtimev1pb.BulkPublishResponse{}, err
+		}
+		entryIDs[entry.EntryId] = struct{}{}
+
+		entryMetadata := make(map[string]string, len(in.Metadata)+len(entry.Metadata))
+		for k, v := range in.Metadata {
+			entryMetadata[k] = v
+		}
+		for k, v := range entry.Metadata {
+			entryMetadata[k] = v
+		}
+		rawPayload, metaErr := contrib_metadata.IsRawPayload(entryMetadata)
+		if metaErr != nil {
+			err := status.Errorf(codes.InvalidArgument, messages.ErrMetadataGet, metaErr.Error())
+			apiServerLogger.Debug(err)
+			return &runtimev1pb.BulkPublishResponse{}, err
+		}
+
+		data := entry.Event
+		if !rawPayload {
+			envelope, err := runtime_pubsub.NewCloudEvent(&runtime_pubsub.CloudEvent{
+				ID:              a.id,
+				Topic:           topic,
+				DataContentType: entry.ContentType,
+				Data:            entry.Event,
+				TraceID:         corID,
+				TraceState:      traceState,
+				Pubsub:          pubsubName,
+			})
+			if err != nil {
+				err = status.Errorf(codes.InvalidArgument, messages.ErrPubsubCloudEventCreation, err.Error())
+				apiServerLogger.Debug(err)
+				return &runtimev1pb.BulkPublishResponse{}, err
+			}
+
+			pubsub.ApplyMetadata(envelope, features, entryMetadata)
+
+			data, err = json.Marshal(envelope)
+			if err != nil {
+				err = status.Errorf(codes.InvalidArgument, messages.ErrPubsubCloudEventsSer, topic, pubsubName, err.Error())
+				apiServerLogger.Debug(err)
+				return &runtimev1pb.BulkPublishResponse{}, err
+			}
+		}
+
+		req.Entries[i] = runtime_pubsub.BulkMessageEntry{
+			EntryID:     entry.EntryId,
+			Event:       data,
+			ContentType: entry.ContentType,
+			Metadata:    entry.Metadata,
+		}
+	}
+
+	start := time.Now()
+	res, err := a.pubsubAdapter.BulkPublish(&req)
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.PubsubEgressEvent(context.Background(), pubsubName, topic, err == nil && len(res.FailedEntries) == 0, elapsed)
+
+	if err != nil {
+		nerr := status.Errorf(codes.Internal, messages.ErrPubsubBulkPublishMessage, topic, pubsubName, err.Error())
+		if errors.As(err, &runtime_pubsub.NotAllowedError{}) {
+			nerr = status.Errorf(codes.PermissionDenied, err.Error())
+		}
+
+		if errors.As(err, &runtime_pubsub.NotFoundError{}) {
+			nerr = status.Errorf(codes.NotFound, err.Error())
+		}
+		apiServerLogger.Debug(nerr)
+		return &runtimev1pb.BulkPublishResponse{}, nerr
+	}
+
+	resp := &runtimev1pb.BulkPublishResponse{
+		FailedEntries: make([]*runtimev1pb.BulkPublishResponseFailedEntry, len(res.FailedEntries)),
+	}
+	for i, e := range res.FailedEntries {
+		resp.FailedEntries[i] = &runtimev1pb.BulkPublishResponseFailedEntry{
+			EntryId: e.EntryID,
+		}
+		if e.Error != nil {
+			resp.FailedEntries[i].Error = e.Error.Error()
+		}
+	}
+
+	return resp, nil
+}
+
 func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRequest) (*commonv1pb.InvokeResponse, error) {
 	req := invokev1.FromInvokeRequestMessage(in.GetMessage())
 
@@ -488,6 +681,16 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 		resp, rErr = a.directMessaging.Invoke(ctx, in.Id, req)
 
 		if rErr != nil {
+			requestErr = true
+			code := codes.Internal
+			if status.Code(rErr) == codes.ResourceExhausted {
+				// Let the caller know that it is being rate limited by the target app
+				code = codes.ResourceExhausted
+			}
+			rErr = status.Errorf(code, messages.ErrDirectInvoke, in.Id, rErr)
+			return rErr
+		}
+		if rErr = resp.BufferStream(); rErr != nil {
 			requestErr = true
 			rErr = status.Errorf(codes.Internal, messages.ErrDirectInvoke, in.Id, rErr)
 			return rErr
@@ -503,6 +706,10 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 				_, errorMessage = resp.RawData()
 			}
 			respError = invokev1.ErrorFromHTTPResponseCode(int(resp.Status().Code), string(errorMessage))
+			if respError != nil {
+				// Let retry policies match the HTTP status code of the app.
+				respError = &resiliency.HTTPStatusCodeError{StatusCode: int(resp.Status().Code), Err: respError}
+			}
 			// Populate http status code to header
 			headerMD.Set(daprHTTPStatusHeader, strconv.Itoa(int(resp.Status().Code)))
 		} else {
@@ -517,6 +724,9 @@ func (a *api) InvokeService(ctx context.Context, in *runtimev1pb.InvokeServiceRe
 	})
 
 	// In this case, there was an error with the actual request or a resiliency policy stopped the request.
+	if errors.Is(respError, resiliency.ErrBulkheadFull) {
+		return nil, status.Errorf(codes.ResourceExhausted, messages.ErrDirectInvoke, in.Id, respError)
+	}
 	if requestErr || (errors.Is(respError, context.DeadlineExceeded) || breaker.IsErrorPermanent(respError)) {
 		return nil, respError
 	}
@@ -658,7 +868,19 @@ func (a *api) GetBulkState(ctx context.Context, in *runtimev1pb.GetBulkStateRequ
 	return bulkResp, nil
 }
 
+// rlockComponents locks the component stores for reading, and returns the function unlocking them.
+func (a *api) rlockComponents() func() {
+	if a.componentsLock == nil {
+		return func() {}
+	}
+	a.componentsLock.RLock()
+	return a.componentsLock.RUnlock
+}
+
 func (a *api) getStateStore(name string) (state.Store, error) {
+	unlock := a.rlockComponents()
+	defer unlock()
+
 	if a.stateStores == nil || len(a.stateStores) == 0 {
 		return nil, status.Error(codes.FailedPrecondition, messages.ErrStateStoresNotConfigured)
 	}
@@ -669,6 +891,15 @@ func (a *api) getStateStore(name string) (state.Store, error) {
 	return a.stateStores[name], nil
 }
 
+// getModifiedStateKeyToWrite returns the key of a state item saved or deleted by the app.
+// The keys reserved for the outbox records of the state store are rejected.
+func (a *api) getModifiedStateKeyToWrite(key, storeName string) (string, error) {
+	if err := outbox.CheckStateKey(storeName, key); err != nil {
+		return "", err
+	}
+	return state_loader.GetModifiedStateKey(key, storeName, a.id)
+}
+
 func (a *api) GetState(ctx context.Context, in *runtimev1pb.GetStateRequest) (*runtimev1pb.GetStateResponse, error) {
 	store, err := a.getStateStore(in.StoreName)
 	if err != nil {
@@ -733,7 +964,7 @@ func (a *api) SaveState(ctx context.Context, in *runtimev1pb.SaveStateRequest) (
 
 	reqs := []state.SetRequest{}
 	for _, s := range in.States {
-		key, err1 := state_loader.GetModifiedStateKey(s.Key, in.StoreName, a.id)
+		key, err1 := a.getModifiedStateKeyToWrite(s.Key, in.StoreName)
 		if err1 != nil {
 			return &emptypb.Empty{}, err1
 		}
@@ -853,6 +1084,27 @@ func (a *api) QueryStateAlpha1(ctx context.Context, in *runtimev1pb.QueryStateRe
 	return ret, nil
 }
 
+func (a *api) ReEncryptStateAlpha1(ctx context.Context, in *runtimev1pb.ReEncryptStateRequest) (*emptypb.Empty, error) {
+	store, err := a.getStateStore(in.StoreName)
+	if err != nil {
+		apiServerLogger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	if a.reEncryptionJobs == nil {
+		err = status.Errorf(codes.Unimplemented, messages.ErrNotFound, "ReEncryptState")
+		apiServerLogger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	if err = a.reEncryptionJobs.Start(in.StoreName, store); err != nil {
+		err = status.Errorf(codes.FailedPrecondition, messages.ErrStateReEncryption, in.StoreName, err.Error())
+		apiServerLogger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // stateErrorResponse takes a state store error, format and args and returns a status code encoded gRPC error.
 func (a *api) stateErrorResponse(err error, format string, args ...interface{}) error {
 	e, ok := err.(*state.ETagError)
@@ -876,7 +1128,7 @@ func (a *api) DeleteState(ctx context.Context, in *runtimev1pb.DeleteStateReques
 		return &emptypb.Empty{}, err
 	}
 
-	key, err := state_loader.GetModifiedStateKey(in.Key, in.StoreName, a.id)
+	key, err := a.getModifiedStateKeyToWrite(in.Key, in.StoreName)
 	if err != nil {
 		return &empty.Empty{}, err
 	}
@@ -920,7 +1172,7 @@ func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkSta
 
 	reqs := make([]state.DeleteRequest, 0, len(in.States))
 	for _, item := range in.States {
-		key, err1 := state_loader.GetModifiedStateKey(item.Key, in.StoreName, a.id)
+		key, err1 := a.getModifiedStateKeyToWrite(item.Key, in.StoreName)
 		if err1 != nil {
 			return &empty.Empty{}, err1
 		}
@@ -957,7 +1209,12 @@ func (a *api) DeleteBulkState(ctx context.Context, in *runtimev1pb.DeleteBulkSta
 }
 
 func (a *api) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest) (*runtimev1pb.GetSecretResponse, error) {
-	if a.secretStores == nil || len(a.secretStores) == 0 {
+	unlock := a.rlockComponents()
+	store := a.secretStores[in.StoreName]
+	secretStoresCount := len(a.secretStores)
+	unlock()
+
+	if secretStoresCount == 0 {
 		err := status.Error(codes.FailedPrecondition, messages.ErrSecretStoreNotConfigured)
 		apiServerLogger.Debug(err)
 		return &runtimev1pb.GetSecretResponse{}, err
@@ -965,7 +1222,7 @@ func (a *api) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest) (
 
 	secretStoreName := in.StoreName
 
-	if a.secretStores[secretStoreName] == nil {
+	if store == nil {
 		err := status.Errorf(codes.InvalidArgument, messages.ErrSecretStoreNotFound, secretStoreName)
 		apiServerLogger.Debug(err)
 		return &runtimev1pb.GetSecretResponse{}, err
@@ -986,7 +1243,7 @@ func (a *api) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest) (
 	policy := a.resiliency.ComponentOutboundPolicy(ctx, secretStoreName)
 	var getResponse secretstores.GetSecretResponse
 	err := policy(func(ctx context.Context) (rErr error) {
-		getResponse, rErr = a.secretStores[secretStoreName].GetSecret(req)
+		getResponse, rErr = store.GetSecret(req)
 		return rErr
 	})
 	elapsed := diag.ElapsedSince(start)
@@ -1007,7 +1264,12 @@ func (a *api) GetSecret(ctx context.Context, in *runtimev1pb.GetSecretRequest) (
 }
 
 func (a *api) GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSecretRequest) (*runtimev1pb.GetBulkSecretResponse, error) {
-	if a.secretStores == nil || len(a.secretStores) == 0 {
+	unlock := a.rlockComponents()
+	store := a.secretStores[in.StoreName]
+	secretStoresCount := len(a.secretStores)
+	unlock()
+
+	if secretStoresCount == 0 {
 		err := status.Error(codes.FailedPrecondition, messages.ErrSecretStoreNotConfigured)
 		apiServerLogger.Debug(err)
 		return &runtimev1pb.GetBulkSecretResponse{}, err
@@ -1015,7 +1277,7 @@ func (a *api) GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSecretRe
 
 	secretStoreName := in.StoreName
 
-	if a.secretStores[secretStoreName] == nil {
+	if store == nil {
 		err := status.Errorf(codes.InvalidArgument, messages.ErrSecretStoreNotFound, secretStoreName)
 		apiServerLogger.Debug(err)
 		return &runtimev1pb.GetBulkSecretResponse{}, err
@@ -1029,7 +1291,7 @@ func (a *api) GetBulkSecret(ctx context.Context, in *runtimev1pb.GetBulkSecretRe
 	policy := a.resiliency.ComponentOutboundPolicy(ctx, secretStoreName)
 	var getResponse secretstores.BulkGetSecretResponse
 	err := policy(func(ctx context.Context) (rErr error) {
-		getResponse, rErr = a.secretStores[secretStoreName].BulkGetSecret(req)
+		getResponse, rErr = store.BulkGetSecret(req)
 		return rErr
 	})
 	elapsed := diag.ElapsedSince(start)
@@ -1069,22 +1331,16 @@ func extractEtag(req *commonv1pb.StateItem) (bool, string) {
 }
 
 func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.ExecuteStateTransactionRequest) (*emptypb.Empty, error) {
-	if a.stateStores == nil || len(a.stateStores) == 0 {
-		err := status.Error(codes.FailedPrecondition, messages.ErrStateStoresNotConfigured)
-		apiServerLogger.Debug(err)
-		return &emptypb.Empty{}, err
-	}
-
 	storeName := in.StoreName
 
-	if a.stateStores[storeName] == nil {
-		err := status.Errorf(codes.InvalidArgument, messages.ErrStateStoreNotFound, storeName)
+	store, err := a.getStateStore(storeName)
+	if err != nil {
 		apiServerLogger.Debug(err)
 		return &emptypb.Empty{}, err
 	}
 
-	transactionalStore, ok := a.transactionalStateStores[storeName]
-	if !ok {
+	transactionalStore, ok := store.(state.TransactionalStore)
+	if !ok || !state.FeatureTransactional.IsPresent(store.Features()) {
 		err := status.Errorf(codes.Unimplemented, messages.ErrStateStoreNotSupported, storeName)
 		apiServerLogger.Debug(err)
 		return &emptypb.Empty{}, err
@@ -1096,7 +1352,7 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 		req := inputReq.Request
 
 		hasEtag, etag := extractEtag(req)
-		key, err := state_loader.GetModifiedStateKey(req.Key, in.StoreName, a.id)
+		key, err := a.getModifiedStateKeyToWrite(req.Key, in.StoreName)
 		if err != nil {
 			return &emptypb.Empty{}, err
 		}
@@ -1174,9 +1430,44 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 		}
 	}
 
+	var outboxKeys []string
+	if len(in.OutboxEvents) > 0 {
+		if !outbox.OutboxStateStore(storeName) {
+			err := status.Errorf(codes.FailedPrecondition, messages.ErrStateOutboxNotConfigured, storeName)
+			apiServerLogger.Debug(err)
+			return &emptypb.Empty{}, err
+		}
+
+		// Populate the W3C trace context of the request to the cloudevent envelopes of the events.
+		span := diag_utils.SpanFromContext(ctx)
+		corID := diag.SpanContextToW3CString(span.SpanContext())
+		traceState := diag.TraceStateToW3CString(span.SpanContext())
+
+		events := make([]outbox.Event, len(in.OutboxEvents))
+		for i, e := range in.OutboxEvents {
+			events[i] = outbox.Event{
+				ID:              e.Id,
+				Data:            e.Data,
+				DataContentType: e.DataContentType,
+				Metadata:        e.Metadata,
+				TraceID:         corID,
+				TraceState:      traceState,
+			}
+		}
+
+		outboxOperations, keys, err := outbox.Operations(storeName, a.id, events)
+		if err != nil {
+			err = status.Errorf(codes.InvalidArgument, messages.ErrStateOutboxEvents, storeName, err.Error())
+			apiServerLogger.Debug(err)
+			return &emptypb.Empty{}, err
+		}
+		operations = append(operations, outboxOperations...)
+		outboxKeys = keys
+	}
+
 	start := time.Now()
 	policy := a.resiliency.ComponentOutboundPolicy(ctx, in.StoreName)
-	err := policy(func(ctx context.Context) error {
+	err = policy(func(ctx context.Context) error {
 		return transactionalStore.Multi(&state.TransactionalStateRequest{
 			Operations: operations,
 			Metadata:   in.Metadata,
@@ -1191,6 +1482,8 @@ func (a *api) ExecuteStateTransaction(ctx context.Context, in *runtimev1pb.Execu
 		apiServerLogger.Debug(err)
 		return &emptypb.Empty{}, err
 	}
+
+	outbox.EventsSaved(storeName, outboxKeys)
 	return &emptypb.Empty{}, nil
 }
 
@@ -1202,13 +1495,14 @@ func (a *api) RegisterActorTimer(ctx context.Context, in *runtimev1pb.RegisterAc
 	}
 
 	req := &actors.CreateTimerRequest{
-		Name:      in.Name,
-		ActorID:   in.ActorId,
-		ActorType: in.ActorType,
-		DueTime:   in.DueTime,
-		Period:    in.Period,
-		TTL:       in.Ttl,
-		Callback:  in.Callback,
+		Name:       in.Name,
+		ActorID:    in.ActorId,
+		ActorType:  in.ActorType,
+		DueTime:    in.DueTime,
+		Period:     in.Period,
+		TTL:        in.Ttl,
+		Callback:   in.Callback,
+		Persistent: in.Persistent,
 	}
 
 	if in.Data != nil {
@@ -1293,6 +1587,70 @@ func (a *api) RenameActorReminder(ctx context.Context, in *runtimev1pb.RenameAct
 	return &emptypb.Empty{}, err
 }
 
+func (a *api) ListActorReminders(ctx context.Context, in *runtimev1pb.ListActorRemindersRequest) (*runtimev1pb.ListActorRemindersResponse, error) {
+	if a.actor == nil {
+		err := status.Errorf(codes.Internal, messages.ErrActorRuntimeNotFound)
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.ListActorRemindersResponse{}, err
+	}
+
+	resp, err := a.actor.ListReminders(ctx, &actors.ListRemindersRequest{
+		ActorType:         in.ActorType,
+		ActorID:           in.ActorId,
+		PageSize:          int(in.PageSize),
+		ContinuationToken: in.ContinuationToken,
+	})
+	if err != nil {
+		err = status.Errorf(codes.Internal, messages.ErrActorRemindersList, err)
+		apiServerLogger.Debug(err)
+		return &runtimev1pb.ListActorRemindersResponse{}, err
+	}
+
+	reminders := make([]*runtimev1pb.ActorReminder, len(resp.Reminders))
+	for i, r := range resp.Reminders {
+		reminder := &runtimev1pb.ActorReminder{
+			ActorType:      r.ActorType,
+			ActorId:        r.ActorID,
+			Name:           r.Name,
+			DueTime:        r.DueTime,
+			Period:         r.Period,
+			RegisteredTime: r.RegisteredTime,
+			ExpirationTime: r.ExpirationTime,
+		}
+		if r.Data != nil {
+			if reminder.Data, err = json.Marshal(r.Data); err != nil {
+				err = status.Errorf(codes.Internal, messages.ErrActorRemindersList, err)
+				apiServerLogger.Debug(err)
+				return &runtimev1pb.ListActorRemindersResponse{}, err
+			}
+		}
+		reminders[i] = reminder
+	}
+
+	return &runtimev1pb.ListActorRemindersResponse{
+		Reminders:         reminders,
+		ContinuationToken: resp.ContinuationToken,
+	}, nil
+}
+
+func (a *api) DeleteActorReminders(ctx context.Context, in *runtimev1pb.DeleteActorRemindersRequest) (*emptypb.Empty, error) {
+	if a.actor == nil {
+		err := status.Errorf(codes.Internal, messages.ErrActorRuntimeNotFound)
+		apiServerLogger.Debug(err)
+		return &emptypb.Empty{}, err
+	}
+
+	err := a.actor.DeleteReminders(ctx, &actors.DeleteRemindersRequest{
+		ActorType: in.ActorType,
+		ActorID:   in.ActorId,
+	})
+	if err != nil {
+		err = status.Errorf(codes.Internal, messages.ErrActorRemindersDelete, err)
+		apiServerLogger.Debug(err)
+	}
+	return &emptypb.Empty{}, err
+}
+
 func (a *api) GetActorState(ctx context.Context, in *runtimev1pb.GetActorStateRequest) (*runtimev1pb.GetActorStateResponse, error) {
 	if a.actor == nil {
 		err := status.Errorf(codes.Internal, messages.ErrActorRuntimeNotFound)
@@ -1430,7 +1788,11 @@ func (a *api) InvokeActor(ctx context.Context, in *runtimev1pb.InvokeActorReques
 		return rErr
 	})
 	if err != nil {
-		err = status.Errorf(codes.Internal, messages.ErrActorInvoke, err)
+		code := codes.Internal
+		if errors.Is(err, resiliency.ErrBulkheadFull) {
+			code = codes.ResourceExhausted
+		}
+		err = status.Errorf(code, messages.ErrActorInvoke, err)
 		apiServerLogger.Debug(err)
 		return &runtimev1pb.InvokeActorResponse{}, err
 	}
@@ -1461,6 +1823,30 @@ func (a *api) SetActorRuntime(actor actors.Actors) {
 	a.actor = actor
 }
 
+func (a *api) SetAppHealth(appHealth *apphealth.AppHealth) {
+	a.appHealth = appHealth
+}
+
+func (a *api) SetAppConnectionConfig(appConnectionConfig channel.AppConnectionConfig) {
+	a.appConnectionConfig = appConnectionConfig
+}
+
+func (a *api) SetEnabledFeatures(enabledFeatures []string) {
+	a.enabledFeatures = enabledFeatures
+}
+
+func (a *api) SetSubscriptionsFn(getSubscriptionsFn func() []runtime_pubsub.Subscription) {
+	a.getSubscriptionsFn = getSubscriptionsFn
+}
+
+func (a *api) SetComponentsCapabilitiesFn(getCapabilitiesFn func() map[string][]string) {
+	a.getCapabilitiesFn = getCapabilitiesFn
+}
+
+func (a *api) SetReEncryptionJobs(reEncryptionJobs *encryption.ReEncryptionJobs) {
+	a.reEncryptionJobs = reEncryptionJobs
+}
+
 func (a *api) GetMetadata(ctx context.Context, in *emptypb.Empty) (*runtimev1pb.GetMetadataResponse, error) {
 	temp := make(map[string]string)
 
@@ -1469,23 +1855,139 @@ func (a *api) GetMetadata(ctx context.Context, in *emptypb.Empty) (*runtimev1pb.
 		temp[key.(string)] = value.(string)
 		return true
 	})
-	registeredComponents := make([]*runtimev1pb.RegisteredComponents, 0, len(a.components))
 
-	for _, comp := range a.components {
+	var capabilities map[string][]string
+	if a.getCapabilitiesFn != nil {
+		capabilities = a.getCapabilitiesFn()
+	}
+
+	var components []components_v1alpha.Component
+	if a.getComponentsFn != nil {
+		components = a.getComponentsFn()
+	}
+	registeredComponents := make([]*runtimev1pb.RegisteredComponents, 0, len(components))
+
+	for _, comp := range components {
 		registeredComp := &runtimev1pb.RegisteredComponents{
-			Name:    comp.Name,
-			Version: comp.Spec.Version,
-			Type:    comp.Spec.Type,
+			Name:         comp.Name,
+			Version:      comp.Spec.Version,
+			Type:         comp.Spec.Type,
+			Capabilities: capabilities[comp.Name],
 		}
 		registeredComponents = append(registeredComponents, registeredComp)
 	}
 	response := &runtimev1pb.GetMetadataResponse{
 		ExtendedMetadata:     temp,
 		RegisteredComponents: registeredComponents,
+		EnabledFeatures:      a.enabledFeatures,
+	}
+	if a.appHealth != nil {
+		cfg := a.appHealth.Config()
+		response.AppHealth = &runtimev1pb.AppHealth{
+			Status:              a.appHealth.Status(),
+			HealthCheckPath:     cfg.Path,
+			HealthProbeInterval: cfg.ProbeInterval.String(),
+			HealthProbeTimeout:  cfg.ProbeTimeout.String(),
+			HealthThreshold:     cfg.Threshold,
+		}
+	}
+	if a.resiliency != nil {
+		response.Resiliency = resiliencyStatusToProto(a.resiliency.Status())
+	}
+	if a.getSubscriptionsFn != nil {
+		for _, s := range a.getSubscriptionsFn() {
+			sub := &runtimev1pb.PubsubSubscription{
+				PubsubName:      s.PubsubName,
+				Topic:           s.Topic,
+				Metadata:        s.Metadata,
+				DeadLetterTopic: s.DeadLetterTopic,
+			}
+			for _, r := range s.Rules {
+				sub.Rules = append(sub.Rules, &runtimev1pb.PubsubSubscriptionRule{
+					Match: r.MatchExpression(),
+					Path:  r.Path,
+				})
+			}
+			response.Subscriptions = append(response.Subscriptions, sub)
+		}
+	}
+	if a.appConnectionConfig.Protocol != "" {
+		response.AppConnectionProperties = &runtimev1pb.AppConnectionProperties{
+			Protocol:       a.appConnectionConfig.Protocol,
+			Port:           int32(a.appConnectionConfig.Port),
+			MaxConcurrency: int32(a.appConnectionConfig.MaxConcurrency),
+		}
+	}
+	if a.actor != nil {
+		placement := a.actor.GetPlacementStatus()
+		response.Placement = &runtimev1pb.ActorPlacementStatus{
+			Connected:    placement.Connected,
+			TableVersion: placement.TableVersion,
+		}
+	}
+	if a.reEncryptionJobs != nil {
+		for _, s := range a.reEncryptionJobs.Statuses() {
+			response.StateReencryption = append(response.StateReencryption, reEncryptionStatusToProto(s))
+		}
 	}
 	return response, nil
 }
 
+func reEncryptionStatusToProto(status encryption.ReEncryptionStatus) *runtimev1pb.StateReEncryptionStatus {
+	res := &runtimev1pb.StateReEncryptionStatus{
+		StoreName:   status.StoreName,
+		State:       status.State,
+		PrimaryKey:  status.PrimaryKey,
+		Scanned:     status.Scanned,
+		Reencrypted: status.ReEncrypted,
+		NoEtag:      status.NoETag,
+		Failed:      status.Failed,
+		StartedAt:   status.StartedAt.Format(time.RFC3339),
+		Error:       status.Error,
+	}
+	if status.CompletedAt != nil {
+		res.CompletedAt = status.CompletedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+func resiliencyStatusToProto(status resiliency.Status) *runtimev1pb.ResiliencyStatus {
+	res := &runtimev1pb.ResiliencyStatus{
+		Policies: &runtimev1pb.ResiliencyPolicies{
+			Timeouts:        status.Policies.Timeouts,
+			Retries:         status.Policies.Retries,
+			CircuitBreakers: status.Policies.CircuitBreakers,
+			Bulkheads:       status.Policies.Bulkheads,
+		},
+		Targets:         make([]*runtimev1pb.ResiliencyTarget, len(status.Targets)),
+		CircuitBreakers: make([]*runtimev1pb.CircuitBreakerStatus, len(status.CircuitBreakers)),
+	}
+	for i, t := range status.Targets {
+		res.Targets[i] = &runtimev1pb.ResiliencyTarget{
+			Type:           string(t.Type),
+			Name:           t.Name,
+			Direction:      t.Direction,
+			Timeout:        t.Timeout,
+			Retry:          t.Retry,
+			CircuitBreaker: t.CircuitBreaker,
+			Bulkhead:       t.Bulkhead,
+		}
+	}
+	for i, cb := range status.CircuitBreakers {
+		res.CircuitBreakers[i] = &runtimev1pb.CircuitBreakerStatus{
+			Name:                 cb.Name,
+			Target:               cb.Target,
+			State:                cb.State,
+			Requests:             cb.Requests,
+			TotalSuccesses:       cb.TotalSuccesses,
+			TotalFailures:        cb.TotalFailures,
+			ConsecutiveSuccesses: cb.ConsecutiveSuccesses,
+			ConsecutiveFailures:  cb.ConsecutiveFailures,
+		}
+	}
+	return res
+}
+
 // SetMetadata Sets value in extended metadata of the sidecar.
 func (a *api) SetMetadata(ctx context.Context, in *runtimev1pb.SetMetadataRequest) (*emptypb.Empty, error) {
 	a.extendedMetadata.Store(in.Key, in.Value)
@@ -1510,6 +2012,9 @@ func stringValueOrEmpty(value *string) string {
 }
 
 func (a *api) getConfigurationStore(name string) (configuration.Store, error) {
+	unlock := a.rlockComponents()
+	defer unlock()
+
 	if a.configurationStores == nil || len(a.configurationStores) == 0 {
 		return nil, status.Error(codes.FailedPrecondition, messages.ErrConfigurationStoresNotConfigured)
 	}
@@ -1624,13 +2129,13 @@ func (a *api) SubscribeConfigurationAlpha1(request *runtimev1pb.SubscribeConfigu
 
 		items := resp.GetItems()
 		for _, item := range items {
-			if _, ok := a.configurationSubscribe[fmt.Sprintf("%s||%s", request.StoreName, item.Key)]; !ok {
+			if !a.configurationSubscribe.Has(fmt.Sprintf("%s||%s", request.StoreName, item.Key)) {
 				subscribeKeys = append(subscribeKeys, item.Key)
 			}
 		}
 	} else {
 		for _, k := range request.Keys {
-			if _, ok := a.configurationSubscribe[fmt.Sprintf("%s||%s", request.StoreName, k)]; !ok {
+			if !a.configurationSubscribe.Has(fmt.Sprintf("%s||%s", request.StoreName, k)) {
 				subscribeKeys = append(subscribeKeys, k)
 			}
 		}
@@ -1650,9 +2155,10 @@ func (a *api) SubscribeConfigurationAlpha1(request *runtimev1pb.SubscribeConfigu
 	// TODO(@laurence) deal with failed subscription and retires
 	start := time.Now()
 	policy := a.resiliency.ComponentOutboundPolicy(newCtx, request.StoreName)
-	var id string
+	var stopped <-chan struct{}
 	err = policy(func(ctx context.Context) (rErr error) {
-		id, rErr = store.Subscribe(ctx, req, handler.updateEventHandler)
+		// The subscription is streamed to the app until it is stopped on unsubscribe.
+		_, stopped, rErr = a.configurationSubscribe.Subscribe(ctx, store, request.StoreName, req, handler.updateEventHandler)
 		return rErr
 	})
 	elapsed := diag.ElapsedSince(start)
@@ -1664,11 +2170,7 @@ func (a *api) SubscribeConfigurationAlpha1(request *runtimev1pb.SubscribeConfigu
 		apiServerLogger.Debug(err)
 		return err
 	}
-	stop := make(chan struct{})
-	a.configurationSubscribeLock.Lock()
-	a.configurationSubscribe[id] = stop
-	a.configurationSubscribeLock.Unlock()
-	<-stop
+	<-stopped
 	return nil
 }
 
@@ -1682,38 +2184,30 @@ func (a *api) UnsubscribeConfigurationAlpha1(ctx context.Context, request *runti
 		}, err
 	}
 
-	a.configurationSubscribeLock.Lock()
-	defer a.configurationSubscribeLock.Unlock()
-
 	subscribeID := request.GetId()
 
-	stop, ok := a.configurationSubscribe[subscribeID]
-	if !ok {
-		return &runtimev1pb.UnsubscribeConfigurationResponse{
-			Ok: true,
-		}, nil
-	}
-	delete(a.configurationSubscribe, subscribeID)
-	close(stop)
-
-	policy := a.resiliency.ComponentOutboundPolicy(ctx, request.StoreName)
+	// Unknown subscriptions are considered already removed.
+	_, err = a.configurationSubscribe.Unsubscribe(subscribeID, request.GetStoreName(), func() error {
+		policy := a.resiliency.ComponentOutboundPolicy(ctx, request.StoreName)
 
-	start := time.Now()
-	err = policy(func(ctx context.Context) error {
-		return store.Unsubscribe(ctx, &configuration.UnsubscribeRequest{
-			ID: subscribeID,
+		start := time.Now()
+		err := policy(func(ctx context.Context) error {
+			return store.Unsubscribe(ctx, &configuration.UnsubscribeRequest{
+				ID: subscribeID,
+			})
 		})
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.ConfigurationInvoked(context.Background(), request.StoreName, diag.ConfigurationUnsubscribe, err == nil, elapsed)
+		elapsed := diag.ElapsedSince(start)
 
+		diag.DefaultComponentMonitoring.ConfigurationInvoked(context.Background(), request.StoreName, diag.ConfigurationUnsubscribe, err == nil, elapsed)
+		return err
+	})
 	if err != nil {
 		return &runtimev1pb.UnsubscribeConfigurationResponse{
 			Ok:      false,
 			Message: err.Error(),
 		}, err
 	}
+
 	return &runtimev1pb.UnsubscribeConfigurationResponse{
 		Ok: true,
 	}, nil
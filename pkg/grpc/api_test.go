@@ -20,7 +20,6 @@ import (
 	"io"
 	"net"
 	"strconv"
-	"sync"
 	"testing"
 	"time"
 
@@ -33,6 +32,7 @@ import (
 	"github.com/phayes/freeport"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.opencensus.io/trace"
 	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
@@ -48,9 +48,12 @@ import (
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/dapr/pkg/actors"
 	components_v1alpha "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	"github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/channel"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	configuration_loader "github.com/dapr/dapr/pkg/components/configuration"
 	state_loader "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
@@ -104,6 +107,12 @@ var testResiliency = &v1alpha1.Resiliency{
 					Trip:        "consecutiveFailures > 4",
 				},
 			},
+			Bulkheads: map[string]v1alpha1.Bulkhead{
+				"singleCall": {
+					MaxConcurrent: 1,
+					MaxQueueWait:  "10ms",
+				},
+			},
 		},
 		Targets: v1alpha1.Targets{
 			Apps: map[string]v1alpha1.EndpointPolicyNames{
@@ -111,6 +120,10 @@ var testResiliency = &v1alpha1.Resiliency{
 					Retry:   "singleRetry",
 					Timeout: "fast",
 				},
+				"bulkheadApp": {
+					Timeout:  "fast",
+					Bulkhead: "singleCall",
+				},
 				"circuitBreakerApp": {
 					Retry:          "tenRetries",
 					CircuitBreaker: "simpleCB",
@@ -148,6 +161,10 @@ func (m *mockGRPCAPI) CallLocal(ctx context.Context, in *internalv1pb.InternalIn
 	return resp.Proto(), nil
 }
 
+func (m *mockGRPCAPI) CallLocalStream(stream internalv1pb.ServiceInvocation_CallLocalStreamServer) error {
+	return status.Error(codes.Unimplemented, "CallLocalStream not implemented")
+}
+
 func (m *mockGRPCAPI) CallActor(ctx context.Context, in *internalv1pb.InternalInvokeRequest) (*internalv1pb.InternalInvokeResponse, error) {
 	resp := invokev1.NewInvokeMethodResponse(0, "", nil)
 	resp.WithRawData(ExtractSpanContext(ctx), "text/plains")
@@ -1319,7 +1336,7 @@ func TestSubscribeConfiguration(t *testing.T) {
 		mock.AnythingOfType("configuration.UpdateHandler")).Return(nil, errors.New("failed to get state with error-key"))
 
 	fakeAPI := &api{
-		configurationSubscribe: make(map[string]chan struct{}),
+		configurationSubscribe: configuration_loader.NewSubscriptions(),
 		id:                     "fakeAPI",
 		configurationStores:    map[string]configuration.Store{"store1": fakeConfigurationStore},
 		resiliency:             resiliency.New(nil),
@@ -1505,7 +1522,7 @@ func TestUnSubscribeConfiguration(t *testing.T) {
 		})).Return(mockSubscribeID, nil)
 
 	fakeAPI := &api{
-		configurationSubscribe: make(map[string]chan struct{}),
+		configurationSubscribe: configuration_loader.NewSubscriptions(),
 		id:                     "fakeAPI",
 		configurationStores:    map[string]configuration.Store{"store1": fakeConfigurationStore},
 		resiliency:             resiliency.New(nil),
@@ -1601,6 +1618,14 @@ func TestUnSubscribeConfiguration(t *testing.T) {
 			assert.Error(t, err, "Unsubscribed channel should returns EOF")
 		})
 	}
+
+	t.Run("Test unsubscribe unknown subscription", func(t *testing.T) {
+		_, err := client.UnsubscribeConfigurationAlpha1(context.Background(), &runtimev1pb.UnsubscribeConfigurationRequest{
+			StoreName: "store1",
+			Id:        "unknown",
+		})
+		assert.NoError(t, err)
+	})
 }
 
 func TestGetBulkState(t *testing.T) {
@@ -1858,6 +1883,105 @@ func TestPublishTopic(t *testing.T) {
 	assert.Equal(t, codes.PermissionDenied, status.Code(err))
 }
 
+func TestBulkPublishEvent(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+
+	srv := &api{
+		pubsubAdapter: &daprt.MockPubSubAdapter{
+			BulkPublishFn: func(req *runtime_pubsub.BulkPublishRequest) (runtime_pubsub.BulkPublishResponse, error) {
+				if req.Topic == "error-topic" {
+					return runtime_pubsub.BulkPublishResponse{}, errors.New("error when publish")
+				}
+
+				if req.Topic == "err-not-allowed" {
+					return runtime_pubsub.BulkPublishResponse{}, runtime_pubsub.NotAllowedError{Topic: req.Topic, ID: "test"}
+				}
+
+				res := runtime_pubsub.BulkPublishResponse{}
+				for _, entry := range req.Entries {
+					if entry.Metadata["fail"] == "true" {
+						res.FailedEntries = append(res.FailedEntries, runtime_pubsub.BulkPublishResponseFailedEntry{
+							EntryID: entry.EntryID,
+							Error:   errors.New("failed entry"),
+						})
+					}
+				}
+				return res, nil
+			},
+			GetPubSubFn: func(pubsubName string) pubsub.PubSub {
+				return &daprt.MockPubSub{}
+			},
+		},
+	}
+	server := startTestServerAPI(port, srv)
+	defer server.Stop()
+
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	entries := []*runtimev1pb.BulkPublishRequestEntry{
+		{EntryId: "1", Event: []byte("event1"), ContentType: "text/plain"},
+		{EntryId: "2", Event: []byte(`{"key":"value"}`), ContentType: "application/json", Metadata: map[string]string{"fail": "true"}},
+	}
+
+	t.Run("no pubsub name", func(t *testing.T) {
+		_, err := client.BulkPublishEvent(context.Background(), &runtimev1pb.BulkPublishRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("no entries", func(t *testing.T) {
+		_, err := client.BulkPublishEvent(context.Background(), &runtimev1pb.BulkPublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("duplicated entry id", func(t *testing.T) {
+		_, err := client.BulkPublishEvent(context.Background(), &runtimev1pb.BulkPublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+			Entries: []*runtimev1pb.BulkPublishRequestEntry{
+				{EntryId: "1", Event: []byte("event1")},
+				{EntryId: "1", Event: []byte("event2")},
+			},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("failed entries are returned", func(t *testing.T) {
+		res, err := client.BulkPublishEvent(context.Background(), &runtimev1pb.BulkPublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "topic",
+			Entries:    entries,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.FailedEntries))
+		assert.Equal(t, "2", res.FailedEntries[0].EntryId)
+		assert.Equal(t, "failed entry", res.FailedEntries[0].Error)
+	})
+
+	t.Run("publish error", func(t *testing.T) {
+		_, err := client.BulkPublishEvent(context.Background(), &runtimev1pb.BulkPublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "error-topic",
+			Entries:    entries,
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("not allowed", func(t *testing.T) {
+		_, err := client.BulkPublishEvent(context.Background(), &runtimev1pb.BulkPublishRequest{
+			PubsubName: "pubsub",
+			Topic:      "err-not-allowed",
+			Entries:    entries,
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
 func TestShutdownEndpoints(t *testing.T) {
 	port, _ := freeport.GetFreePort()
 
@@ -1962,14 +2086,10 @@ func TestExecuteStateTransaction(t *testing.T) {
 		return matchKeyFn(req, "error-key")
 	})).Return(errors.New("error to execute with key2"))
 
-	var fakeTransactionalStore state.TransactionalStore = fakeStore
 	fakeAPI := &api{
 		id:          "fakeAPI",
 		stateStores: map[string]state.Store{"store1": fakeStore},
-		transactionalStateStores: map[string]state.TransactionalStore{
-			"store1": fakeTransactionalStore,
-		},
-		resiliency: resiliency.New(nil),
+		resiliency:  resiliency.New(nil),
 	}
 	port, _ := freeport.GetFreePort()
 	server := startDaprAPIServer(port, fakeAPI, "")
@@ -2051,15 +2171,62 @@ func TestExecuteStateTransaction(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("outbox events without outbox", func(t *testing.T) {
+		_, err := client.ExecuteStateTransaction(context.Background(), &runtimev1pb.ExecuteStateTransactionRequest{
+			StoreName: "store1",
+			Operations: []*runtimev1pb.TransactionalStateOperation{
+				{
+					OperationType: string(state.Upsert),
+					Request: &commonv1pb.StateItem{
+						Key:   goodKey,
+						Value: []byte("1"),
+					},
+				},
+			},
+			OutboxEvents: []*runtimev1pb.TransactionalOutboxEvent{
+				{
+					Data:            []byte("event"),
+					DataContentType: "text/plain",
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
 }
 
 func TestGetMetadata(t *testing.T) {
 	port, _ := freeport.GetFreePort()
 	fakeComponent := components_v1alpha.Component{}
 	fakeComponent.Name = "testComponent"
+	mockActors := new(actors.MockActors)
+	mockActors.On("GetPlacementStatus").Return(actors.PlacementStatus{Connected: true, TableVersion: "1"})
 	fakeAPI := &api{
-		id:         "fakeAPI",
-		components: []components_v1alpha.Component{fakeComponent},
+		id:    "fakeAPI",
+		actor: mockActors,
+		getComponentsFn: func() []components_v1alpha.Component {
+			return []components_v1alpha.Component{fakeComponent}
+		},
+		getCapabilitiesFn: func() map[string][]string {
+			return map[string][]string{"testComponent": {"ETAG", "TRANSACTIONAL"}}
+		},
+		getSubscriptionsFn: func() []runtime_pubsub.Subscription {
+			return []runtime_pubsub.Subscription{
+				{
+					PubsubName:      "pubsub",
+					Topic:           "topic",
+					DeadLetterTopic: "dead",
+					Rules:           []*runtime_pubsub.Rule{{Path: "/orders"}},
+				},
+			}
+		},
+		appConnectionConfig: channel.AppConnectionConfig{
+			Protocol:       "grpc",
+			Port:           3000,
+			MaxConcurrency: -1,
+		},
+		enabledFeatures: []string{"Resiliency"},
 	}
 	fakeAPI.extendedMetadata.Store("testKey", "testValue")
 	server := startDaprAPIServer(port, fakeAPI, "")
@@ -2073,8 +2240,48 @@ func TestGetMetadata(t *testing.T) {
 	assert.NoError(t, err, "Expected no error")
 	assert.Len(t, response.RegisteredComponents, 1, "One component should be returned")
 	assert.Equal(t, response.RegisteredComponents[0].Name, "testComponent")
+	assert.Equal(t, []string{"ETAG", "TRANSACTIONAL"}, response.RegisteredComponents[0].Capabilities)
 	assert.Contains(t, response.ExtendedMetadata, "testKey")
 	assert.Equal(t, response.ExtendedMetadata["testKey"], "testValue")
+
+	require.Len(t, response.Subscriptions, 1)
+	assert.Equal(t, "pubsub", response.Subscriptions[0].PubsubName)
+	assert.Equal(t, "dead", response.Subscriptions[0].DeadLetterTopic)
+	require.Len(t, response.Subscriptions[0].Rules, 1)
+	assert.Equal(t, "", response.Subscriptions[0].Rules[0].Match)
+	assert.Equal(t, "/orders", response.Subscriptions[0].Rules[0].Path)
+
+	assert.Equal(t, "grpc", response.AppConnectionProperties.Protocol)
+	assert.Equal(t, int32(3000), response.AppConnectionProperties.Port)
+	assert.Equal(t, int32(-1), response.AppConnectionProperties.MaxConcurrency)
+	assert.True(t, response.Placement.Connected)
+	assert.Equal(t, "1", response.Placement.TableVersion)
+	assert.Equal(t, []string{"Resiliency"}, response.EnabledFeatures)
+}
+
+func TestGetMetadataResiliency(t *testing.T) {
+	port, _ := freeport.GetFreePort()
+	fakeAPI := &api{
+		id:         "fakeAPI",
+		resiliency: resiliency.FromConfigurations(logger.NewLogger("grpc.api.test"), testResiliency),
+	}
+	server := startDaprAPIServer(port, fakeAPI, "")
+	defer server.Stop()
+
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+	response, err := client.GetMetadata(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.NotNil(t, response.Resiliency)
+	assert.Equal(t, []string{"fast"}, response.Resiliency.Policies.Timeouts)
+	assert.Equal(t, []string{"simpleCB"}, response.Resiliency.Policies.CircuitBreakers)
+	// Two apps, and three components with inbound and outbound targets.
+	require.Len(t, response.Resiliency.Targets, 8)
+	assert.Equal(t, "circuitBreakerApp", response.Resiliency.Targets[0].Name)
+	assert.Equal(t, "simpleCB", response.Resiliency.Targets[0].CircuitBreaker)
+	assert.Empty(t, response.Resiliency.CircuitBreakers)
 }
 
 func TestSetMetadata(t *testing.T) {
@@ -2358,6 +2565,62 @@ func TestStateStoreQuerierEncrypted(t *testing.T) {
 	assert.Equal(t, codes.Aborted, status.Code(err))
 }
 
+func TestReEncryptStateAlpha1(t *testing.T) {
+	port, err := freeport.GetFreePort()
+	assert.NoError(t, err)
+
+	storeName := "encrypted-store2"
+	encryption.AddEncryptedStateStore(storeName, encryption.ComponentEncryptionKeys{})
+
+	fakeStore := &mockStateStoreQuerier{}
+	fakeStore.MockQuerier.On("Query", mock.Anything).Return(&state.QueryResponse{}, nil)
+
+	reEncryptionJobs := encryption.NewReEncryptionJobs(context.Background())
+	server := startDaprAPIServer(
+		port,
+		&api{
+			id: "fakeAPI",
+			stateStores: map[string]state.Store{
+				storeName: fakeStore,
+				"store1":  &mockStateStoreQuerier{},
+			},
+			resiliency:       resiliency.New(nil),
+			reEncryptionJobs: reEncryptionJobs,
+		},
+		"")
+	defer server.Stop()
+
+	clientConn := createTestClient(port)
+	defer clientConn.Close()
+
+	client := runtimev1pb.NewDaprClient(clientConn)
+
+	t.Run("encrypted state store", func(t *testing.T) {
+		_, err := client.ReEncryptStateAlpha1(context.Background(), &runtimev1pb.ReEncryptStateRequest{
+			StoreName: storeName,
+		})
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			statuses := reEncryptionJobs.Statuses()
+			return len(statuses) == 1 && statuses[0].State == encryption.ReEncryptionCompleted
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("state store not encrypted", func(t *testing.T) {
+		_, err := client.ReEncryptStateAlpha1(context.Background(), &runtimev1pb.ReEncryptStateRequest{
+			StoreName: "store1",
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("state store not found", func(t *testing.T) {
+		_, err := client.ReEncryptStateAlpha1(context.Background(), &runtimev1pb.ReEncryptStateRequest{
+			StoreName: "store2",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestGetConfigurationAlpha1(t *testing.T) {
 	t.Run("get configuration item", func(t *testing.T) {
 		port, err := freeport.GetFreePort()
@@ -2400,11 +2663,10 @@ func TestSubscribeConfigurationAlpha1(t *testing.T) {
 		server := startDaprAPIServer(
 			port,
 			&api{
-				id:                         "fakeAPI",
-				configurationStores:        map[string]configuration.Store{"store1": &mockConfigStore{}},
-				configurationSubscribe:     make(map[string]chan struct{}),
-				configurationSubscribeLock: sync.Mutex{},
-				resiliency:                 resiliency.New(nil),
+				id:                     "fakeAPI",
+				configurationStores:    map[string]configuration.Store{"store1": &mockConfigStore{}},
+				configurationSubscribe: configuration_loader.NewSubscriptions(),
+				resiliency:             resiliency.New(nil),
 			},
 			"")
 		defer server.Stop()
@@ -2450,11 +2712,10 @@ func TestSubscribeConfigurationAlpha1(t *testing.T) {
 		server := startDaprAPIServer(
 			port,
 			&api{
-				id:                         "fakeAPI",
-				configurationStores:        map[string]configuration.Store{"store1": &mockConfigStore{}},
-				configurationSubscribe:     make(map[string]chan struct{}),
-				configurationSubscribeLock: sync.Mutex{},
-				resiliency:                 resiliency.New(nil),
+				id:                     "fakeAPI",
+				configurationStores:    map[string]configuration.Store{"store1": &mockConfigStore{}},
+				configurationSubscribe: configuration_loader.NewSubscriptions(),
+				resiliency:             resiliency.New(nil),
 			},
 			"")
 		defer server.Stop()
@@ -2524,10 +2785,9 @@ func TestStateAPIWithResiliency(t *testing.T) {
 	state_loader.SaveStateConfiguration("failStore", map[string]string{"keyPrefix": "none"})
 
 	fakeAPI := &api{
-		id:                       "fakeAPI",
-		stateStores:              map[string]state.Store{"failStore": failingStore},
-		transactionalStateStores: map[string]state.TransactionalStore{"failStore": failingStore},
-		resiliency:               resiliency.FromConfigurations(logger.NewLogger("grpc.api.test"), testResiliency),
+		id:          "fakeAPI",
+		stateStores: map[string]state.Store{"failStore": failingStore},
+		resiliency:  resiliency.FromConfigurations(logger.NewLogger("grpc.api.test"), testResiliency),
 	}
 	port, _ := freeport.GetFreePort()
 	server := startDaprAPIServer(port, fakeAPI, "")
@@ -2768,7 +3028,7 @@ func TestConfigurationAPIWithResiliency(t *testing.T) {
 	fakeAPI := &api{
 		id:                     "fakeAPI",
 		configurationStores:    map[string]configuration.Store{"failConfig": &failingConfigStore},
-		configurationSubscribe: map[string]chan struct{}{},
+		configurationSubscribe: configuration_loader.NewSubscriptions(),
 		resiliency:             resiliency.FromConfigurations(logger.NewLogger("grpc.api.test"), testResiliency),
 	}
 	port, _ := freeport.GetFreePort()
@@ -2830,9 +3090,10 @@ func TestConfigurationAPIWithResiliency(t *testing.T) {
 	})
 
 	t.Run("test unsubscribe configuration retries with resiliency", func(t *testing.T) {
-		fakeAPI.configurationSubscribe["failingUnsubscribeKey"] = make(chan struct{})
+		_, _, err := fakeAPI.configurationSubscribe.Subscribe(context.Background(), subscriptionIDStore{id: "failingUnsubscribeKey"}, "failConfig", &configuration.SubscribeRequest{}, nil)
+		require.NoError(t, err)
 
-		_, err := client.UnsubscribeConfigurationAlpha1(context.Background(), &runtimev1pb.UnsubscribeConfigurationRequest{
+		_, err = client.UnsubscribeConfigurationAlpha1(context.Background(), &runtimev1pb.UnsubscribeConfigurationRequest{
 			StoreName: "failConfig",
 			Id:        "failingUnsubscribeKey",
 		})
@@ -2842,9 +3103,10 @@ func TestConfigurationAPIWithResiliency(t *testing.T) {
 	})
 
 	t.Run("test unsubscribe configuration fails due to timeout with resiliency", func(t *testing.T) {
-		fakeAPI.configurationSubscribe["timeoutUnsubscribeKey"] = make(chan struct{})
+		_, _, err := fakeAPI.configurationSubscribe.Subscribe(context.Background(), subscriptionIDStore{id: "timeoutUnsubscribeKey"}, "failConfig", &configuration.SubscribeRequest{}, nil)
+		require.NoError(t, err)
 
-		_, err := client.UnsubscribeConfigurationAlpha1(context.Background(), &runtimev1pb.UnsubscribeConfigurationRequest{
+		_, err = client.UnsubscribeConfigurationAlpha1(context.Background(), &runtimev1pb.UnsubscribeConfigurationRequest{
 			StoreName: "failConfig",
 			Id:        "timeoutUnsubscribeKey",
 		})
@@ -2854,6 +3116,16 @@ func TestConfigurationAPIWithResiliency(t *testing.T) {
 	})
 }
 
+// subscriptionIDStore is a configuration store whose subscriptions have the given ID.
+type subscriptionIDStore struct {
+	configuration.Store
+	id string
+}
+
+func (s subscriptionIDStore) Subscribe(ctx context.Context, req *configuration.SubscribeRequest, handler configuration.UpdateHandler) (string, error) {
+	return s.id, nil
+}
+
 func TestSecretAPIWithResiliency(t *testing.T) {
 	failingStore := daprt.FailingSecretStore{
 		Failure: daprt.Failure{
@@ -2938,7 +3210,8 @@ func TestServiceInvocationWithResiliency(t *testing.T) {
 				"circuitBreakerKey": 10,
 			},
 			Timeouts: map[string]time.Duration{
-				"timeoutKey": time.Second * 10,
+				"timeoutKey":  time.Second * 10,
+				"bulkheadKey": time.Second,
 			},
 			CallCount: map[string]int{},
 		},
@@ -3029,6 +3302,24 @@ func TestServiceInvocationWithResiliency(t *testing.T) {
 		assert.Contains(t, err.Error(), "circuit breaker is open")
 		assert.Equal(t, 5, failingDirectMessaging.Failure.CallCount["circuitBreakerKey"])
 	})
+
+	t.Run("Test invoke direct messages rejected by a full bulkhead", func(t *testing.T) {
+		req := &runtimev1pb.InvokeServiceRequest{
+			Id: "bulkheadApp",
+			Message: &commonv1pb.InvokeRequest{
+				Method: "test",
+				Data:   &anypb.Any{Value: []byte("bulkheadKey")},
+			},
+		}
+
+		// The call times out, but keeps its slot in the bulkhead until it returns.
+		_, err := client.InvokeService(context.Background(), req)
+		assert.Error(t, err)
+
+		_, err = client.InvokeService(context.Background(), req)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Equal(t, 1, failingDirectMessaging.Failure.CallCount["bulkheadKey"])
+	})
 }
 
 type mockConfigStore struct{}
@@ -3147,7 +3438,7 @@ func TestUnlockResponseToGrpcResponse(t *testing.T) {
 
 func TestTryLock(t *testing.T) {
 	t.Run("error when lock store not configured", func(t *testing.T) {
-		api := NewAPI("", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil)
+		api := NewAPI("", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil, nil)
 		req := &runtimev1pb.TryLockRequest{
 			StoreName: "abc",
 		}
@@ -3160,7 +3451,7 @@ func TestTryLock(t *testing.T) {
 		defer ctl.Finish()
 
 		mockLockStore := daprt.NewMockStore(ctl)
-		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil)
+		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil, nil)
 		req := &runtimev1pb.TryLockRequest{
 			StoreName: "abc",
 		}
@@ -3173,7 +3464,7 @@ func TestTryLock(t *testing.T) {
 		defer ctl.Finish()
 
 		mockLockStore := daprt.NewMockStore(ctl)
-		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"abc": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil)
+		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"abc": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil, nil)
 		req := &runtimev1pb.TryLockRequest{
 			StoreName:  "abc",
 			ResourceId: "resource",
@@ -3187,7 +3478,7 @@ func TestTryLock(t *testing.T) {
 		defer ctl.Finish()
 
 		mockLockStore := daprt.NewMockStore(ctl)
-		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"abc": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil)
+		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"abc": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil, nil)
 
 		req := &runtimev1pb.TryLockRequest{
 			StoreName:  "abc",
@@ -3203,7 +3494,7 @@ func TestTryLock(t *testing.T) {
 		defer ctl.Finish()
 
 		mockLockStore := daprt.NewMockStore(ctl)
-		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil)
+		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil, nil)
 
 		req := &runtimev1pb.TryLockRequest{
 			StoreName:       "abc",
@@ -3229,7 +3520,7 @@ func TestTryLock(t *testing.T) {
 				Success: true,
 			}, nil
 		})
-		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil)
+		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil, nil)
 		req := &runtimev1pb.TryLockRequest{
 			StoreName:       "mock",
 			ResourceId:      "resource",
@@ -3244,7 +3535,7 @@ func TestTryLock(t *testing.T) {
 
 func TestUnlock(t *testing.T) {
 	t.Run("error when lock store not configured", func(t *testing.T) {
-		api := NewAPI("", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil)
+		api := NewAPI("", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil, nil)
 
 		req := &runtimev1pb.UnlockRequest{
 			StoreName: "abc",
@@ -3258,7 +3549,7 @@ func TestUnlock(t *testing.T) {
 		defer ctl.Finish()
 
 		mockLockStore := daprt.NewMockStore(ctl)
-		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil)
+		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil, nil)
 
 		req := &runtimev1pb.UnlockRequest{
 			StoreName: "abc",
@@ -3272,7 +3563,7 @@ func TestUnlock(t *testing.T) {
 		defer ctl.Finish()
 
 		mockLockStore := daprt.NewMockStore(ctl)
-		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil)
+		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil, nil)
 		req := &runtimev1pb.UnlockRequest{
 			StoreName:  "abc",
 			ResourceId: "resource",
@@ -3286,7 +3577,7 @@ func TestUnlock(t *testing.T) {
 		defer ctl.Finish()
 
 		mockLockStore := daprt.NewMockStore(ctl)
-		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil)
+		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil, nil)
 
 		req := &runtimev1pb.UnlockRequest{
 			StoreName:  "abc",
@@ -3310,7 +3601,7 @@ func TestUnlock(t *testing.T) {
 				Status: lock.Success,
 			}, nil
 		})
-		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil)
+		api := NewAPI("", nil, nil, nil, nil, nil, nil, map[string]lock.Store{"mock": mockLockStore}, nil, nil, nil, nil, config.TracingSpec{}, nil, "", nil, nil, nil)
 		req := &runtimev1pb.UnlockRequest{
 			StoreName:  "mock",
 			ResourceId: "resource",
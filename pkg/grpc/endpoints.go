@@ -38,6 +38,7 @@ var endpoints = map[string][]string{
 	},
 	"publish.v1": {
 		"/dapr.proto.runtime.v1.Dapr/PublishEvent",
+		"/dapr.proto.runtime.v1.Dapr/BulkPublishEvent",
 	},
 	"bindings.v1": {
 		"/dapr.proto.runtime.v1.Dapr/InvokeBinding",
@@ -52,6 +53,8 @@ var endpoints = map[string][]string{
 		"/dapr.proto.runtime.v1.Dapr/RegisterActorReminder",
 		"/dapr.proto.runtime.v1.Dapr/UnregisterActorReminder",
 		"/dapr.proto.runtime.v1.Dapr/RenameActorReminder",
+		"/dapr.proto.runtime.v1.Dapr/ListActorReminders",
+		"/dapr.proto.runtime.v1.Dapr/DeleteActorReminders",
 		"/dapr.proto.runtime.v1.Dapr/GetActorState",
 		"/dapr.proto.runtime.v1.Dapr/ExecuteActorStateTransaction",
 		"/dapr.proto.runtime.v1.Dapr/InvokeActor",
@@ -34,6 +34,7 @@ import (
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/dapr/dapr/pkg/messaging"
+	middleware "github.com/dapr/dapr/pkg/middleware/grpc"
 	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	auth "github.com/dapr/dapr/pkg/runtime/security"
@@ -71,6 +72,7 @@ type server struct {
 	authToken          string
 	apiSpec            config.APISpec
 	proxy              messaging.Proxy
+	pipeline           middleware.Pipeline
 }
 
 var (
@@ -80,7 +82,7 @@ var (
 )
 
 // NewAPIServer returns a new user facing gRPC API server.
-func NewAPIServer(api API, config ServerConfig, tracingSpec config.TracingSpec, metricSpec config.MetricSpec, apiSpec config.APISpec, proxy messaging.Proxy) Server {
+func NewAPIServer(api API, config ServerConfig, tracingSpec config.TracingSpec, metricSpec config.MetricSpec, apiSpec config.APISpec, proxy messaging.Proxy, pipeline middleware.Pipeline) Server {
 	apiServerInfoLogger.SetOutputLevel(logger.LogLevel("info"))
 	return &server{
 		api:         api,
@@ -93,6 +95,7 @@ func NewAPIServer(api API, config ServerConfig, tracingSpec config.TracingSpec,
 		authToken:   auth.GetAPIToken(),
 		apiSpec:     apiSpec,
 		proxy:       proxy,
+		pipeline:    pipeline,
 	}
 }
 
@@ -228,6 +231,11 @@ func (s *server) getMiddlewareOptions() []grpc_go.ServerOption {
 		intr = append(intr, s.getGRPCAPILoggingInfo())
 	}
 
+	// The middleware pipeline runs after authentication, right before the API handlers, as in the HTTP server.
+	pipelineStream := s.pipeline.StreamServerInterceptors()
+	intr = append(intr, s.pipeline.UnaryServerInterceptors()...)
+	intrStream = append(intrStream, pipelineStream...)
+
 	chain := grpc_middleware.ChainUnaryServer(
 		intr...,
 	)
@@ -236,7 +244,7 @@ func (s *server) getMiddlewareOptions() []grpc_go.ServerOption {
 		grpc_go.UnaryInterceptor(chain),
 	)
 
-	if s.proxy != nil {
+	if s.proxy != nil || len(pipelineStream) > 0 {
 		chainStream := grpc_middleware.ChainStreamServer(
 			intrStream...,
 		)
@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"testing"
@@ -9,10 +10,18 @@ import (
 	"github.com/phayes/freeport"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	grpc_go "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	grpc_metadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/dapr/kit/logger"
 
 	"github.com/dapr/dapr/pkg/config"
+	middleware "github.com/dapr/dapr/pkg/middleware/grpc"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	dapr_testing "github.com/dapr/dapr/pkg/testing"
 )
 
@@ -88,6 +97,80 @@ func TestGetMiddlewareOptions(t *testing.T) {
 
 		assert.Equal(t, 1, len(serverOption))
 	})
+
+	t.Run("should enable stream interceptor if the pipeline has stream middleware", func(t *testing.T) {
+		fakeServer := &server{
+			config:     ServerConfig{},
+			renewMutex: &sync.Mutex{},
+			logger:     logger.NewLogger("dapr.runtime.grpc.test"),
+			pipeline: middleware.Pipeline{
+				Handlers: []middleware.Middleware{
+					{
+						Stream: func(srv interface{}, ss grpc_go.ServerStream, info *grpc_go.StreamServerInfo, handler grpc_go.StreamHandler) error {
+							return handler(srv, ss)
+						},
+					},
+				},
+			},
+		}
+
+		serverOption := fakeServer.getMiddlewareOptions()
+
+		assert.Equal(t, 2, len(serverOption))
+	})
+}
+
+func TestGRPCPipeline(t *testing.T) {
+	port, err := freeport.GetFreePort()
+	require.NoError(t, err)
+	serverConfig := NewServerConfig("test", "127.0.0.1", port, []string{"127.0.0.1"}, "test", "test", 4, "", 4, false)
+	a := &api{id: "test"}
+
+	var calls []string
+	pipeline := middleware.Pipeline{
+		Handlers: []middleware.Middleware{
+			{
+				Unary: func(ctx context.Context, req interface{}, info *grpc_go.UnaryServerInfo, handler grpc_go.UnaryHandler) (interface{}, error) {
+					calls = append(calls, "first")
+					md, _ := grpc_metadata.FromIncomingContext(ctx)
+					if len(md.Get("authorization")) == 0 {
+						return nil, status.Error(codes.Unauthenticated, "missing authorization")
+					}
+					return handler(ctx, req)
+				},
+			},
+			{
+				Unary: func(ctx context.Context, req interface{}, info *grpc_go.UnaryServerInfo, handler grpc_go.UnaryHandler) (interface{}, error) {
+					calls = append(calls, "second")
+					return handler(ctx, req)
+				},
+			},
+		},
+	}
+	server := NewAPIServer(a, serverConfig, config.TracingSpec{}, config.MetricSpec{}, config.APISpec{}, nil, pipeline)
+	require.NoError(t, server.StartNonBlocking())
+	defer server.Close()
+	dapr_testing.WaitForListeningAddress(t, 5*time.Second, fmt.Sprintf("127.0.0.1:%d", port))
+
+	conn, err := grpc_go.Dial(fmt.Sprintf("127.0.0.1:%d", port), grpc_go.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := runtimev1pb.NewDaprClient(conn)
+
+	t.Run("middleware rejects the request", func(t *testing.T) {
+		calls = nil
+		_, err := client.GetMetadata(context.Background(), &emptypb.Empty{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Equal(t, []string{"first"}, calls)
+	})
+
+	t.Run("middleware runs in order", func(t *testing.T) {
+		calls = nil
+		ctx := grpc_metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer token")
+		_, err := client.GetMetadata(ctx, &emptypb.Empty{})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"first", "second"}, calls)
+	})
 }
 
 func TestClose(t *testing.T) {
@@ -96,7 +179,7 @@ func TestClose(t *testing.T) {
 		require.NoError(t, err)
 		serverConfig := NewServerConfig("test", "127.0.0.1", port, []string{"127.0.0.1"}, "test", "test", 4, "", 4, true)
 		a := &api{}
-		server := NewAPIServer(a, serverConfig, config.TracingSpec{}, config.MetricSpec{}, config.APISpec{}, nil)
+		server := NewAPIServer(a, serverConfig, config.TracingSpec{}, config.MetricSpec{}, config.APISpec{}, nil, middleware.Pipeline{})
 		require.NoError(t, server.StartNonBlocking())
 		dapr_testing.WaitForListeningAddress(t, 5*time.Second, fmt.Sprintf("127.0.0.1:%d", port))
 		assert.NoError(t, server.Close())
@@ -107,7 +190,7 @@ func TestClose(t *testing.T) {
 		require.NoError(t, err)
 		serverConfig := NewServerConfig("test", "127.0.0.1", port, []string{"127.0.0.1"}, "test", "test", 4, "", 4, false)
 		a := &api{}
-		server := NewAPIServer(a, serverConfig, config.TracingSpec{}, config.MetricSpec{}, config.APISpec{}, nil)
+		server := NewAPIServer(a, serverConfig, config.TracingSpec{}, config.MetricSpec{}, config.APISpec{}, nil, middleware.Pipeline{})
 		require.NoError(t, server.StartNonBlocking())
 		dapr_testing.WaitForListeningAddress(t, 5*time.Second, fmt.Sprintf("127.0.0.1:%d", port))
 		assert.NoError(t, server.Close())
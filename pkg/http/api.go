@@ -18,11 +18,14 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	nethttp "net/http"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/fasthttp/router"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
@@ -32,14 +35,20 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/components-contrib/configuration"
+	contrib_contenttype "github.com/dapr/components-contrib/contenttype"
+	"github.com/dapr/components-contrib/lock"
 	contrib_metadata "github.com/dapr/components-contrib/metadata"
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/dapr/pkg/actors"
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	"github.com/dapr/dapr/pkg/apphealth"
 	"github.com/dapr/dapr/pkg/channel"
 	"github.com/dapr/dapr/pkg/channel/http"
+	configuration_loader "github.com/dapr/dapr/pkg/components/configuration"
+	lock_loader "github.com/dapr/dapr/pkg/components/lock"
 	state_loader "github.com/dapr/dapr/pkg/components/state"
 	"github.com/dapr/dapr/pkg/concurrency"
 	"github.com/dapr/dapr/pkg/config"
@@ -49,6 +58,7 @@ import (
 	"github.com/dapr/dapr/pkg/messages"
 	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/outbox"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
@@ -63,41 +73,121 @@ type API interface {
 	SetAppChannel(appChannel channel.AppChannel)
 	SetDirectMessaging(directMessaging messaging.DirectMessaging)
 	SetActorRuntime(actor actors.Actors)
+	SetAppHealth(appHealth *apphealth.AppHealth)
+	SetAppConnectionConfig(appConnectionConfig channel.AppConnectionConfig)
+	SetEnabledFeatures(enabledFeatures []string)
+	SetSubscriptionsFn(getSubscriptionsFn func() []runtime_pubsub.Subscription)
+	SetComponentsCapabilitiesFn(getCapabilitiesFn func() map[string][]string)
+	SetReEncryptionJobs(reEncryptionJobs *encryption.ReEncryptionJobs)
 }
 
 type api struct {
-	endpoints                []Endpoint
-	publicEndpoints          []Endpoint
-	directMessaging          messaging.DirectMessaging
-	appChannel               channel.AppChannel
-	getComponentsFn          func() []components_v1alpha1.Component
-	resiliency               resiliency.Provider
-	stateStores              map[string]state.Store
-	transactionalStateStores map[string]state.TransactionalStore
-	secretStores             map[string]secretstores.SecretStore
-	secretsConfiguration     map[string]config.SecretsScope
-	actor                    actors.Actors
-	pubsubAdapter            runtime_pubsub.Adapter
-	sendToOutputBindingFn    func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
-	id                       string
-	extendedMetadata         sync.Map
-	readyStatus              bool
-	outboundReadyStatus      bool
-	tracingSpec              config.TracingSpec
-	shutdown                 func()
+	endpoints              []Endpoint
+	publicEndpoints        []Endpoint
+	directMessaging        messaging.DirectMessaging
+	appChannel             channel.AppChannel
+	getComponentsFn        func() []components_v1alpha1.Component
+	componentsLock         *sync.RWMutex // guards the component stores, updated when components are hot reloaded
+	resiliency             resiliency.Provider
+	stateStores            map[string]state.Store
+	secretStores           map[string]secretstores.SecretStore
+	secretsConfiguration   map[string]config.SecretsScope
+	configurationStores    map[string]configuration.Store
+	configurationSubscribe *configuration_loader.Subscriptions
+	lockStores             map[string]lock.Store
+	actor                  actors.Actors
+	appHealth              *apphealth.AppHealth
+	appConnectionConfig    channel.AppConnectionConfig
+	enabledFeatures        []string
+	getSubscriptionsFn     func() []runtime_pubsub.Subscription
+	getCapabilitiesFn      func() map[string][]string
+	reEncryptionJobs       *encryption.ReEncryptionJobs
+	pubsubAdapter          runtime_pubsub.Adapter
+	sendToOutputBindingFn  func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error)
+	id                     string
+	extendedMetadata       sync.Map
+	readyStatus            bool
+	outboundReadyStatus    bool
+	tracingSpec            config.TracingSpec
+	shutdown               func()
 }
 
 type registeredComponent struct {
-	Name    string `json:"name"`
-	Type    string `json:"type"`
-	Version string `json:"version"`
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	Version      string   `json:"version"`
+	Capabilities []string `json:"capabilities,omitempty"`
 }
 
 type metadata struct {
-	ID                   string                     `json:"id"`
-	ActiveActorsCount    []actors.ActiveActorsCount `json:"actors"`
-	Extended             map[string]string          `json:"extended"`
-	RegisteredComponents []registeredComponent      `json:"components"`
+	ID                   string                          `json:"id"`
+	ActiveActorsCount    []actors.ActiveActorsCount      `json:"actors"`
+	Extended             map[string]string               `json:"extended"`
+	RegisteredComponents []registeredComponent           `json:"components"`
+	AppHealth            *appHealthMetadata              `json:"appHealth,omitempty"`
+	Resiliency           *resiliency.Status              `json:"resiliency,omitempty"`
+	Subscriptions        []subscriptionMetadata          `json:"subscriptions,omitempty"`
+	AppConnection        *appConnectionMetadata          `json:"appConnection,omitempty"`
+	Placement            *actors.PlacementStatus         `json:"placement,omitempty"`
+	EnabledFeatures      []string                        `json:"enabledFeatures,omitempty"`
+	StateReEncryption    []encryption.ReEncryptionStatus `json:"stateReEncryption,omitempty"`
+}
+
+type subscriptionMetadata struct {
+	PubsubName      string                     `json:"pubsubname"`
+	Topic           string                     `json:"topic"`
+	DeadLetterTopic string                     `json:"deadLetterTopic,omitempty"`
+	Metadata        map[string]string          `json:"metadata,omitempty"`
+	Rules           []subscriptionRuleMetadata `json:"rules,omitempty"`
+}
+
+type subscriptionRuleMetadata struct {
+	Match string `json:"match,omitempty"`
+	Path  string `json:"path"`
+}
+
+type appConnectionMetadata struct {
+	Protocol       string `json:"protocol"`
+	Port           int    `json:"port"`
+	MaxConcurrency int    `json:"maxConcurrency"`
+}
+
+type appHealthMetadata struct {
+	Status              string `json:"status"`
+	HealthCheckPath     string `json:"healthCheckPath,omitempty"`
+	HealthProbeInterval string `json:"healthProbeInterval"`
+	HealthProbeTimeout  string `json:"healthProbeTimeout"`
+	HealthThreshold     int32  `json:"healthThreshold"`
+}
+
+type bulkPublishMessageEntry struct {
+	EntryID     string            `json:"entryId"`
+	Event       json.RawMessage   `json:"event"`
+	ContentType string            `json:"contentType"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+type stateTransactionRequest struct {
+	Operations   []state.TransactionalStateOperation `json:"operations"`
+	Metadata     map[string]string                   `json:"metadata,omitempty"`
+	OutboxEvents []outboxEventEntry                  `json:"outboxEvents,omitempty"`
+}
+
+type outboxEventEntry struct {
+	ID          string            `json:"id,omitempty"`
+	Data        json.RawMessage   `json:"data"`
+	ContentType string            `json:"dataContentType,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+type bulkPublishResponseFailedEntry struct {
+	EntryID string `json:"entryId"`
+	Error   string `json:"error"`
+}
+
+type bulkPublishResponse struct {
+	FailedEntries []bulkPublishResponseFailedEntry `json:"failedEntries"`
+	ErrorCode     string                           `json:"errorCode"`
 }
 
 const (
@@ -109,12 +199,17 @@ const (
 	actorTypeParam       = "actorType"
 	actorIDParam         = "actorId"
 	storeNameParam       = "storeName"
+	configKeyParam       = "key"
+	subscribeIDParam     = "configurationSubscribeId"
+	routeParam           = "route"
 	stateKeyParam        = "key"
 	secretStoreNameParam = "secretStoreName"
 	secretNameParam      = "key"
 	nameParam            = "name"
 	consistencyParam     = "consistency"
 	concurrencyParam     = "concurrency"
+	pageSizeParam        = "pageSize"
+	pageTokenParam       = "continuationToken"
 	pubsubnameparam      = "pubsubname"
 	traceparentHeader    = "traceparent"
 	tracestateHeader     = "tracestate"
@@ -127,37 +222,37 @@ func NewAPI(
 	appChannel channel.AppChannel,
 	directMessaging messaging.DirectMessaging,
 	getComponentsFn func() []components_v1alpha1.Component,
+	componentsLock *sync.RWMutex,
 	resiliency resiliency.Provider,
 	stateStores map[string]state.Store,
 	secretStores map[string]secretstores.SecretStore,
 	secretsConfiguration map[string]config.SecretsScope,
+	configurationStores map[string]configuration.Store,
+	lockStores map[string]lock.Store,
 	pubsubAdapter runtime_pubsub.Adapter,
 	actor actors.Actors,
 	sendToOutputBindingFn func(name string, req *bindings.InvokeRequest) (*bindings.InvokeResponse, error),
 	tracingSpec config.TracingSpec,
 	shutdown func(),
 ) API {
-	transactionalStateStores := map[string]state.TransactionalStore{}
-	for key, store := range stateStores {
-		if state.FeatureTransactional.IsPresent(store.Features()) {
-			transactionalStateStores[key] = store.(state.TransactionalStore)
-		}
-	}
 	api := &api{
-		appChannel:               appChannel,
-		getComponentsFn:          getComponentsFn,
-		resiliency:               resiliency,
-		directMessaging:          directMessaging,
-		stateStores:              stateStores,
-		transactionalStateStores: transactionalStateStores,
-		secretStores:             secretStores,
-		secretsConfiguration:     secretsConfiguration,
-		actor:                    actor,
-		pubsubAdapter:            pubsubAdapter,
-		sendToOutputBindingFn:    sendToOutputBindingFn,
-		id:                       appID,
-		tracingSpec:              tracingSpec,
-		shutdown:                 shutdown,
+		appChannel:             appChannel,
+		getComponentsFn:        getComponentsFn,
+		componentsLock:         componentsLock,
+		resiliency:             resiliency,
+		directMessaging:        directMessaging,
+		stateStores:            stateStores,
+		secretStores:           secretStores,
+		secretsConfiguration:   secretsConfiguration,
+		configurationStores:    configurationStores,
+		configurationSubscribe: configuration_loader.NewSubscriptions(),
+		lockStores:             lockStores,
+		actor:                  actor,
+		pubsubAdapter:          pubsubAdapter,
+		sendToOutputBindingFn:  sendToOutputBindingFn,
+		id:                     appID,
+		tracingSpec:            tracingSpec,
+		shutdown:               shutdown,
 	}
 
 	metadataEndpoints := api.constructMetadataEndpoints()
@@ -171,6 +266,8 @@ func NewAPI(
 	api.endpoints = append(api.endpoints, metadataEndpoints...)
 	api.endpoints = append(api.endpoints, api.constructShutdownEndpoints()...)
 	api.endpoints = append(api.endpoints, api.constructBindingsEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructDistributedLockEndpoints()...)
+	api.endpoints = append(api.endpoints, api.constructConfigurationEndpoints()...)
 	api.endpoints = append(api.endpoints, healthEndpoints...)
 
 	api.publicEndpoints = append(api.publicEndpoints, metadataEndpoints...)
@@ -237,6 +334,12 @@ func (a *api) constructStateEndpoints() []Endpoint {
 			Version: apiVersionV1alpha1,
 			Handler: a.onQueryState,
 		},
+		{
+			Methods: []string{fasthttp.MethodPost},
+			Route:   "state/{storeName}/reencrypt",
+			Version: apiVersionV1alpha1,
+			Handler: a.onReEncryptState,
+		},
 	}
 }
 
@@ -265,6 +368,12 @@ func (a *api) constructPubSubEndpoints() []Endpoint {
 			Version: apiVersionV1,
 			Handler: a.onPublish,
 		},
+		{
+			Methods: []string{fasthttp.MethodPost, fasthttp.MethodPut},
+			Route:   "publish/bulk/{pubsubname}/{topic:*}",
+			Version: apiVersionV1alpha1,
+			Handler: a.onBulkPublish,
+		},
 	}
 }
 
@@ -279,6 +388,46 @@ func (a *api) constructBindingsEndpoints() []Endpoint {
 	}
 }
 
+func (a *api) constructDistributedLockEndpoints() []Endpoint {
+	return []Endpoint{
+		{
+			Methods: []string{fasthttp.MethodPost},
+			Route:   "lock/{storeName}",
+			Version: apiVersionV1alpha1,
+			Handler: a.onTryLock,
+		},
+		{
+			Methods: []string{fasthttp.MethodPost},
+			Route:   "unlock/{storeName}",
+			Version: apiVersionV1alpha1,
+			Handler: a.onUnlock,
+		},
+	}
+}
+
+func (a *api) constructConfigurationEndpoints() []Endpoint {
+	return []Endpoint{
+		{
+			Methods: []string{fasthttp.MethodGet},
+			Route:   "configuration/{storeName}",
+			Version: apiVersionV1alpha1,
+			Handler: a.onGetConfiguration,
+		},
+		{
+			Methods: []string{fasthttp.MethodGet, fasthttp.MethodPost},
+			Route:   "configuration/{storeName}/subscribe",
+			Version: apiVersionV1alpha1,
+			Handler: a.onSubscribeConfiguration,
+		},
+		{
+			Methods: []string{fasthttp.MethodGet, fasthttp.MethodPost},
+			Route:   "configuration/{storeName}/{configurationSubscribeId}/unsubscribe",
+			Version: apiVersionV1alpha1,
+			Handler: a.onUnsubscribeConfiguration,
+		},
+	}
+}
+
 func (a *api) constructDirectMessagingEndpoints() []Endpoint {
 	return []Endpoint{
 		{
@@ -348,6 +497,24 @@ func (a *api) constructActorEndpoints() []Endpoint {
 			Version: apiVersionV1,
 			Handler: a.onRenameActorReminder,
 		},
+		{
+			Methods: []string{fasthttp.MethodGet},
+			Route:   "actors/{actorType}/reminders",
+			Version: apiVersionV1,
+			Handler: a.onListActorReminders,
+		},
+		{
+			Methods: []string{fasthttp.MethodGet},
+			Route:   "actors/{actorType}/{actorId}/reminders",
+			Version: apiVersionV1,
+			Handler: a.onListActorReminders,
+		},
+		{
+			Methods: []string{fasthttp.MethodDelete},
+			Route:   "actors/{actorType}/{actorId}/reminders",
+			Version: apiVersionV1,
+			Handler: a.onDeleteActorReminders,
+		},
 	}
 }
 
@@ -595,7 +762,19 @@ func (a *api) onBulkGetState(reqCtx *fasthttp.RequestCtx) {
 	respond(reqCtx, withJSON(fasthttp.StatusOK, b))
 }
 
+// rlockComponents locks the component stores for reading, and returns the function unlocking them.
+func (a *api) rlockComponents() func() {
+	if a.componentsLock == nil {
+		return func() {}
+	}
+	a.componentsLock.RLock()
+	return a.componentsLock.RUnlock
+}
+
 func (a *api) getStateStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx) (state.Store, string, error) {
+	unlock := a.rlockComponents()
+	defer unlock()
+
 	if a.stateStores == nil || len(a.stateStores) == 0 {
 		msg := NewErrorResponse("ERR_STATE_STORES_NOT_CONFIGURED", messages.ErrStateStoresNotConfigured)
 		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
@@ -614,6 +793,15 @@ func (a *api) getStateStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx) (s
 	return a.stateStores[storeName], storeName, nil
 }
 
+// getModifiedStateKeyToWrite returns the key of a state item saved or deleted by the app.
+// The keys reserved for the outbox records of the state store are rejected.
+func (a *api) getModifiedStateKeyToWrite(key, storeName string) (string, error) {
+	if err := outbox.CheckStateKey(storeName, key); err != nil {
+		return "", err
+	}
+	return state_loader.GetModifiedStateKey(key, storeName, a.id)
+}
+
 func (a *api) onGetState(reqCtx *fasthttp.RequestCtx) {
 	store, storeName, err := a.getStateStoreWithRequestValidation(reqCtx)
 	if err != nil {
@@ -704,7 +892,7 @@ func (a *api) onDeleteState(reqCtx *fasthttp.RequestCtx) {
 	consistency := string(reqCtx.QueryArgs().Peek(consistencyParam))
 
 	metadata := getMetadataFromRequest(reqCtx)
-	k, err := state_loader.GetModifiedStateKey(key, storeName, a.id)
+	k, err := a.getModifiedStateKeyToWrite(key, storeName)
 	if err != nil {
 		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
 		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
@@ -745,164 +933,507 @@ func (a *api) onDeleteState(reqCtx *fasthttp.RequestCtx) {
 	respond(reqCtx, withEmpty())
 }
 
-func (a *api) onGetSecret(reqCtx *fasthttp.RequestCtx) {
-	store, secretStoreName, err := a.getSecretStoreWithRequestValidation(reqCtx)
-	if err != nil {
-		log.Debug(err)
-		return
+func (a *api) getLockStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx) (lock.Store, string, error) {
+	unlock := a.rlockComponents()
+	defer unlock()
+
+	if a.lockStores == nil || len(a.lockStores) == 0 {
+		msg := NewErrorResponse("ERR_LOCK_STORE_NOT_CONFIGURED", messages.ErrLockStoresNotConfigured)
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		return nil, "", errors.New(msg.Message)
 	}
 
-	metadata := getMetadataFromRequest(reqCtx)
+	storeName := reqCtx.UserValue(storeNameParam).(string)
 
-	key := reqCtx.UserValue(secretNameParam).(string)
+	if a.lockStores[storeName] == nil {
+		msg := NewErrorResponse("ERR_LOCK_STORE_NOT_FOUND", fmt.Sprintf(messages.ErrLockStoreNotFound, storeName))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		return nil, "", errors.New(msg.Message)
+	}
+	return a.lockStores[storeName], storeName, nil
+}
 
-	if !a.isSecretAllowed(secretStoreName, key) {
-		msg := NewErrorResponse("ERR_PERMISSION_DENIED", fmt.Sprintf(messages.ErrPermissionDenied, key, secretStoreName))
-		respond(reqCtx, withError(fasthttp.StatusForbidden, msg))
+func (a *api) onTryLock(reqCtx *fasthttp.RequestCtx) {
+	store, storeName, err := a.getLockStoreWithRequestValidation(reqCtx)
+	if err != nil {
+		log.Debug(err)
 		return
 	}
 
-	req := secretstores.GetSecretRequest{
-		Name:     key,
-		Metadata: metadata,
+	var req TryLockRequest
+	err = json.Unmarshal(reqCtx.PostBody(), &req)
+	if err != nil {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf(messages.ErrMalformedRequest, err))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
 	}
 
-	start := time.Now()
-	policy := a.resiliency.ComponentOutboundPolicy(reqCtx, secretStoreName)
-	var resp secretstores.GetSecretResponse
-	err = policy(func(ctx context.Context) (rErr error) {
-		resp, rErr = store.GetSecret(req)
-		return rErr
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.SecretInvoked(context.Background(), secretStoreName, diag.Get, err == nil, elapsed)
+	if req.ResourceID == "" {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf(messages.ErrResourceIDEmpty, storeName))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
+	}
+	if req.LockOwner == "" {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf(messages.ErrLockOwnerEmpty, storeName))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
+	}
+	if req.ExpiryInSeconds <= 0 {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf(messages.ErrExpiryInSecondsNotPositive, storeName))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
+	}
 
+	resourceID, err := lock_loader.GetModifiedLockKey(req.ResourceID, storeName, a.id)
 	if err != nil {
-		msg := NewErrorResponse("ERR_SECRET_GET",
-			fmt.Sprintf(messages.ErrSecretGet, req.Name, secretStoreName, err.Error()))
-		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
 		log.Debug(msg)
 		return
 	}
 
-	if resp.Data == nil {
-		respond(reqCtx, withEmpty())
+	resp, err := store.TryLock(&lock.TryLockRequest{
+		ResourceID:      resourceID,
+		LockOwner:       req.LockOwner,
+		ExpiryInSeconds: req.ExpiryInSeconds,
+	})
+	if err != nil {
+		msg := NewErrorResponse("ERR_TRY_LOCK", fmt.Sprintf(messages.ErrTryLockFailed, storeName, err))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
 		return
 	}
 
-	respBytes, _ := json.Marshal(resp.Data)
-	respond(reqCtx, withJSON(fasthttp.StatusOK, respBytes))
+	b, _ := json.Marshal(TryLockResponse{Success: resp != nil && resp.Success})
+	respond(reqCtx, withJSON(fasthttp.StatusOK, b))
 }
 
-func (a *api) onBulkGetSecret(reqCtx *fasthttp.RequestCtx) {
-	store, secretStoreName, err := a.getSecretStoreWithRequestValidation(reqCtx)
+func (a *api) onUnlock(reqCtx *fasthttp.RequestCtx) {
+	store, storeName, err := a.getLockStoreWithRequestValidation(reqCtx)
 	if err != nil {
 		log.Debug(err)
 		return
 	}
 
-	metadata := getMetadataFromRequest(reqCtx)
-
-	req := secretstores.BulkGetSecretRequest{
-		Metadata: metadata,
+	var req UnlockRequest
+	err = json.Unmarshal(reqCtx.PostBody(), &req)
+	if err != nil {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf(messages.ErrMalformedRequest, err))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
 	}
 
-	start := time.Now()
-	policy := a.resiliency.ComponentOutboundPolicy(reqCtx, secretStoreName)
-	var resp secretstores.BulkGetSecretResponse
-	err = policy(func(ctx context.Context) (rErr error) {
-		resp, rErr = store.BulkGetSecret(req)
-		return rErr
-	})
-	elapsed := diag.ElapsedSince(start)
-
-	diag.DefaultComponentMonitoring.SecretInvoked(context.Background(), secretStoreName, diag.BulkGet, err == nil, elapsed)
+	if req.ResourceID == "" {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf(messages.ErrResourceIDEmpty, storeName))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
+	}
+	if req.LockOwner == "" {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf(messages.ErrLockOwnerEmpty, storeName))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
+	}
 
+	resourceID, err := lock_loader.GetModifiedLockKey(req.ResourceID, storeName, a.id)
 	if err != nil {
-		msg := NewErrorResponse("ERR_SECRET_GET",
-			fmt.Sprintf(messages.ErrBulkSecretGet, secretStoreName, err.Error()))
-		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
 		log.Debug(msg)
 		return
 	}
 
-	if resp.Data == nil {
-		respond(reqCtx, withEmpty())
+	resp, err := store.Unlock(&lock.UnlockRequest{
+		ResourceID: resourceID,
+		LockOwner:  req.LockOwner,
+	})
+	if err != nil {
+		msg := NewErrorResponse("ERR_UNLOCK", fmt.Sprintf(messages.ErrUnlockFailed, storeName, err))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
 		return
 	}
 
-	filteredSecrets := map[string]map[string]string{}
-	for key, v := range resp.Data {
-		if a.isSecretAllowed(secretStoreName, key) {
-			filteredSecrets[key] = v
-		} else {
-			log.Debugf(messages.ErrPermissionDenied, key, secretStoreName)
-		}
+	unlockResp := UnlockResponse{}
+	if resp != nil {
+		unlockResp.Status = int32(resp.Status)
 	}
-
-	respBytes, _ := json.Marshal(filteredSecrets)
-	respond(reqCtx, withJSON(fasthttp.StatusOK, respBytes))
+	b, _ := json.Marshal(unlockResp)
+	respond(reqCtx, withJSON(fasthttp.StatusOK, b))
 }
 
-func (a *api) getSecretStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx) (secretstores.SecretStore, string, error) {
-	if a.secretStores == nil || len(a.secretStores) == 0 {
-		msg := NewErrorResponse("ERR_SECRET_STORES_NOT_CONFIGURED", messages.ErrSecretStoreNotConfigured)
+func (a *api) getConfigurationStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx) (configuration.Store, string, error) {
+	unlock := a.rlockComponents()
+	defer unlock()
+
+	if a.configurationStores == nil || len(a.configurationStores) == 0 {
+		msg := NewErrorResponse("ERR_CONFIGURATION_STORE_NOT_CONFIGURED", messages.ErrConfigurationStoresNotConfigured)
 		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
 		return nil, "", errors.New(msg.Message)
 	}
 
-	secretStoreName := reqCtx.UserValue(secretStoreNameParam).(string)
+	storeName := reqCtx.UserValue(storeNameParam).(string)
 
-	if a.secretStores[secretStoreName] == nil {
-		msg := NewErrorResponse("ERR_SECRET_STORE_NOT_FOUND", fmt.Sprintf(messages.ErrSecretStoreNotFound, secretStoreName))
-		respond(reqCtx, withError(fasthttp.StatusUnauthorized, msg))
+	if a.configurationStores[storeName] == nil {
+		msg := NewErrorResponse("ERR_CONFIGURATION_STORE_NOT_FOUND", fmt.Sprintf(messages.ErrConfigurationStoreNotFound, storeName))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
 		return nil, "", errors.New(msg.Message)
 	}
-	return a.secretStores[secretStoreName], secretStoreName, nil
+	return a.configurationStores[storeName], storeName, nil
 }
 
-func (a *api) onPostState(reqCtx *fasthttp.RequestCtx) {
-	store, storeName, err := a.getStateStoreWithRequestValidation(reqCtx)
+// getConfigurationKeysFromRequest returns the keys passed as repeated `key` query parameters.
+func getConfigurationKeysFromRequest(reqCtx *fasthttp.RequestCtx) []string {
+	peekedKeys := reqCtx.QueryArgs().PeekMulti(configKeyParam)
+	keys := make([]string, 0, len(peekedKeys))
+	for _, k := range peekedKeys {
+		keys = append(keys, string(k))
+	}
+
+	return keys
+}
+
+func (a *api) onGetConfiguration(reqCtx *fasthttp.RequestCtx) {
+	store, storeName, err := a.getConfigurationStoreWithRequestValidation(reqCtx)
 	if err != nil {
 		log.Debug(err)
 		return
 	}
 
-	reqs := []state.SetRequest{}
-	err = json.Unmarshal(reqCtx.PostBody(), &reqs)
+	req := configuration.GetRequest{
+		Keys:     getConfigurationKeysFromRequest(reqCtx),
+		Metadata: getMetadataFromRequest(reqCtx),
+	}
+
+	start := time.Now()
+	policy := a.resiliency.ComponentOutboundPolicy(reqCtx, storeName)
+	var getResponse *configuration.GetResponse
+	err = policy(func(ctx context.Context) (rErr error) {
+		getResponse, rErr = store.Get(ctx, &req)
+		return rErr
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.ConfigurationInvoked(context.Background(), storeName, diag.Get, err == nil, elapsed)
+
 	if err != nil {
-		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
-		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		msg := NewErrorResponse("ERR_CONFIGURATION_GET", fmt.Sprintf(messages.ErrConfigurationGet, req.Keys, storeName, err.Error()))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
 		log.Debug(msg)
 		return
 	}
-	if len(reqs) == 0 {
-		respond(reqCtx, withEmpty())
+
+	items := []*configuration.Item{}
+	if getResponse != nil && getResponse.Items != nil {
+		items = getResponse.Items
+	}
+
+	b, _ := json.Marshal(items)
+	respond(reqCtx, withJSON(fasthttp.StatusOK, b))
+}
+
+func (a *api) onSubscribeConfiguration(reqCtx *fasthttp.RequestCtx) {
+	store, storeName, err := a.getConfigurationStoreWithRequestValidation(reqCtx)
+	if err != nil {
+		log.Debug(err)
 		return
 	}
 
-	metadata := getMetadataFromRequest(reqCtx)
+	if a.appChannel == nil {
+		msg := NewErrorResponse("ERR_APP_CHANNEL_NIL", messages.ErrChannelNotFound)
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
 
-	for i, r := range reqs {
-		// merge metadata from URL query parameters
-		if reqs[i].Metadata == nil {
-			reqs[i].Metadata = metadata
-		} else {
-			for k, v := range metadata {
-				reqs[i].Metadata[k] = v
-			}
-		}
+	// The app declares the route updates are delivered to; by default it is /configuration/{storeName}.
+	route := string(reqCtx.QueryArgs().Peek(routeParam))
+	if route == "" {
+		route = fmt.Sprintf("configuration/%s", storeName)
+	}
+	route = strings.TrimPrefix(route, "/")
 
-		reqs[i].Key, err = state_loader.GetModifiedStateKey(r.Key, storeName, a.id)
-		if err != nil {
-			msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
-			respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
-			log.Debug(err)
-			return
-		}
+	req := configuration.SubscribeRequest{
+		Keys:     getConfigurationKeysFromRequest(reqCtx),
+		Metadata: getMetadataFromRequest(reqCtx),
+	}
 
-		if encryption.EncryptedStateStore(storeName) {
+	handler := &configurationEventHandler{
+		api:       a,
+		storeName: storeName,
+		route:     route,
+	}
+
+	start := time.Now()
+	policy := a.resiliency.ComponentOutboundPolicy(reqCtx, storeName)
+	var id string
+	err = policy(func(ctx context.Context) (rErr error) {
+		// The subscription outlives this request: it is stopped on unsubscribe.
+		id, _, rErr = a.configurationSubscribe.Subscribe(ctx, store, storeName, &req, handler.updateEventHandler)
+		return rErr
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.ConfigurationInvoked(context.Background(), storeName, diag.ConfigurationSubscribe, err == nil, elapsed)
+
+	if err != nil {
+		msg := NewErrorResponse("ERR_CONFIGURATION_SUBSCRIBE", fmt.Sprintf(messages.ErrConfigurationSubscribe, req.Keys, storeName, err.Error()))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	b, _ := json.Marshal(SubscribeConfigurationResponse{ID: id})
+	respond(reqCtx, withJSON(fasthttp.StatusOK, b))
+}
+
+func (a *api) onUnsubscribeConfiguration(reqCtx *fasthttp.RequestCtx) {
+	store, storeName, err := a.getConfigurationStoreWithRequestValidation(reqCtx)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+
+	subscribeID := reqCtx.UserValue(subscribeIDParam).(string)
+
+	found, err := a.configurationSubscribe.Unsubscribe(subscribeID, storeName, func() error {
+		start := time.Now()
+		policy := a.resiliency.ComponentOutboundPolicy(reqCtx, storeName)
+		err := policy(func(ctx context.Context) error {
+			return store.Unsubscribe(ctx, &configuration.UnsubscribeRequest{
+				ID: subscribeID,
+			})
+		})
+		elapsed := diag.ElapsedSince(start)
+
+		diag.DefaultComponentMonitoring.ConfigurationInvoked(context.Background(), storeName, diag.ConfigurationUnsubscribe, err == nil, elapsed)
+		return err
+	})
+	if !found {
+		msg := NewErrorResponse("ERR_CONFIGURATION_SUBSCRIPTION_NOT_FOUND", fmt.Sprintf(messages.ErrConfigurationSubscriptionNotFound, subscribeID, storeName))
+		respond(reqCtx, withError(fasthttp.StatusNotFound, msg))
+		log.Debug(msg)
+		return
+	}
+	if err != nil {
+		msg := NewErrorResponse("ERR_CONFIGURATION_UNSUBSCRIBE", fmt.Sprintf(messages.ErrConfigurationUnsubscribe, subscribeID, storeName, err.Error()))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	respond(reqCtx, withEmpty())
+}
+
+// configurationEventHandler delivers configuration update events of a subscription to the app over the HTTP app channel.
+type configurationEventHandler struct {
+	api       *api
+	storeName string
+	route     string
+}
+
+func (h *configurationEventHandler) updateEventHandler(ctx context.Context, e *configuration.UpdateEvent) error {
+	appChannel := h.api.appChannel
+	if appChannel == nil {
+		err := errors.New(messages.ErrChannelNotFound)
+		log.Debug(err)
+		return err
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		log.Debugf("error serializing configuration update event for store %s: %s", h.storeName, err)
+		return err
+	}
+
+	req := invokev1.NewInvokeMethodRequest(h.route)
+	req.WithHTTPExtension(nethttp.MethodPost, "")
+	req.WithRawData(b, invokev1.JSONContentType)
+
+	policy := h.api.resiliency.ComponentInboundPolicy(ctx, h.storeName)
+	err = policy(func(ctx context.Context) error {
+		resp, rErr := appChannel.InvokeMethod(ctx, req)
+		if rErr != nil {
+			return rErr
+		}
+		if code := resp.Status().Code; code < nethttp.StatusOK || code >= nethttp.StatusMultipleChoices {
+			return errors.Errorf("error sending configuration update event to app, status code: %d", code)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Debugf("failed to deliver configuration update event %s of store %s to route %s: %s", e.ID, h.storeName, h.route, err)
+	}
+	return err
+}
+
+func (a *api) onGetSecret(reqCtx *fasthttp.RequestCtx) {
+	store, secretStoreName, err := a.getSecretStoreWithRequestValidation(reqCtx)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+
+	metadata := getMetadataFromRequest(reqCtx)
+
+	key := reqCtx.UserValue(secretNameParam).(string)
+
+	if !a.isSecretAllowed(secretStoreName, key) {
+		msg := NewErrorResponse("ERR_PERMISSION_DENIED", fmt.Sprintf(messages.ErrPermissionDenied, key, secretStoreName))
+		respond(reqCtx, withError(fasthttp.StatusForbidden, msg))
+		return
+	}
+
+	req := secretstores.GetSecretRequest{
+		Name:     key,
+		Metadata: metadata,
+	}
+
+	start := time.Now()
+	policy := a.resiliency.ComponentOutboundPolicy(reqCtx, secretStoreName)
+	var resp secretstores.GetSecretResponse
+	err = policy(func(ctx context.Context) (rErr error) {
+		resp, rErr = store.GetSecret(req)
+		return rErr
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.SecretInvoked(context.Background(), secretStoreName, diag.Get, err == nil, elapsed)
+
+	if err != nil {
+		msg := NewErrorResponse("ERR_SECRET_GET",
+			fmt.Sprintf(messages.ErrSecretGet, req.Name, secretStoreName, err.Error()))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	if resp.Data == nil {
+		respond(reqCtx, withEmpty())
+		return
+	}
+
+	respBytes, _ := json.Marshal(resp.Data)
+	respond(reqCtx, withJSON(fasthttp.StatusOK, respBytes))
+}
+
+func (a *api) onBulkGetSecret(reqCtx *fasthttp.RequestCtx) {
+	store, secretStoreName, err := a.getSecretStoreWithRequestValidation(reqCtx)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+
+	metadata := getMetadataFromRequest(reqCtx)
+
+	req := secretstores.BulkGetSecretRequest{
+		Metadata: metadata,
+	}
+
+	start := time.Now()
+	policy := a.resiliency.ComponentOutboundPolicy(reqCtx, secretStoreName)
+	var resp secretstores.BulkGetSecretResponse
+	err = policy(func(ctx context.Context) (rErr error) {
+		resp, rErr = store.BulkGetSecret(req)
+		return rErr
+	})
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.SecretInvoked(context.Background(), secretStoreName, diag.BulkGet, err == nil, elapsed)
+
+	if err != nil {
+		msg := NewErrorResponse("ERR_SECRET_GET",
+			fmt.Sprintf(messages.ErrBulkSecretGet, secretStoreName, err.Error()))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	if resp.Data == nil {
+		respond(reqCtx, withEmpty())
+		return
+	}
+
+	filteredSecrets := map[string]map[string]string{}
+	for key, v := range resp.Data {
+		if a.isSecretAllowed(secretStoreName, key) {
+			filteredSecrets[key] = v
+		} else {
+			log.Debugf(messages.ErrPermissionDenied, key, secretStoreName)
+		}
+	}
+
+	respBytes, _ := json.Marshal(filteredSecrets)
+	respond(reqCtx, withJSON(fasthttp.StatusOK, respBytes))
+}
+
+func (a *api) getSecretStoreWithRequestValidation(reqCtx *fasthttp.RequestCtx) (secretstores.SecretStore, string, error) {
+	unlock := a.rlockComponents()
+	defer unlock()
+
+	if a.secretStores == nil || len(a.secretStores) == 0 {
+		msg := NewErrorResponse("ERR_SECRET_STORES_NOT_CONFIGURED", messages.ErrSecretStoreNotConfigured)
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		return nil, "", errors.New(msg.Message)
+	}
+
+	secretStoreName := reqCtx.UserValue(secretStoreNameParam).(string)
+
+	if a.secretStores[secretStoreName] == nil {
+		msg := NewErrorResponse("ERR_SECRET_STORE_NOT_FOUND", fmt.Sprintf(messages.ErrSecretStoreNotFound, secretStoreName))
+		respond(reqCtx, withError(fasthttp.StatusUnauthorized, msg))
+		return nil, "", errors.New(msg.Message)
+	}
+	return a.secretStores[secretStoreName], secretStoreName, nil
+}
+
+func (a *api) onPostState(reqCtx *fasthttp.RequestCtx) {
+	store, storeName, err := a.getStateStoreWithRequestValidation(reqCtx)
+	if err != nil {
+		log.Debug(err)
+		return
+	}
+
+	reqs := []state.SetRequest{}
+	err = json.Unmarshal(reqCtx.PostBody(), &reqs)
+	if err != nil {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
+	}
+	if len(reqs) == 0 {
+		respond(reqCtx, withEmpty())
+		return
+	}
+
+	metadata := getMetadataFromRequest(reqCtx)
+
+	for i, r := range reqs {
+		// merge metadata from URL query parameters
+		if reqs[i].Metadata == nil {
+			reqs[i].Metadata = metadata
+		} else {
+			for k, v := range metadata {
+				reqs[i].Metadata[k] = v
+			}
+		}
+
+		reqs[i].Key, err = a.getModifiedStateKeyToWrite(r.Key, storeName)
+		if err != nil {
+			msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
+			respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+			log.Debug(err)
+			return
+		}
+
+		if encryption.EncryptedStateStore(storeName) {
 			data := []byte(fmt.Sprintf("%v", r.Value))
 			val, encErr := encryption.TryEncryptValue(storeName, data)
 			if encErr != nil {
@@ -999,18 +1530,39 @@ func (a *api) onDirectMessage(reqCtx *fasthttp.RequestCtx) {
 
 	// Construct internal invoke method request
 	req := invokev1.NewInvokeMethodRequest(invokeMethodName).WithHTTPExtension(verb, reqCtx.QueryArgs().String())
-	req.WithRawData(reqCtx.Request.Body(), string(reqCtx.Request.Header.ContentType()))
+	if reqCtx.Request.IsBodyStream() {
+		// The server streams request bodies: forward the body without buffering it.
+		req.WithRawDataStream(reqCtx.RequestBodyStream(), string(reqCtx.Request.Header.ContentType()))
+	} else {
+		req.WithRawData(reqCtx.Request.Body(), string(reqCtx.Request.Header.ContentType()))
+	}
 	// Save headers to internal metadata
 	req.WithFastHTTPHeaders(&reqCtx.Request.Header)
 
+	// A streamed request body can be read only once, so the call can't be retried.
+	isStream := req.HasStream()
+	permanentIfStream := func(err error) error {
+		if isStream {
+			return backoff.Permanent(err)
+		}
+		return err
+	}
+
 	policy := a.resiliency.EndpointPolicy(reqCtx, targetID, fmt.Sprintf("%s:%s", targetID, invokeMethodName))
 	// Since we don't want to return the actual error, we have to extract several things in order to construct our response.
 	var resp *invokev1.InvokeMethodResponse
 	var body []byte
+	var bodyStream io.ReadCloser
 	var statusCode int
 	var msg ErrorResponse
 	errorOccurred := false
 	err := policy(func(ctx context.Context) (rErr error) {
+		if bodyStream != nil {
+			// Release the response of the previous attempt.
+			bodyStream.Close()
+			bodyStream = nil
+		}
+
 		resp, rErr = a.directMessaging.Invoke(ctx, targetID, req)
 
 		if rErr != nil {
@@ -1018,17 +1570,36 @@ func (a *api) onDirectMessage(reqCtx *fasthttp.RequestCtx) {
 			// For everything else, treat it as a gRPC transport error
 			errorOccurred = true
 			statusCode = fasthttp.StatusInternalServerError
-			if status.Code(rErr) == codes.PermissionDenied {
-				statusCode = invokev1.HTTPStatusFromCode(codes.PermissionDenied)
+			if code := status.Code(rErr); code == codes.PermissionDenied || code == codes.ResourceExhausted {
+				statusCode = invokev1.HTTPStatusFromCode(code)
 			}
 			msg = NewErrorResponse("ERR_DIRECT_INVOKE", fmt.Sprintf(messages.ErrDirectInvoke, targetID, rErr))
-			return rErr
+			return permanentIfStream(rErr)
 		}
 
 		errorOccurred = false
 		invokev1.InternalMetadataToHTTPHeader(reqCtx, resp.Headers(), reqCtx.Response.Header.Set)
 		var contentType string
-		contentType, body = resp.RawData()
+		if resp.HasStream() && resp.IsHTTPResponse() {
+			// Stream the response body to the caller without buffering it.
+			// The bulkhead slots of the call are held until the body has been sent.
+			contentType, bodyStream = resp.RawDataStream()
+			bodyStream = resiliency.ReleaseBulkheadSlotsOnClose(ctx, bodyStream)
+			if rErr = ctx.Err(); rErr != nil {
+				// The policy gave up on the call: nobody is going to read the response.
+				bodyStream.Close()
+				bodyStream = nil
+				return rErr
+			}
+		} else {
+			if rErr = resp.BufferStream(); rErr != nil {
+				errorOccurred = true
+				statusCode = fasthttp.StatusInternalServerError
+				msg = NewErrorResponse("ERR_DIRECT_INVOKE", fmt.Sprintf(messages.ErrDirectInvoke, targetID, rErr))
+				return permanentIfStream(rErr)
+			}
+			contentType, body = resp.RawData()
+		}
 		reqCtx.Response.Header.SetContentType(contentType)
 
 		// Construct response
@@ -1044,14 +1615,21 @@ func (a *api) onDirectMessage(reqCtx *fasthttp.RequestCtx) {
 				}
 			}
 		} else if statusCode != fasthttp.StatusOK {
-			return errors.Errorf("Received non-successful status code: %d", statusCode)
+			return permanentIfStream(resiliency.NewHTTPStatusCodeError(statusCode))
 		}
 		return nil
 	})
 
-	// Special case for timeouts/circuit breakers since they won't go through the rest of the logic.
-	if errors.Is(err, context.DeadlineExceeded) || breaker.IsErrorPermanent(err) {
-		respond(reqCtx, withError(500, NewErrorResponse("ERR_DIRECT_INVOKE", err.Error())))
+	// Special case for timeouts/circuit breakers/bulkheads since they won't go through the rest of the logic.
+	if errors.Is(err, context.DeadlineExceeded) || breaker.IsErrorPermanent(err) || errors.Is(err, resiliency.ErrBulkheadFull) {
+		if bodyStream != nil {
+			bodyStream.Close()
+		}
+		code := fasthttp.StatusInternalServerError
+		if errors.Is(err, resiliency.ErrBulkheadFull) {
+			code = fasthttp.StatusTooManyRequests
+		}
+		respond(reqCtx, withError(code, NewErrorResponse("ERR_DIRECT_INVOKE", err.Error())))
 		return
 	}
 
@@ -1059,6 +1637,11 @@ func (a *api) onDirectMessage(reqCtx *fasthttp.RequestCtx) {
 		respond(reqCtx, withError(statusCode, msg))
 		return
 	}
+	if bodyStream != nil {
+		// fasthttp closes the stream once the response has been written.
+		respond(reqCtx, withStream(statusCode, bodyStream))
+		return
+	}
 	respond(reqCtx, with(statusCode, body))
 }
 
@@ -1268,6 +1851,73 @@ func (a *api) onActorStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	}
 }
 
+func (a *api) onListActorReminders(reqCtx *fasthttp.RequestCtx) {
+	if a.actor == nil {
+		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", messages.ErrActorRuntimeNotFound)
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	req := &actors.ListRemindersRequest{
+		ActorType:         reqCtx.UserValue(actorTypeParam).(string),
+		ContinuationToken: string(reqCtx.QueryArgs().Peek(pageTokenParam)),
+	}
+	// The actor ID is only set when listing the reminders of a single actor.
+	if actorID, ok := reqCtx.UserValue(actorIDParam).(string); ok {
+		req.ActorID = actorID
+	}
+	if pageSize := string(reqCtx.QueryArgs().Peek(pageSizeParam)); pageSize != "" {
+		size, err := strconv.Atoi(pageSize)
+		if err != nil || size < 0 {
+			msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf("invalid page size %q", pageSize))
+			respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+			log.Debug(msg)
+			return
+		}
+		req.PageSize = size
+	}
+
+	resp, err := a.actor.ListReminders(reqCtx, req)
+	if err != nil {
+		msg := NewErrorResponse("ERR_ACTOR_REMINDERS_LIST", fmt.Sprintf(messages.ErrActorRemindersList, err))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+	b, err := json.Marshal(resp)
+	if err != nil {
+		msg := NewErrorResponse("ERR_ACTOR_REMINDERS_LIST", fmt.Sprintf(messages.ErrActorRemindersList, err))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	respond(reqCtx, withJSON(fasthttp.StatusOK, b))
+}
+
+func (a *api) onDeleteActorReminders(reqCtx *fasthttp.RequestCtx) {
+	if a.actor == nil {
+		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", messages.ErrActorRuntimeNotFound)
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	err := a.actor.DeleteReminders(reqCtx, &actors.DeleteRemindersRequest{
+		ActorType: reqCtx.UserValue(actorTypeParam).(string),
+		ActorID:   reqCtx.UserValue(actorIDParam).(string),
+	})
+	if err != nil {
+		msg := NewErrorResponse("ERR_ACTOR_REMINDERS_DELETE", fmt.Sprintf(messages.ErrActorRemindersDelete, err))
+		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		log.Debug(msg)
+		return
+	}
+
+	respond(reqCtx, withEmpty())
+}
+
 func (a *api) onGetActorReminder(reqCtx *fasthttp.RequestCtx) {
 	if a.actor == nil {
 		msg := NewErrorResponse("ERR_ACTOR_RUNTIME_NOT_FOUND", messages.ErrActorRuntimeNotFound)
@@ -1369,8 +2019,12 @@ func (a *api) onDirectActorMessage(reqCtx *fasthttp.RequestCtx) {
 		return rErr
 	})
 	if err != nil {
+		code := fasthttp.StatusInternalServerError
+		if errors.Is(err, resiliency.ErrBulkheadFull) {
+			code = fasthttp.StatusTooManyRequests
+		}
 		msg := NewErrorResponse("ERR_ACTOR_INVOKE_METHOD", fmt.Sprintf(messages.ErrActorInvoke, err))
-		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+		respond(reqCtx, withError(code, msg))
 		log.Debug(msg)
 		return
 	}
@@ -1446,14 +2100,20 @@ func (a *api) onGetMetadata(reqCtx *fasthttp.RequestCtx) {
 		activeActorsCount = a.actor.GetActiveActorsCount(reqCtx)
 	}
 
+	var capabilities map[string][]string
+	if a.getCapabilitiesFn != nil {
+		capabilities = a.getCapabilitiesFn()
+	}
+
 	components := a.getComponentsFn()
 	registeredComponents := make([]registeredComponent, 0, len(components))
 
 	for _, comp := range components {
 		registeredComp := registeredComponent{
-			Name:    comp.Name,
-			Version: comp.Spec.Version,
-			Type:    comp.Spec.Type,
+			Name:         comp.Name,
+			Version:      comp.Spec.Version,
+			Type:         comp.Spec.Type,
+			Capabilities: capabilities[comp.Name],
 		}
 		registeredComponents = append(registeredComponents, registeredComp)
 	}
@@ -1463,6 +2123,58 @@ func (a *api) onGetMetadata(reqCtx *fasthttp.RequestCtx) {
 		ActiveActorsCount:    activeActorsCount,
 		Extended:             temp,
 		RegisteredComponents: registeredComponents,
+		EnabledFeatures:      a.enabledFeatures,
+	}
+
+	if a.appHealth != nil {
+		cfg := a.appHealth.Config()
+		mtd.AppHealth = &appHealthMetadata{
+			Status:              a.appHealth.Status(),
+			HealthCheckPath:     cfg.Path,
+			HealthProbeInterval: cfg.ProbeInterval.String(),
+			HealthProbeTimeout:  cfg.ProbeTimeout.String(),
+			HealthThreshold:     cfg.Threshold,
+		}
+	}
+
+	if a.resiliency != nil {
+		status := a.resiliency.Status()
+		mtd.Resiliency = &status
+	}
+
+	if a.getSubscriptionsFn != nil {
+		for _, s := range a.getSubscriptionsFn() {
+			sub := subscriptionMetadata{
+				PubsubName:      s.PubsubName,
+				Topic:           s.Topic,
+				DeadLetterTopic: s.DeadLetterTopic,
+				Metadata:        s.Metadata,
+			}
+			for _, r := range s.Rules {
+				sub.Rules = append(sub.Rules, subscriptionRuleMetadata{
+					Match: r.MatchExpression(),
+					Path:  r.Path,
+				})
+			}
+			mtd.Subscriptions = append(mtd.Subscriptions, sub)
+		}
+	}
+
+	if a.appConnectionConfig.Protocol != "" {
+		mtd.AppConnection = &appConnectionMetadata{
+			Protocol:       a.appConnectionConfig.Protocol,
+			Port:           a.appConnectionConfig.Port,
+			MaxConcurrency: a.appConnectionConfig.MaxConcurrency,
+		}
+	}
+
+	if a.actor != nil {
+		placement := a.actor.GetPlacementStatus()
+		mtd.Placement = &placement
+	}
+
+	if a.reEncryptionJobs != nil {
+		mtd.StateReEncryption = a.reEncryptionJobs.Statuses()
 	}
 
 	mtdBytes, err := json.Marshal(mtd)
@@ -1618,6 +2330,197 @@ func (a *api) onPublish(reqCtx *fasthttp.RequestCtx) {
 	}
 }
 
+func (a *api) onBulkPublish(reqCtx *fasthttp.RequestCtx) {
+	if a.pubsubAdapter == nil {
+		msg := NewErrorResponse("ERR_PUBSUB_NOT_CONFIGURED", messages.ErrPubsubNotConfigured)
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+
+		return
+	}
+
+	pubsubName := reqCtx.UserValue(pubsubnameparam).(string)
+	if pubsubName == "" {
+		msg := NewErrorResponse("ERR_PUBSUB_EMPTY", messages.ErrPubsubEmpty)
+		respond(reqCtx, withError(fasthttp.StatusNotFound, msg))
+		log.Debug(msg)
+
+		return
+	}
+
+	thepubsub := a.pubsubAdapter.GetPubSub(pubsubName)
+	if thepubsub == nil {
+		msg := NewErrorResponse("ERR_PUBSUB_NOT_FOUND", fmt.Sprintf(messages.ErrPubsubNotFound, pubsubName))
+		respond(reqCtx, withError(fasthttp.StatusNotFound, msg))
+		log.Debug(msg)
+
+		return
+	}
+
+	topic := reqCtx.UserValue(topicParam).(string)
+	if topic == "" {
+		msg := NewErrorResponse("ERR_TOPIC_EMPTY", fmt.Sprintf(messages.ErrTopicEmpty, pubsubName))
+		respond(reqCtx, withError(fasthttp.StatusNotFound, msg))
+		log.Debug(msg)
+
+		return
+	}
+
+	var entries []bulkPublishMessageEntry
+	if err := json.Unmarshal(reqCtx.PostBody(), &entries); err != nil {
+		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf(messages.ErrMalformedRequest, err))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+
+		return
+	}
+	if len(entries) == 0 {
+		msg := NewErrorResponse("ERR_PUBSUB_EVENTS_SER", fmt.Sprintf(messages.ErrPubsubBulkEntriesEmpty, topic, pubsubName))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+
+		return
+	}
+
+	metadata := getMetadataFromRequest(reqCtx)
+
+	// Extract trace context from context.
+	span := diag_utils.SpanFromContext(reqCtx)
+	// Populate W3C traceparent to cloudevent envelope
+	corID := diag.SpanContextToW3CString(span.SpanContext())
+	// Populate W3C tracestate to cloudevent envelope
+	traceState := diag.TraceStateToW3CString(span.SpanContext())
+
+	features := thepubsub.Features()
+	entryIDs := make(map[string]struct{}, len(entries))
+	req := runtime_pubsub.BulkPublishRequest{
+		PubsubName: pubsubName,
+		Topic:      topic,
+		Entries:    make([]runtime_pubsub.BulkMessageEntry, len(entries)),
+		Metadata:   metadata,
+	}
+	for i, entry := range entries {
+		if _, ok := entryIDs[entry.EntryID]; ok || entry.EntryID == "" {
+			msg := NewErrorResponse("ERR_PUBSUB_EVENTS_SER", fmt.Sprintf(messages.ErrPubsubBulkEntryID, entry.EntryID, topic, pubsubName))
+			respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+			log.Debug(msg)
+
+			return
+		}
+		entryIDs[entry.EntryID] = struct{}{}
+
+		entryMetadata := make(map[string]string, len(metadata)+len(entry.Metadata))
+		for k, v := range metadata {
+			entryMetadata[k] = v
+		}
+		for k, v := range entry.Metadata {
+			entryMetadata[k] = v
+		}
+		rawPayload, metaErr := contrib_metadata.IsRawPayload(entryMetadata)
+		if metaErr != nil {
+			msg := NewErrorResponse("ERR_PUBSUB_REQUEST_METADATA",
+				fmt.Sprintf(messages.ErrMetadataGet, metaErr.Error()))
+			respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+			log.Debug(msg)
+
+			return
+		}
+
+		// Non JSON events are sent as JSON strings.
+		data := []byte(entry.Event)
+		if !contrib_contenttype.IsJSONContentType(entry.ContentType) && !contrib_contenttype.IsCloudEventContentType(entry.ContentType) {
+			var str string
+			if err := json.Unmarshal(entry.Event, &str); err == nil {
+				data = []byte(str)
+			}
+		}
+
+		if !rawPayload {
+			envelope, err := runtime_pubsub.NewCloudEvent(&runtime_pubsub.CloudEvent{
+				ID:              a.id,
+				Topic:           topic,
+				DataContentType: entry.ContentType,
+				Data:            data,
+				TraceID:         corID,
+				TraceState:      traceState,
+				Pubsub:          pubsubName,
+			})
+			if err != nil {
+				msg := NewErrorResponse("ERR_PUBSUB_CLOUD_EVENTS_SER",
+					fmt.Sprintf(messages.ErrPubsubCloudEventCreation, err.Error()))
+				respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+				log.Debug(msg)
+				return
+			}
+
+			pubsub.ApplyMetadata(envelope, features, entryMetadata)
+
+			data, err = json.Marshal(envelope)
+			if err != nil {
+				msg := NewErrorResponse("ERR_PUBSUB_CLOUD_EVENTS_SER",
+					fmt.Sprintf(messages.ErrPubsubCloudEventsSer, topic, pubsubName, err.Error()))
+				respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
+				log.Debug(msg)
+				return
+			}
+		}
+
+		req.Entries[i] = runtime_pubsub.BulkMessageEntry{
+			EntryID:     entry.EntryID,
+			Event:       data,
+			ContentType: entry.ContentType,
+			Metadata:    entry.Metadata,
+		}
+	}
+
+	start := time.Now()
+	res, err := a.pubsubAdapter.BulkPublish(&req)
+	elapsed := diag.ElapsedSince(start)
+
+	diag.DefaultComponentMonitoring.PubsubEgressEvent(context.Background(), pubsubName, topic, err == nil && len(res.FailedEntries) == 0, elapsed)
+
+	if err != nil {
+		status := fasthttp.StatusInternalServerError
+		msg := NewErrorResponse("ERR_PUBSUB_PUBLISH_MESSAGE",
+			fmt.Sprintf(messages.ErrPubsubBulkPublishMessage, topic, pubsubName, err.Error()))
+
+		if errors.As(err, &runtime_pubsub.NotAllowedError{}) {
+			msg = NewErrorResponse("ERR_PUBSUB_FORBIDDEN", err.Error())
+			status = fasthttp.StatusForbidden
+		}
+
+		if errors.As(err, &runtime_pubsub.NotFoundError{}) {
+			msg = NewErrorResponse("ERR_PUBSUB_NOT_FOUND", err.Error())
+			status = fasthttp.StatusBadRequest
+		}
+
+		respond(reqCtx, withError(status, msg))
+		log.Debug(msg)
+		return
+	}
+
+	if len(res.FailedEntries) == 0 {
+		respond(reqCtx, withEmpty())
+		return
+	}
+
+	resp := bulkPublishResponse{
+		FailedEntries: make([]bulkPublishResponseFailedEntry, len(res.FailedEntries)),
+		ErrorCode:     "ERR_PUBSUB_PUBLISH_MESSAGE",
+	}
+	for i, e := range res.FailedEntries {
+		resp.FailedEntries[i] = bulkPublishResponseFailedEntry{
+			EntryID: e.EntryID,
+		}
+		if e.Error != nil {
+			resp.FailedEntries[i].Error = e.Error.Error()
+		}
+	}
+	b, _ := json.Marshal(resp)
+	respond(reqCtx, withJSON(fasthttp.StatusInternalServerError, b))
+	log.Debugf("failed to publish %d of %d entries to topic %s in pubsub %s", len(res.FailedEntries), len(req.Entries), topic, pubsubName)
+}
+
 // GetStatusCodeFromMetadata extracts the http status code from the metadata if it exists.
 func GetStatusCodeFromMetadata(metadata map[string]string) int {
 	code := metadata[http.HTTPStatusCode]
@@ -1665,24 +2568,14 @@ func getMetadataFromRequest(reqCtx *fasthttp.RequestCtx) map[string]string {
 }
 
 func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
-	if a.stateStores == nil || len(a.stateStores) == 0 {
-		msg := NewErrorResponse("ERR_STATE_STORES_NOT_CONFIGURED", messages.ErrStateStoresNotConfigured)
-		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
-		log.Debug(msg)
-		return
-	}
-
-	storeName := reqCtx.UserValue(storeNameParam).(string)
-	_, ok := a.stateStores[storeName]
-	if !ok {
-		msg := NewErrorResponse("ERR_STATE_STORE_NOT_FOUND", fmt.Sprintf(messages.ErrStateStoreNotFound, storeName))
-		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
-		log.Debug(msg)
+	store, storeName, err := a.getStateStoreWithRequestValidation(reqCtx)
+	if err != nil {
+		log.Debug(err)
 		return
 	}
 
-	transactionalStore, ok := a.transactionalStateStores[storeName]
-	if !ok {
+	transactionalStore, ok := store.(state.TransactionalStore)
+	if !ok || !state.FeatureTransactional.IsPresent(store.Features()) {
 		msg := NewErrorResponse("ERR_STATE_STORE_NOT_SUPPORTED", fmt.Sprintf(messages.ErrStateStoreNotSupported, storeName))
 		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
 		log.Debug(msg)
@@ -1690,14 +2583,14 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 	}
 
 	body := reqCtx.PostBody()
-	var req state.TransactionalStateRequest
+	var req stateTransactionRequest
 	if err := json.Unmarshal(body, &req); err != nil {
 		msg := NewErrorResponse("ERR_MALFORMED_REQUEST", fmt.Sprintf(messages.ErrMalformedRequest, err.Error()))
 		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
 		log.Debug(msg)
 		return
 	}
-	if len(req.Operations) == 0 {
+	if len(req.Operations) == 0 && len(req.OutboxEvents) == 0 {
 		respond(reqCtx, withEmpty())
 		return
 	}
@@ -1725,7 +2618,7 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 				log.Debug(msg)
 				return
 			}
-			upsertReq.Key, err = state_loader.GetModifiedStateKey(upsertReq.Key, storeName, a.id)
+			upsertReq.Key, err = a.getModifiedStateKeyToWrite(upsertReq.Key, storeName)
 			if err != nil {
 				msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
 				respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
@@ -1746,7 +2639,7 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 				log.Debug(msg)
 				return
 			}
-			delReq.Key, err = state_loader.GetModifiedStateKey(delReq.Key, storeName, a.id)
+			delReq.Key, err = a.getModifiedStateKeyToWrite(delReq.Key, storeName)
 			if err != nil {
 				msg := NewErrorResponse("ERR_MALFORMED_REQUEST", err.Error())
 				respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
@@ -1788,9 +2681,55 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 		}
 	}
 
+	var outboxKeys []string
+	if len(req.OutboxEvents) > 0 {
+		if !outbox.OutboxStateStore(storeName) {
+			msg := NewErrorResponse("ERR_STATE_OUTBOX_NOT_CONFIGURED", fmt.Sprintf(messages.ErrStateOutboxNotConfigured, storeName))
+			respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+			log.Debug(msg)
+			return
+		}
+
+		// Populate the W3C trace context of the request to the cloudevent envelopes of the events.
+		span := diag_utils.SpanFromContext(reqCtx)
+		corID := diag.SpanContextToW3CString(span.SpanContext())
+		traceState := diag.TraceStateToW3CString(span.SpanContext())
+
+		events := make([]outbox.Event, len(req.OutboxEvents))
+		for i, e := range req.OutboxEvents {
+			// Non JSON events are sent as JSON strings.
+			data := []byte(e.Data)
+			if !contrib_contenttype.IsJSONContentType(e.ContentType) && !contrib_contenttype.IsCloudEventContentType(e.ContentType) {
+				var str string
+				if err := json.Unmarshal(e.Data, &str); err == nil {
+					data = []byte(str)
+				}
+			}
+
+			events[i] = outbox.Event{
+				ID:              e.ID,
+				Data:            data,
+				DataContentType: e.ContentType,
+				Metadata:        e.Metadata,
+				TraceID:         corID,
+				TraceState:      traceState,
+			}
+		}
+
+		outboxOperations, keys, err := outbox.Operations(storeName, a.id, events)
+		if err != nil {
+			msg := NewErrorResponse("ERR_STATE_OUTBOX_EVENTS", fmt.Sprintf(messages.ErrStateOutboxEvents, storeName, err.Error()))
+			respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+			log.Debug(msg)
+			return
+		}
+		operations = append(operations, outboxOperations...)
+		outboxKeys = keys
+	}
+
 	start := time.Now()
 	policy := a.resiliency.ComponentOutboundPolicy(reqCtx, storeName)
-	err := policy(func(ctx context.Context) error {
+	err = policy(func(ctx context.Context) error {
 		return transactionalStore.Multi(&state.TransactionalStateRequest{
 			Operations: operations,
 			Metadata:   req.Metadata,
@@ -1805,6 +2744,7 @@ func (a *api) onPostStateTransaction(reqCtx *fasthttp.RequestCtx) {
 		respond(reqCtx, withError(fasthttp.StatusInternalServerError, msg))
 		log.Debug(msg)
 	} else {
+		outbox.EventsSaved(storeName, outboxKeys)
 		respond(reqCtx, withEmpty())
 	}
 }
@@ -1878,6 +2818,29 @@ func (a *api) onQueryState(reqCtx *fasthttp.RequestCtx) {
 	respond(reqCtx, withJSON(fasthttp.StatusOK, b))
 }
 
+func (a *api) onReEncryptState(reqCtx *fasthttp.RequestCtx) {
+	store, storeName, err := a.getStateStoreWithRequestValidation(reqCtx)
+	if err != nil {
+		// error has been already logged
+		return
+	}
+
+	if a.reEncryptionJobs == nil {
+		msg := NewErrorResponse("ERR_METHOD_NOT_FOUND", fmt.Sprintf(messages.ErrNotFound, "ReEncryptState"))
+		respond(reqCtx, withError(fasthttp.StatusNotFound, msg))
+		log.Debug(msg)
+		return
+	}
+
+	if err = a.reEncryptionJobs.Start(storeName, store); err != nil {
+		msg := NewErrorResponse("ERR_STATE_REENCRYPTION", fmt.Sprintf(messages.ErrStateReEncryption, storeName, err.Error()))
+		respond(reqCtx, withError(fasthttp.StatusBadRequest, msg))
+		log.Debug(msg)
+		return
+	}
+	respond(reqCtx, with(fasthttp.StatusAccepted, nil))
+}
+
 func (a *api) isSecretAllowed(storeName, key string) bool {
 	if config, ok := a.secretsConfiguration[storeName]; ok {
 		return config.IsSecretAllowed(key)
@@ -1897,3 +2860,27 @@ func (a *api) SetDirectMessaging(directMessaging messaging.DirectMessaging) {
 func (a *api) SetActorRuntime(actor actors.Actors) {
 	a.actor = actor
 }
+
+func (a *api) SetAppHealth(appHealth *apphealth.AppHealth) {
+	a.appHealth = appHealth
+}
+
+func (a *api) SetAppConnectionConfig(appConnectionConfig channel.AppConnectionConfig) {
+	a.appConnectionConfig = appConnectionConfig
+}
+
+func (a *api) SetEnabledFeatures(enabledFeatures []string) {
+	a.enabledFeatures = enabledFeatures
+}
+
+func (a *api) SetSubscriptionsFn(getSubscriptionsFn func() []runtime_pubsub.Subscription) {
+	a.getSubscriptionsFn = getSubscriptionsFn
+}
+
+func (a *api) SetComponentsCapabilitiesFn(getCapabilitiesFn func() map[string][]string) {
+	a.getCapabilitiesFn = getCapabilitiesFn
+}
+
+func (a *api) SetReEncryptionJobs(reEncryptionJobs *encryption.ReEncryptionJobs) {
+	a.reEncryptionJobs = reEncryptionJobs
+}
@@ -29,9 +29,11 @@ import (
 
 	"github.com/agrea/ptr"
 	routing "github.com/fasthttp/router"
+	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/valyala/fasthttp"
 	"github.com/valyala/fasthttp/fasthttputil"
 	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -42,6 +44,8 @@ import (
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/dapr/components-contrib/bindings"
+	"github.com/dapr/components-contrib/configuration"
+	"github.com/dapr/components-contrib/lock"
 	"github.com/dapr/components-contrib/middleware"
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/secretstores"
@@ -51,7 +55,11 @@ import (
 	"github.com/dapr/dapr/pkg/actors"
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	"github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/apphealth"
+	"github.com/dapr/dapr/pkg/channel"
 	"github.com/dapr/dapr/pkg/channel/http"
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	configuration_loader "github.com/dapr/dapr/pkg/components/configuration"
 	http_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/http"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
@@ -93,6 +101,12 @@ var testResiliency = &v1alpha1.Resiliency{
 					Trip:        "consecutiveFailures > 4",
 				},
 			},
+			Bulkheads: map[string]v1alpha1.Bulkhead{
+				"singleCall": {
+					MaxConcurrent: 1,
+					MaxQueueWait:  "10ms",
+				},
+			},
 		},
 		Targets: v1alpha1.Targets{
 			Apps: map[string]v1alpha1.EndpointPolicyNames{
@@ -100,6 +114,10 @@ var testResiliency = &v1alpha1.Resiliency{
 					Retry:   "singleRetry",
 					Timeout: "fast",
 				},
+				"bulkheadApp": {
+					Timeout:  "fast",
+					Bulkhead: "singleCall",
+				},
 				"circuitBreakerApp": {
 					Retry:          "tenRetries",
 					CircuitBreaker: "simpleCB",
@@ -291,6 +309,102 @@ func TestPubSubEndpoints(t *testing.T) {
 	fakeServer.Shutdown()
 }
 
+func TestBulkPubSubEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	var lastRequest *runtime_pubsub.BulkPublishRequest
+	testAPI := &api{
+		pubsubAdapter: &daprt.MockPubSubAdapter{
+			BulkPublishFn: func(req *runtime_pubsub.BulkPublishRequest) (runtime_pubsub.BulkPublishResponse, error) {
+				lastRequest = req
+				if req.PubsubName == "errnotallowed" {
+					return runtime_pubsub.BulkPublishResponse{}, runtime_pubsub.NotAllowedError{Topic: req.Topic, ID: "test"}
+				}
+
+				res := runtime_pubsub.BulkPublishResponse{}
+				for _, entry := range req.Entries {
+					if entry.Metadata["fail"] == "true" {
+						res.FailedEntries = append(res.FailedEntries, runtime_pubsub.BulkPublishResponseFailedEntry{
+							EntryID: entry.EntryID,
+							Error:   errors.New("failed entry"),
+						})
+					}
+				}
+				return res, nil
+			},
+			GetPubSubFn: func(pubsubName string) pubsub.PubSub {
+				return &daprt.MockPubSub{}
+			},
+		},
+	}
+	fakeServer.StartServer(testAPI.constructPubSubEndpoints())
+
+	t.Run("Bulk publish successfully - 204 No Content", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/publish/bulk/pubsubname/topic", apiVersionV1alpha1)
+		body := []byte(`[{"entryId":"1","event":"hello","contentType":"text/plain"},` +
+			`{"entryId":"2","event":{"key":"value"},"contentType":"application/json","metadata":{"rawPayload":"true"}}]`)
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, body, nil)
+		// assert
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.Equal(t, []byte{}, resp.RawBody, "Always give empty body with 204")
+		require.NotNil(t, lastRequest)
+		require.Equal(t, 2, len(lastRequest.Entries))
+
+		var envelope map[string]interface{}
+		require.NoError(t, json.Unmarshal(lastRequest.Entries[0].Event, &envelope))
+		assert.Equal(t, "hello", envelope["data"])
+		assert.Equal(t, "topic", envelope["topic"])
+		assert.Equal(t, `{"key":"value"}`, string(lastRequest.Entries[1].Event))
+	})
+
+	t.Run("Bulk publish with failed entries - 500", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/publish/bulk/pubsubname/topic", apiVersionV1alpha1)
+		body := []byte(`[{"entryId":"1","event":"hello","contentType":"text/plain"},` +
+			`{"entryId":"2","event":"world","contentType":"text/plain","metadata":{"fail":"true"}}]`)
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, body, nil)
+		// assert
+		assert.Equal(t, 500, resp.StatusCode)
+		var res bulkPublishResponse
+		require.NoError(t, json.Unmarshal(resp.RawBody, &res))
+		assert.Equal(t, "ERR_PUBSUB_PUBLISH_MESSAGE", res.ErrorCode)
+		require.Equal(t, 1, len(res.FailedEntries))
+		assert.Equal(t, "2", res.FailedEntries[0].EntryID)
+		assert.Equal(t, "failed entry", res.FailedEntries[0].Error)
+	})
+
+	t.Run("Bulk publish with duplicated entry id - 400", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/publish/bulk/pubsubname/topic", apiVersionV1alpha1)
+		body := []byte(`[{"entryId":"1","event":"hello"},{"entryId":"1","event":"world"}]`)
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, body, nil)
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_EVENTS_SER", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Bulk publish with malformed body - 400", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/publish/bulk/pubsubname/topic", apiVersionV1alpha1)
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, []byte(`{"entryId":"1"}`), nil)
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Bulk publish not allowed - 403", func(t *testing.T) {
+		apiPath := fmt.Sprintf("%s/publish/bulk/errnotallowed/topic", apiVersionV1alpha1)
+		body := []byte(`[{"entryId":"1","event":"hello","contentType":"text/plain"}]`)
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, body, nil)
+		// assert
+		assert.Equal(t, 403, resp.StatusCode)
+		assert.Equal(t, "ERR_PUBSUB_FORBIDDEN", resp.ErrorBody["errorCode"])
+	})
+
+	fakeServer.Shutdown()
+}
+
 func TestShutdownEndpoints(t *testing.T) {
 	fakeServer := newFakeHTTPServer()
 
@@ -919,7 +1033,8 @@ func TestV1DirectMessagingEndpointsWithResiliency(t *testing.T) {
 				"circuitBreakerKey": 10,
 			},
 			Timeouts: map[string]time.Duration{
-				"timeoutKey": time.Second * 10,
+				"timeoutKey":  time.Second * 10,
+				"bulkheadKey": time.Second,
 			},
 			CallCount: map[string]int{},
 		},
@@ -1000,6 +1115,20 @@ func TestV1DirectMessagingEndpointsWithResiliency(t *testing.T) {
 		assert.Equal(t, 5, failingDirectMessaging.Failure.CallCount["circuitBreakerKey"])
 	})
 
+	t.Run("Test invoke direct messages rejected by a full bulkhead", func(t *testing.T) {
+		apiPath := "v1.0/invoke/bulkheadApp/method/fakeMethod"
+		fakeData := []byte("bulkheadKey")
+
+		// The call times out, but keeps its slot in the bulkhead until it returns.
+		resp := fakeServer.DoRequest("POST", apiPath, fakeData, nil)
+		assert.Equal(t, 500, resp.StatusCode)
+
+		resp = fakeServer.DoRequest("POST", apiPath, fakeData, nil)
+		assert.Equal(t, 429, resp.StatusCode)
+		assert.Contains(t, string(resp.RawBody), resiliency.ErrBulkheadFull.Error())
+		assert.Equal(t, 1, failingDirectMessaging.Failure.CallCount["bulkheadKey"])
+	})
+
 	fakeServer.Shutdown()
 }
 
@@ -1471,6 +1600,123 @@ func TestV1ActorEndpoints(t *testing.T) {
 		mockActors.AssertNumberOfCalls(t, "DeleteReminder", 1)
 	})
 
+	t.Run("Reminders List - 200 OK", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/fakeActorID/reminders"
+		remindersRequest := actors.ListRemindersRequest{
+			ActorType:         "fakeActorType",
+			ActorID:           "fakeActorID",
+			PageSize:          10,
+			ContinuationToken: "token",
+		}
+		remindersResponse := actors.ListRemindersResponse{
+			Reminders: []actors.Reminder{
+				{ActorType: "fakeActorType", ActorID: "fakeActorID", Name: "reminder1"},
+			},
+			ContinuationToken: "next",
+		}
+
+		mockActors := new(actors.MockActors)
+
+		mockActors.On("ListReminders", &remindersRequest).Return(&remindersResponse, nil)
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, map[string]string{
+			"pageSize":          "10",
+			"continuationToken": "token",
+		})
+
+		// assert
+		assert.Equal(t, 200, resp.StatusCode)
+		var body actors.ListRemindersResponse
+		assert.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		assert.Equal(t, "next", body.ContinuationToken)
+		assert.Len(t, body.Reminders, 1)
+		mockActors.AssertNumberOfCalls(t, "ListReminders", 1)
+	})
+
+	t.Run("Reminders List - 400 on invalid page size", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/reminders"
+
+		mockActors := new(actors.MockActors)
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, map[string]string{"pageSize": "abc"})
+
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+		mockActors.AssertNumberOfCalls(t, "ListReminders", 0)
+	})
+
+	t.Run("Reminders List - 500 on upstream actor error", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/reminders"
+		remindersRequest := actors.ListRemindersRequest{
+			ActorType: "fakeActorType",
+		}
+
+		mockActors := new(actors.MockActors)
+
+		mockActors.On("ListReminders", &remindersRequest).Return(nil, errors.New("UPSTREAM_ERROR"))
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_ACTOR_REMINDERS_LIST", resp.ErrorBody["errorCode"])
+		mockActors.AssertNumberOfCalls(t, "ListReminders", 1)
+	})
+
+	t.Run("Reminders Delete - 204 No Content", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/fakeActorID/reminders"
+		remindersRequest := actors.DeleteRemindersRequest{
+			ActorType: "fakeActorType",
+			ActorID:   "fakeActorID",
+		}
+
+		mockActors := new(actors.MockActors)
+
+		mockActors.On("DeleteReminders", &remindersRequest).Return(nil)
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("DELETE", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.Equal(t, []byte{}, resp.RawBody, "Always give empty body with 204")
+		mockActors.AssertNumberOfCalls(t, "DeleteReminders", 1)
+	})
+
+	t.Run("Reminders Delete - 500 on upstream actor error", func(t *testing.T) {
+		apiPath := "v1.0/actors/fakeActorType/fakeActorID/reminders"
+		remindersRequest := actors.DeleteRemindersRequest{
+			ActorType: "fakeActorType",
+			ActorID:   "fakeActorID",
+		}
+
+		mockActors := new(actors.MockActors)
+
+		mockActors.On("DeleteReminders", &remindersRequest).Return(errors.New("UPSTREAM_ERROR"))
+
+		testAPI.actor = mockActors
+
+		// act
+		resp := fakeServer.DoRequest("DELETE", apiPath, nil, nil)
+
+		// assert
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_ACTOR_REMINDERS_DELETE", resp.ErrorBody["errorCode"])
+		mockActors.AssertNumberOfCalls(t, "DeleteReminders", 1)
+	})
+
 	t.Run("Reminder Get - 200 OK", func(t *testing.T) {
 		apiPath := "v1.0/actors/fakeActorType/fakeActorID/reminders/reminder1"
 		reminderRequest := actors.GetReminderRequest{
@@ -1790,6 +2036,7 @@ func TestV1MetadataEndpoint(t *testing.T) {
 			{"name": "MockComponent1Name", "type": "mock.component1Type", "version": "v1.0"},
 			{"name": "MockComponent2Name", "type": "mock.component2Type", "version": "v1.0"},
 		},
+		"placement": map[string]interface{}{"connected": true, "tableVersion": "1"},
 	}
 	expectedBodyBytes, _ := json.Marshal(expectedBody)
 
@@ -1798,6 +2045,7 @@ func TestV1MetadataEndpoint(t *testing.T) {
 		mockActors := new(actors.MockActors)
 
 		mockActors.On("GetActiveActorsCount")
+		mockActors.On("GetPlacementStatus").Return(actors.PlacementStatus{Connected: true, TableVersion: "1"})
 
 		testAPI.id = "xyz"
 		testAPI.actor = mockActors
@@ -1809,6 +2057,104 @@ func TestV1MetadataEndpoint(t *testing.T) {
 		mockActors.AssertNumberOfCalls(t, "GetActiveActorsCount", 1)
 	})
 
+	t.Run("Metadata - app health", func(t *testing.T) {
+		apiPath := "v1.0/metadata"
+		mockActors := new(actors.MockActors)
+		mockActors.On("GetActiveActorsCount")
+		mockActors.On("GetPlacementStatus").Return(actors.PlacementStatus{Connected: true, TableVersion: "1"})
+		testAPI.actor = mockActors
+		testAPI.appHealth = apphealth.NewAppHealth(apphealth.Config{Path: "/healthz"}, nil)
+		defer func() {
+			testAPI.appHealth = nil
+		}()
+
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		assert.Equal(t, map[string]interface{}{
+			"status":              apphealth.StatusUnhealthy,
+			"healthCheckPath":     "/healthz",
+			"healthProbeInterval": "5s",
+			"healthProbeTimeout":  "500ms",
+			"healthThreshold":     float64(apphealth.DefaultThreshold),
+		}, body["appHealth"])
+	})
+
+	t.Run("Metadata - resiliency", func(t *testing.T) {
+		apiPath := "v1.0/metadata"
+		mockActors := new(actors.MockActors)
+		mockActors.On("GetActiveActorsCount")
+		mockActors.On("GetPlacementStatus").Return(actors.PlacementStatus{Connected: true, TableVersion: "1"})
+		testAPI.actor = mockActors
+		testAPI.resiliency = resiliency.FromConfigurations(logger.NewLogger("test.api.http.metadata"), testResiliency)
+		defer func() {
+			testAPI.resiliency = nil
+		}()
+
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		var body metadata
+		require.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		require.NotNil(t, body.Resiliency)
+		assert.Equal(t, []string{"fast"}, body.Resiliency.Policies.Timeouts)
+		assert.Equal(t, []string{"simpleCB"}, body.Resiliency.Policies.CircuitBreakers)
+		assert.NotEmpty(t, body.Resiliency.Targets)
+	})
+
+	t.Run("Metadata - subscriptions, app connection, features and capabilities", func(t *testing.T) {
+		apiPath := "v1.0/metadata"
+		mockActors := new(actors.MockActors)
+		mockActors.On("GetActiveActorsCount")
+		mockActors.On("GetPlacementStatus").Return(actors.PlacementStatus{Connected: true, TableVersion: "1"})
+		testAPI.actor = mockActors
+		testAPI.SetAppConnectionConfig(channel.AppConnectionConfig{
+			Protocol:       "http",
+			Port:           3000,
+			MaxConcurrency: 10,
+		})
+		testAPI.SetEnabledFeatures([]string{"Resiliency"})
+		testAPI.SetSubscriptionsFn(func() []runtime_pubsub.Subscription {
+			return []runtime_pubsub.Subscription{
+				{
+					PubsubName: "pubsub",
+					Topic:      "topic",
+					Rules:      []*runtime_pubsub.Rule{{Path: "/orders"}},
+				},
+			}
+		})
+		testAPI.SetComponentsCapabilitiesFn(func() map[string][]string {
+			return map[string][]string{"MockComponent1Name": {"ETAG"}}
+		})
+		defer func() {
+			testAPI.appConnectionConfig = channel.AppConnectionConfig{}
+			testAPI.enabledFeatures = nil
+			testAPI.getSubscriptionsFn = nil
+			testAPI.getCapabilitiesFn = nil
+		}()
+
+		resp := fakeServer.DoRequest("GET", apiPath, nil, nil)
+
+		assert.Equal(t, 200, resp.StatusCode)
+		var body metadata
+		require.NoError(t, json.Unmarshal(resp.RawBody, &body))
+		assert.Equal(t, []subscriptionMetadata{
+			{
+				PubsubName: "pubsub",
+				Topic:      "topic",
+				Rules:      []subscriptionRuleMetadata{{Path: "/orders"}},
+			},
+		}, body.Subscriptions)
+		assert.Equal(t, &appConnectionMetadata{Protocol: "http", Port: 3000, MaxConcurrency: 10}, body.AppConnection)
+		assert.Equal(t, &actors.PlacementStatus{Connected: true, TableVersion: "1"}, body.Placement)
+		assert.Equal(t, []string{"Resiliency"}, body.EnabledFeatures)
+		require.Len(t, body.RegisteredComponents, 2)
+		assert.Equal(t, []string{"ETAG"}, body.RegisteredComponents[0].Capabilities)
+		assert.Empty(t, body.RegisteredComponents[1].Capabilities)
+	})
+
 	fakeServer.Shutdown()
 }
 
@@ -2502,14 +2848,9 @@ func TestV1StateEndpoints(t *testing.T) {
 		"store1":    fakeStore,
 		"failStore": failingStore,
 	}
-	fakeTransactionalStores := map[string]state.TransactionalStore{
-		"store1":    fakeStore.(state.TransactionalStore),
-		"failStore": failingStore,
-	}
 	testAPI := &api{
-		stateStores:              fakeStores,
-		transactionalStateStores: fakeTransactionalStores,
-		resiliency:               resiliency.FromConfigurations(logger.NewLogger("state.test"), testResiliency),
+		stateStores: fakeStores,
+		resiliency:  resiliency.FromConfigurations(logger.NewLogger("state.test"), testResiliency),
 	}
 	fakeServer.StartServer(testAPI.constructStateEndpoints())
 	storeName := "store1"
@@ -3058,6 +3399,43 @@ func TestStateStoreQuerierEncrypted(t *testing.T) {
 	assert.Equal(t, 400, resp.StatusCode)
 }
 
+func TestV1Alpha1StateReEncryptionEndpoint(t *testing.T) {
+	storeName := "encrypted-store2"
+	fakeServer := newFakeHTTPServer()
+	reEncryptionJobs := encryption.NewReEncryptionJobs(context.Background())
+	testAPI := &api{
+		stateStores: map[string]state.Store{
+			storeName: fakeStateStoreQuerier{},
+			"store1":  fakeStateStoreQuerier{},
+		},
+		resiliency:       resiliency.New(nil),
+		reEncryptionJobs: reEncryptionJobs,
+	}
+	encryption.AddEncryptedStateStore(storeName, encryption.ComponentEncryptionKeys{})
+	fakeServer.StartServer(testAPI.constructStateEndpoints())
+
+	t.Run("encrypted state store - 202", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/"+storeName+"/reencrypt", nil, nil)
+		assert.Equal(t, 202, resp.StatusCode)
+		assert.Eventually(t, func() bool {
+			statuses := reEncryptionJobs.Statuses()
+			return len(statuses) == 1 && statuses[0].State == encryption.ReEncryptionCompleted
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("state store not encrypted - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store1/reencrypt", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_REENCRYPTION", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("state store not found - 400", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/state/store2/reencrypt", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_STORE_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+}
+
 const (
 	queryTestRequestOK = `{
 	"filter": {
@@ -3392,6 +3770,241 @@ func TestV1SecretEndpoints(t *testing.T) {
 	})
 }
 
+func TestV1DistributedLockEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	mockLockStore := daprt.NewMockStore(ctl)
+	testAPI := &api{
+		lockStores: map[string]lock.Store{"mock": mockLockStore},
+	}
+	fakeServer.StartServer(testAPI.constructDistributedLockEndpoints())
+	defer fakeServer.Shutdown()
+
+	t.Run("TryLock - 500 ERR_LOCK_STORE_NOT_CONFIGURED", func(t *testing.T) {
+		emptyAPI := &api{}
+		emptyServer := newFakeHTTPServer()
+		emptyServer.StartServer(emptyAPI.constructDistributedLockEndpoints())
+		defer emptyServer.Shutdown()
+
+		resp := emptyServer.DoRequest("POST", "v1.0-alpha1/lock/mock", []byte(`{}`), nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_LOCK_STORE_NOT_CONFIGURED", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("TryLock - 400 ERR_LOCK_STORE_NOT_FOUND", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/lock/abc", []byte(`{}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_LOCK_STORE_NOT_FOUND", resp.ErrorBody["errorCode"])
+		assert.Equal(t, "lock store abc not found", resp.ErrorBody["message"])
+	})
+
+	t.Run("TryLock - 400 malformed request", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/lock/mock", invalidJSON, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("TryLock - 400 ResourceId empty", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/lock/mock", []byte(`{"lockOwner":"owner","expiryInSeconds":1}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ResourceId is empty in lock store mock", resp.ErrorBody["message"])
+	})
+
+	t.Run("TryLock - 400 LockOwner empty", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/lock/mock", []byte(`{"resourceId":"resource","expiryInSeconds":1}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "LockOwner is empty in lock store mock", resp.ErrorBody["message"])
+	})
+
+	t.Run("TryLock - 400 ExpiryInSeconds is not positive", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/lock/mock", []byte(`{"resourceId":"resource","lockOwner":"owner"}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ExpiryInSeconds is not positive in lock store mock", resp.ErrorBody["message"])
+	})
+
+	t.Run("TryLock - 400 illegal ResourceId", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/lock/mock", []byte(`{"resourceId":"a||b","lockOwner":"owner","expiryInSeconds":1}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_MALFORMED_REQUEST", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("TryLock - 200 OK", func(t *testing.T) {
+		mockLockStore.EXPECT().TryLock(gomock.Any()).DoAndReturn(func(req *lock.TryLockRequest) (*lock.TryLockResponse, error) {
+			assert.Equal(t, "lock||resource", req.ResourceID)
+			assert.Equal(t, "owner", req.LockOwner)
+			assert.Equal(t, int32(1), req.ExpiryInSeconds)
+			return &lock.TryLockResponse{
+				Success: true,
+			}, nil
+		})
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/lock/mock", []byte(`{"resourceId":"resource","lockOwner":"owner","expiryInSeconds":1}`), nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, `{"success":true}`, string(resp.RawBody))
+	})
+
+	t.Run("TryLock - 500 ERR_TRY_LOCK", func(t *testing.T) {
+		mockLockStore.EXPECT().TryLock(gomock.Any()).Return(nil, errors.New("failed"))
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/lock/mock", []byte(`{"resourceId":"resource","lockOwner":"owner","expiryInSeconds":1}`), nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_TRY_LOCK", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Unlock - 400 ERR_LOCK_STORE_NOT_FOUND", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/unlock/abc", []byte(`{}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_LOCK_STORE_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Unlock - 400 ResourceId empty", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/unlock/mock", []byte(`{"lockOwner":"owner"}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ResourceId is empty in lock store mock", resp.ErrorBody["message"])
+	})
+
+	t.Run("Unlock - 400 LockOwner empty", func(t *testing.T) {
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/unlock/mock", []byte(`{"resourceId":"resource"}`), nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "LockOwner is empty in lock store mock", resp.ErrorBody["message"])
+	})
+
+	t.Run("Unlock - 200 OK", func(t *testing.T) {
+		mockLockStore.EXPECT().Unlock(gomock.Any()).DoAndReturn(func(req *lock.UnlockRequest) (*lock.UnlockResponse, error) {
+			assert.Equal(t, "lock||resource", req.ResourceID)
+			assert.Equal(t, "owner", req.LockOwner)
+			return &lock.UnlockResponse{
+				Status: lock.LockBelongToOthers,
+			}, nil
+		})
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/unlock/mock", []byte(`{"resourceId":"resource","lockOwner":"owner"}`), nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, `{"status":2}`, string(resp.RawBody))
+	})
+
+	t.Run("Unlock - 500 ERR_UNLOCK", func(t *testing.T) {
+		mockLockStore.EXPECT().Unlock(gomock.Any()).Return(nil, errors.New("failed"))
+
+		resp := fakeServer.DoRequest("POST", "v1.0-alpha1/unlock/mock", []byte(`{"resourceId":"resource","lockOwner":"owner"}`), nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_UNLOCK", resp.ErrorBody["errorCode"])
+	})
+}
+
+func TestV1ConfigurationEndpoints(t *testing.T) {
+	fakeServer := newFakeHTTPServer()
+	fakeStore := &daprt.MockConfigurationStore{}
+	mockAppChannel := new(channelt.MockAppChannel)
+	testAPI := &api{
+		appChannel:             mockAppChannel,
+		configurationStores:    map[string]configuration.Store{"store1": fakeStore},
+		configurationSubscribe: configuration_loader.NewSubscriptions(),
+		resiliency:             resiliency.New(nil),
+	}
+	fakeServer.StartServer(testAPI.constructConfigurationEndpoints())
+	defer fakeServer.Shutdown()
+
+	t.Run("Get configuration - 500 ERR_CONFIGURATION_STORE_NOT_CONFIGURED", func(t *testing.T) {
+		emptyAPI := &api{}
+		emptyServer := newFakeHTTPServer()
+		emptyServer.StartServer(emptyAPI.constructConfigurationEndpoints())
+		defer emptyServer.Shutdown()
+
+		resp := emptyServer.DoRequest("GET", "v1.0-alpha1/configuration/store1", nil, nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_CONFIGURATION_STORE_NOT_CONFIGURED", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Get configuration - 400 ERR_CONFIGURATION_STORE_NOT_FOUND", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/configuration/nonexistent", nil, nil)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_CONFIGURATION_STORE_NOT_FOUND", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Get configuration - 200 OK", func(t *testing.T) {
+		fakeStore.On("Get",
+			mock.Anything,
+			mock.MatchedBy(func(req *configuration.GetRequest) bool {
+				return len(req.Keys) == 2 && req.Keys[0] == "good-key1" && req.Keys[1] == "good-key2" && req.Metadata["version"] == "1"
+			})).Return(
+			&configuration.GetResponse{
+				Items: []*configuration.Item{
+					{Key: "good-key1", Value: "value1"},
+					{Key: "good-key2", Value: "value2"},
+				},
+			}, nil).Once()
+
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/configuration/store1?key=good-key1&key=good-key2&metadata.version=1", nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, `[{"key":"good-key1","value":"value1"},{"key":"good-key2","value":"value2"}]`, string(resp.RawBody))
+	})
+
+	t.Run("Get configuration - 500 ERR_CONFIGURATION_GET", func(t *testing.T) {
+		fakeStore.On("Get",
+			mock.Anything,
+			mock.MatchedBy(func(req *configuration.GetRequest) bool {
+				return len(req.Keys) == 1 && req.Keys[0] == "error-key"
+			})).Return(nil, errors.New("failed to get error-key")).Once()
+
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/configuration/store1?key=error-key", nil, nil)
+		assert.Equal(t, 500, resp.StatusCode)
+		assert.Equal(t, "ERR_CONFIGURATION_GET", resp.ErrorBody["errorCode"])
+	})
+
+	t.Run("Subscribe configuration - updates are sent to the declared route", func(t *testing.T) {
+		var handler configuration.UpdateHandler
+		fakeStore.On("Subscribe",
+			mock.Anything,
+			mock.MatchedBy(func(req *configuration.SubscribeRequest) bool {
+				return len(req.Keys) == 1 && req.Keys[0] == "good-key1"
+			}),
+			mock.Anything).Run(func(args mock.Arguments) {
+			handler = args.Get(2).(configuration.UpdateHandler)
+		}).Return("subscription1", nil).Once()
+		mockAppChannel.On("InvokeMethod",
+			mock.Anything,
+			mock.MatchedBy(func(req *invokev1.InvokeMethodRequest) bool {
+				_, body := req.RawData()
+				return req.Message().Method == "config-updates" &&
+					string(body) == `{"id":"subscription1","items":[{"key":"good-key1","value":"value2"}]}`
+			})).Return(invokev1.NewInvokeMethodResponse(200, "OK", nil), nil).Once()
+
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/configuration/store1/subscribe?key=good-key1&route=/config-updates", nil, nil)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, `{"id":"subscription1"}`, string(resp.RawBody))
+
+		err := handler(context.Background(), &configuration.UpdateEvent{
+			ID:    "subscription1",
+			Items: []*configuration.Item{{Key: "good-key1", Value: "value2"}},
+		})
+		assert.NoError(t, err)
+		mockAppChannel.AssertNumberOfCalls(t, "InvokeMethod", 1)
+	})
+
+	t.Run("Unsubscribe configuration - 204 No Content", func(t *testing.T) {
+		fakeStore.On("Unsubscribe",
+			mock.Anything,
+			mock.MatchedBy(func(req *configuration.UnsubscribeRequest) bool {
+				return req.ID == "subscription1"
+			})).Return(nil).Once()
+
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/configuration/store1/subscription1/unsubscribe", nil, nil)
+		assert.Equal(t, 204, resp.StatusCode)
+		assert.False(t, testAPI.configurationSubscribe.Has("subscription1"))
+		fakeStore.AssertNumberOfCalls(t, "Unsubscribe", 1)
+	})
+
+	t.Run("Unsubscribe configuration - unknown subscription", func(t *testing.T) {
+		resp := fakeServer.DoRequest("GET", "v1.0-alpha1/configuration/store1/unknown/unsubscribe", nil, nil)
+		assert.Equal(t, 404, resp.StatusCode)
+		assert.Equal(t, "ERR_CONFIGURATION_SUBSCRIPTION_NOT_FOUND", resp.ErrorBody["errorCode"])
+		fakeStore.AssertNumberOfCalls(t, "Unsubscribe", 1)
+	})
+}
+
 func TestV1HealthzEndpoint(t *testing.T) {
 	fakeServer := newFakeHTTPServer()
 
@@ -3427,13 +4040,9 @@ func TestV1TransactionEndpoints(t *testing.T) {
 		"store1":                fakeStore,
 		"storeNonTransactional": fakeStoreNonTransactional,
 	}
-	fakeTransactionalStores := map[string]state.TransactionalStore{
-		"store1": fakeStore.(state.TransactionalStore),
-	}
 	testAPI := &api{
-		stateStores:              fakeStores,
-		transactionalStateStores: fakeTransactionalStores,
-		resiliency:               resiliency.New(nil),
+		stateStores: fakeStores,
+		resiliency:  resiliency.New(nil),
 	}
 	fakeServer.StartServer(testAPI.constructStateEndpoints())
 	fakeBodyObject := map[string]interface{}{"data": "fakeData"}
@@ -3471,6 +4080,35 @@ func TestV1TransactionEndpoints(t *testing.T) {
 		assert.Equal(t, []byte{}, resp.RawBody, "Always give empty body with 204")
 	})
 
+	t.Run("Outbox events without outbox - 400 ERR_STATE_OUTBOX_NOT_CONFIGURED", func(t *testing.T) {
+		apiPath := fmt.Sprintf("v1.0/state/%s/transaction", storeName)
+		inputBodyBytes, err := json.Marshal(stateTransactionRequest{
+			Operations: []state.TransactionalStateOperation{
+				{
+					Operation: state.Upsert,
+					Request: map[string]interface{}{
+						"key":   "fakeKey1",
+						"value": fakeBodyObject,
+					},
+				},
+			},
+			OutboxEvents: []outboxEventEntry{
+				{
+					Data:        []byte(`{"id":"1"}`),
+					ContentType: "application/json",
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		// act
+		resp := fakeServer.DoRequest("POST", apiPath, inputBodyBytes, nil)
+
+		// assert
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.Equal(t, "ERR_STATE_OUTBOX_NOT_CONFIGURED", resp.ErrorBody["errorCode"])
+	})
+
 	t.Run("Post non-existent state store - 400 No State Store Found", func(t *testing.T) {
 		apiPath := fmt.Sprintf("v1.0/state/%s/transaction", "non-existent-store")
 		testTransactionalOperations := []state.TransactionalStateOperation{
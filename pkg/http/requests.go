@@ -26,3 +26,16 @@ type BulkGetRequest struct {
 	Keys        []string          `json:"keys"`
 	Parallelism int               `json:"parallelism"`
 }
+
+// TryLockRequest is the request object to acquire a lock from a lock store.
+type TryLockRequest struct {
+	ResourceID      string `json:"resourceId"`
+	LockOwner       string `json:"lockOwner"`
+	ExpiryInSeconds int32  `json:"expiryInSeconds"`
+}
+
+// UnlockRequest is the request object to release a lock held in a lock store.
+type UnlockRequest struct {
+	ResourceID string `json:"resourceId"`
+	LockOwner  string `json:"lockOwner"`
+}
@@ -15,6 +15,7 @@ package http
 
 import (
 	"encoding/json"
+	"io"
 
 	"github.com/valyala/fasthttp"
 )
@@ -49,6 +50,22 @@ type QueryItem struct {
 	Error string          `json:"error,omitempty"`
 }
 
+// TryLockResponse is the response object for acquiring a lock.
+type TryLockResponse struct {
+	Success bool `json:"success"`
+}
+
+// UnlockResponse is the response object for releasing a lock.
+// Status follows the values of the UnlockResponse.Status enum in the gRPC API.
+type UnlockResponse struct {
+	Status int32 `json:"status"`
+}
+
+// SubscribeConfigurationResponse is the response object for subscribing to configuration updates.
+type SubscribeConfigurationResponse struct {
+	ID string `json:"id"`
+}
+
 type option = func(ctx *fasthttp.RequestCtx)
 
 // withEtag sets etag header.
@@ -104,6 +121,18 @@ func with(code int, obj []byte) option {
 	}
 }
 
+// withStream sets the status code and a stream to read the body from, sent with chunked encoding.
+func withStream(code int, body io.ReadCloser) option {
+	return func(ctx *fasthttp.RequestCtx) {
+		ctx.Response.SetStatusCode(code)
+		ctx.Response.SetBodyStream(body, -1)
+
+		if len(ctx.Response.Header.ContentType()) == 0 {
+			ctx.Response.Header.SetContentType(jsonContentTypeHeader)
+		}
+	}
+}
+
 func respond(ctx *fasthttp.RequestCtx, options ...option) {
 	for _, option := range options {
 		option(ctx)
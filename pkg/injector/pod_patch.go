@@ -20,6 +20,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/admission/v1"
@@ -79,6 +80,11 @@ const (
 	daprVolumeMountsReadOnlyKey       = "dapr.io/volume-mounts"
 	daprVolumeMountsReadWriteKey      = "dapr.io/volume-mounts-rw"
 	daprDisableBuiltinK8sSecretStore  = "dapr.io/disable-builtin-k8s-secret-store"
+	daprEnableAppHealthCheck          = "dapr.io/enable-app-health-check"
+	daprAppHealthCheckPath            = "dapr.io/app-health-check-path"
+	daprAppHealthProbeInterval        = "dapr.io/app-health-probe-interval"
+	daprAppHealthProbeTimeout         = "dapr.io/app-health-probe-timeout"
+	daprAppHealthThreshold            = "dapr.io/app-health-threshold"
 	unixDomainSocketVolume            = "dapr-unix-domain-socket"
 	containersPath                    = "/spec/containers"
 	sidecarHTTPPort                   = 3500
@@ -119,6 +125,7 @@ const (
 	defaultDaprHTTPStreamRequestBody  = false
 	defaultAPILoggingEnabled          = false
 	defaultBuiltinSecretStoreDisabled = false
+	defaultAppHealthCheckEnabled      = false
 )
 
 func (i *injector) getPodPatchOperations(ar *v1.AdmissionReview,
@@ -462,6 +469,67 @@ func getDisableBuiltinK8sSecretStore(annotations map[string]string) bool {
 	return getBoolAnnotationOrDefault(annotations, daprDisableBuiltinK8sSecretStore, defaultBuiltinSecretStoreDisabled)
 }
 
+func appHealthCheckEnabled(annotations map[string]string) bool {
+	return getBoolAnnotationOrDefault(annotations, daprEnableAppHealthCheck, defaultAppHealthCheckEnabled)
+}
+
+// getAppHealthCheckArgs returns the daprd arguments configuring the app health checks.
+// The defaults of daprd are used for the settings that are not annotated or that are invalid.
+// The probe interval and timeout can be annotated as durations, such as "5s", which are converted
+// to the seconds and milliseconds expected by daprd.
+func getAppHealthCheckArgs(annotations map[string]string) []string {
+	if !appHealthCheckEnabled(annotations) {
+		return nil
+	}
+
+	args := []string{"--enable-app-health-check"}
+	if path := getStringAnnotation(annotations, daprAppHealthCheckPath); path != "" {
+		args = append(args, "--app-health-check-path", path)
+	}
+	if interval, err := getDurationAnnotation(annotations, daprAppHealthProbeInterval, time.Second); err != nil {
+		log.Warn(err)
+	} else if interval > 0 {
+		args = append(args, "--app-health-probe-interval", strconv.FormatInt(interval, 10))
+	}
+	if timeout, err := getDurationAnnotation(annotations, daprAppHealthProbeTimeout, time.Millisecond); err != nil {
+		log.Warn(err)
+	} else if timeout > 0 {
+		args = append(args, "--app-health-probe-timeout", strconv.FormatInt(timeout, 10))
+	}
+	if threshold, err := getInt32Annotation(annotations, daprAppHealthThreshold); err != nil {
+		log.Warn(err)
+	} else if threshold > 0 {
+		args = append(args, "--app-health-threshold", strconv.FormatInt(int64(threshold), 10))
+	} else if _, ok := annotations[daprAppHealthThreshold]; ok {
+		log.Warnf("invalid %s value %d, it must be positive", daprAppHealthThreshold, threshold)
+	}
+	return args
+}
+
+// getDurationAnnotation returns the value of the annotation as a number of units.
+// The annotation is either a number of units, or a duration such as "5s" which must be a positive multiple of the unit.
+// It returns -1 if the annotation is not given.
+func getDurationAnnotation(annotations map[string]string, key string, unit time.Duration) (int64, error) {
+	s, ok := annotations[key]
+	if !ok || s == "" {
+		return -1, nil
+	}
+	if value, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if value <= 0 {
+			return -1, errors.Errorf("invalid %s value %s, it must be positive", key, s)
+		}
+		return value, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return -1, errors.Wrapf(err, "error parsing %s duration value %s", key, s)
+	}
+	if d <= 0 || d%unit != 0 {
+		return -1, errors.Errorf("invalid %s value %s, it must be a positive multiple of %s", key, s, unit)
+	}
+	return int64(d / unit), nil
+}
+
 func getBoolAnnotationOrDefault(annotations map[string]string, key string, defaultValue bool) bool {
 	enabled, ok := annotations[key]
 	if !ok {
@@ -810,6 +878,8 @@ func getSidecarContainer(annotations map[string]string, id, daprSidecarImage, im
 		c.Args = append(c.Args, "--http-stream-request-body")
 	}
 
+	c.Args = append(c.Args, getAppHealthCheckArgs(annotations)...)
+
 	secret := getAPITokenSecret(annotations)
 	if secret != "" {
 		c.Env = append(c.Env, corev1.EnvVar{
@@ -51,6 +51,65 @@ func TestLogAsJSONEnabled(t *testing.T) {
 	})
 }
 
+func TestGetAppHealthCheckArgs(t *testing.T) {
+	t.Run("dapr.io/enable-app-health-check is not given", func(t *testing.T) {
+		assert.Nil(t, getAppHealthCheckArgs(map[string]string{
+			daprAppHealthCheckPath: "/health",
+		}))
+	})
+
+	t.Run("dapr.io/enable-app-health-check is true", func(t *testing.T) {
+		fakeAnnotation := map[string]string{
+			daprEnableAppHealthCheck: trueString,
+		}
+
+		assert.Equal(t, []string{"--enable-app-health-check"}, getAppHealthCheckArgs(fakeAnnotation))
+	})
+
+	t.Run("app health check settings are given", func(t *testing.T) {
+		fakeAnnotation := map[string]string{
+			daprEnableAppHealthCheck:   trueString,
+			daprAppHealthCheckPath:     "/health",
+			daprAppHealthProbeInterval: "10",
+			daprAppHealthProbeTimeout:  "200",
+			daprAppHealthThreshold:     "5",
+		}
+
+		assert.Equal(t, []string{
+			"--enable-app-health-check",
+			"--app-health-check-path", "/health",
+			"--app-health-probe-interval", "10",
+			"--app-health-probe-timeout", "200",
+			"--app-health-threshold", "5",
+		}, getAppHealthCheckArgs(fakeAnnotation))
+	})
+
+	t.Run("app health check durations are converted", func(t *testing.T) {
+		fakeAnnotation := map[string]string{
+			daprEnableAppHealthCheck:   trueString,
+			daprAppHealthProbeInterval: "1m",
+			daprAppHealthProbeTimeout:  "1.5s",
+		}
+
+		assert.Equal(t, []string{
+			"--enable-app-health-check",
+			"--app-health-probe-interval", "60",
+			"--app-health-probe-timeout", "1500",
+		}, getAppHealthCheckArgs(fakeAnnotation))
+	})
+
+	t.Run("invalid app health check settings are ignored", func(t *testing.T) {
+		fakeAnnotation := map[string]string{
+			daprEnableAppHealthCheck:   trueString,
+			daprAppHealthProbeInterval: "1500ms",
+			daprAppHealthProbeTimeout:  "abc",
+			daprAppHealthThreshold:     "0",
+		}
+
+		assert.Equal(t, []string{"--enable-app-health-check"}, getAppHealthCheckArgs(fakeAnnotation))
+	})
+}
+
 func TestFormatProbePath(t *testing.T) {
 	testCases := []struct {
 		given    []string
@@ -13,11 +13,14 @@ const (
 	ErrStateDelete              = "failed deleting state with key %s: %s"
 	ErrStateSave                = "failed saving state in state store %s: %s"
 	ErrStateQuery               = "failed query in state store %s: %s"
+	ErrStateReEncryption        = "failed to start the re-encryption of state store %s: %s"
 
 	// StateTransaction.
 	ErrStateStoreNotSupported     = "state store %s doesn't support transaction"
 	ErrNotSupportedStateOperation = "operation type %s not supported"
 	ErrStateTransaction           = "error while executing state transaction: %s"
+	ErrStateOutboxNotConfigured   = "state store %s doesn't have an outbox configured"
+	ErrStateOutboxEvents          = "failed saving outbox events in state store %s: %s"
 
 	// Binding.
 	ErrInvokeOutputBinding = "error when invoke output binding %s: %s"
@@ -31,6 +34,9 @@ const (
 	ErrPubsubPublishMessage     = "error when publish to topic %s in pubsub %s: %s"
 	ErrPubsubForbidden          = "topic %s is not allowed for app id %s"
 	ErrPubsubCloudEventCreation = "cannot create cloudevent: %s"
+	ErrPubsubBulkEntriesEmpty   = "entries are empty for bulk publish to topic %s in pubsub %s"
+	ErrPubsubBulkEntryID        = "entryId %q is empty or duplicated for bulk publish to topic %s in pubsub %s"
+	ErrPubsubBulkPublishMessage = "error when bulk publish to topic %s in pubsub %s: %s"
 
 	// AppChannel.
 	ErrChannelNotFound       = "app channel is not initialized"
@@ -45,6 +51,8 @@ const (
 	ErrActorReminderRename       = "error rename actor reminder: %s"
 	ErrActorReminderGet          = "error getting actor reminder: %s"
 	ErrActorReminderDelete       = "error deleting actor reminder: %s"
+	ErrActorRemindersList        = "error listing actor reminders: %s"
+	ErrActorRemindersDelete      = "error deleting actor reminders: %s"
 	ErrActorTimerCreate          = "error creating actor timer: %s"
 	ErrActorTimerDelete          = "error deleting actor timer: %s"
 	ErrActorStateGet             = "error getting actor state: %s"
@@ -70,10 +78,12 @@ const (
 	ErrHealthNotReady = "dapr is not ready"
 
 	// Configuration.
-	ErrConfigurationStoresNotConfigured = "error configuration stores not configured"
-	ErrConfigurationStoreNotFound       = "error configuration stores %s not found"
-	ErrConfigurationGet                 = "fail to get %s from Configuration store %s: %s"
-	ErrConfigurationSubscribe           = "fail to subscribe %s from Configuration store %s: %s"
+	ErrConfigurationStoresNotConfigured  = "error configuration stores not configured"
+	ErrConfigurationStoreNotFound        = "error configuration stores %s not found"
+	ErrConfigurationGet                  = "fail to get %s from Configuration store %s: %s"
+	ErrConfigurationSubscribe            = "fail to subscribe %s from Configuration store %s: %s"
+	ErrConfigurationUnsubscribe          = "fail to unsubscribe %s from Configuration store %s: %s"
+	ErrConfigurationSubscriptionNotFound = "subscription %s not found in Configuration store %s"
 
 	//	Lock
 	ErrLockStoresNotConfigured    = "lock store is not configured"
@@ -81,4 +91,6 @@ const (
 	ErrLockOwnerEmpty             = "LockOwner is empty in lock store %s"
 	ErrExpiryInSecondsNotPositive = "ExpiryInSeconds is not positive in lock store %s"
 	ErrLockStoreNotFound          = "lock store %s not found"
+	ErrTryLockFailed              = "failed to try acquiring lock in lock store %s: %s"
+	ErrUnlockFailed               = "failed to release lock in lock store %s: %s"
 )
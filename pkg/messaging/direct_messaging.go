@@ -15,6 +15,7 @@ package messaging
 
 import (
 	"context"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -67,8 +68,10 @@ type directMessaging struct {
 	maxRequestBodySize  int
 	proxy               Proxy
 	readBufferSize      int
+	streamRequestBody   bool
 	resiliency          resiliency.Provider
 	isResiliencyEnabled bool
+	httpEndpoints       map[string]channel.AppChannel
 }
 
 type remoteApp struct {
@@ -91,6 +94,7 @@ func NewDirectMessaging(
 	streamRequestBody bool,
 	resiliency resiliency.Provider,
 	isResiliencyEnabled bool,
+	httpEndpoints map[string]channel.AppChannel,
 ) DirectMessaging {
 	hAddr, _ := utils.GetHostAddress()
 	hName, _ := os.Hostname()
@@ -109,8 +113,10 @@ func NewDirectMessaging(
 		maxRequestBodySize:  maxRequestBodySize,
 		proxy:               proxy,
 		readBufferSize:      readBufferSize,
+		streamRequestBody:   streamRequestBody,
 		resiliency:          resiliency,
 		isResiliencyEnabled: isResiliencyEnabled,
+		httpEndpoints:       httpEndpoints,
 	}
 
 	if proxy != nil {
@@ -122,7 +128,13 @@ func NewDirectMessaging(
 }
 
 // Invoke takes a message requests and invokes an app, either local or remote.
+// HTTP endpoints share the address space of the app IDs: an endpoint shadows the app in the namespace of this app
+// which has the same ID, which can still be invoked with its ID qualified with the namespace.
 func (d *directMessaging) Invoke(ctx context.Context, targetAppID string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	if endpoint, ok := d.httpEndpoints[targetAppID]; ok {
+		return d.invokeHTTPEndpoint(ctx, targetAppID, endpoint, req)
+	}
+
 	app, err := d.getRemoteApp(targetAppID)
 	if err != nil {
 		return nil, err
@@ -131,6 +143,13 @@ func (d *directMessaging) Invoke(ctx context.Context, targetAppID string, req *i
 	if app.id == d.appID && app.namespace == d.namespace {
 		return d.invokeLocal(ctx, req)
 	}
+	if d.streamRequestBody {
+		if req.HasStream() {
+			// A streamed request body can be read only once, so the call can't be retried.
+			return d.invokeRemoteStream(ctx, app.id, app.namespace, app.address, req)
+		}
+		return d.invokeWithRetry(ctx, retry.DefaultLinearRetryCount, retry.DefaultLinearBackoffInterval, app, d.invokeRemoteStream, req)
+	}
 	return d.invokeWithRetry(ctx, retry.DefaultLinearRetryCount, retry.DefaultLinearBackoffInterval, app, d.invokeRemote, req)
 }
 
@@ -229,6 +248,37 @@ func (d *directMessaging) invokeLocal(ctx context.Context, req *invokev1.InvokeM
 	return d.appChannel.InvokeMethod(ctx, req)
 }
 
+// invokeHTTPEndpoint invokes an external, non-Dapr HTTP endpoint.
+// The forwarded headers are not added, so that the addresses of the hosts aren't disclosed outside of the cluster.
+// invokeHTTPEndpoint invokes an external HTTP endpoint, with the tracing and the client metrics of the calls to apps.
+func (d *directMessaging) invokeHTTPEndpoint(ctx context.Context, name string, endpoint channel.AppChannel, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	ctx = d.setContextSpan(ctx)
+
+	// Streamed data is not counted, as it is not read yet.
+	method := "HTTPEndpoint/" + name
+	var reqSize int
+	if !req.HasStream() {
+		_, data := req.RawData()
+		reqSize = len(data)
+	}
+	start := diag.DefaultGRPCMonitoring.ClientRequestSent(ctx, method, int64(reqSize))
+
+	resp, err := endpoint.InvokeMethod(ctx, req)
+
+	code := status.Code(err)
+	var respSize int
+	if err == nil {
+		code = invokev1.CodeFromHTTPStatus(int(resp.Status().Code))
+		if !resp.HasStream() {
+			_, data := resp.RawData()
+			respSize = len(data)
+		}
+	}
+	diag.DefaultGRPCMonitoring.ClientRequestReceived(ctx, method, code.String(), int64(respSize), start)
+
+	return resp, err
+}
+
 func (d *directMessaging) setContextSpan(ctx context.Context) context.Context {
 	span := diag_utils.SpanFromContext(ctx)
 	ctx = diag.SpanContextToGRPCMetadata(ctx, span.SpanContext())
@@ -248,11 +298,19 @@ func (d *directMessaging) invokeRemote(ctx context.Context, appID, namespace, ap
 	d.addForwardedHeadersToMetadata(req)
 	d.addDestinationAppIDHeaderToMetadata(appID, req)
 
+	return d.callLocal(ctx, conn, req)
+}
+
+func (d *directMessaging) callLocal(ctx context.Context, conn *grpc.ClientConn, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
 	clientV1 := internalv1pb.NewServiceInvocationClient(conn)
 
 	var opts []grpc.CallOption
 	opts = append(opts, grpc.MaxCallRecvMsgSize(d.maxRequestBodySize*1024*1024), grpc.MaxCallSendMsgSize(d.maxRequestBodySize*1024*1024))
 
+	if err := req.BufferStream(); err != nil {
+		return nil, err
+	}
+
 	resp, err := clientV1.CallLocal(ctx, req.Proto(), opts...)
 	if err != nil {
 		return nil, err
@@ -261,6 +319,72 @@ func (d *directMessaging) invokeRemote(ctx context.Context, appID, namespace, ap
 	return invokev1.InternalInvokeResponse(resp)
 }
 
+// invokeRemoteStream invokes the remote app with CallLocalStream: request and response data
+// are streamed in chunks, so that the memory used by the call is bounded regardless of the payload size.
+// The returned response must be closed to release the underlying stream.
+func (d *directMessaging) invokeRemoteStream(ctx context.Context, appID, namespace, appAddress string, req *invokev1.InvokeMethodRequest) (*invokev1.InvokeMethodResponse, error) {
+	conn, teardown, err := d.connectionCreatorFn(context.TODO(), appAddress, appID, namespace, false, false, false)
+	if err != nil {
+		teardown()
+		return nil, err
+	}
+
+	ctx = d.setContextSpan(ctx)
+
+	d.addForwardedHeadersToMetadata(req)
+	d.addDestinationAppIDHeaderToMetadata(appID, req)
+
+	clientV1 := internalv1pb.NewServiceInvocationClient(conn)
+
+	// The response data is read after the call returned: the stream is canceled once it is closed.
+	streamCtx, received, cancel := invokev1.StreamContext(ctx)
+	stream, err := clientV1.CallLocalStream(streamCtx)
+	if err != nil {
+		cancel()
+		teardown()
+		return nil, err
+	}
+
+	// The request is sent while the response is received, so that neither is buffered.
+	isStream := req.HasStream()
+	sendDone := make(chan struct{})
+	go func() {
+		defer close(sendDone)
+		if sendErr := req.SendStream(stream.Send); sendErr != nil {
+			// io.EOF means that the callee ended the call: the error is returned by Recv.
+			if sendErr != io.EOF {
+				log.Debugf("error sending request data to app %s: %s", appID, sendErr)
+				cancel()
+			}
+			return
+		}
+		stream.CloseSend()
+	}()
+
+	release := func() {
+		cancel()
+		// Wait for the request data to stop being read before releasing the call.
+		<-sendDone
+		teardown()
+	}
+
+	resp, err := invokev1.InternalInvokeResponseFromStream(stream.Recv, release)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented && !isStream {
+			// The target runtime doesn't support streaming: fall back to a unary call.
+			cancel()
+			<-sendDone
+			defer teardown()
+			return d.callLocal(ctx, conn, req)
+		}
+		release()
+		return nil, err
+	}
+	received()
+
+	return resp, nil
+}
+
 func (d *directMessaging) addDestinationAppIDHeaderToMetadata(appID string, req *invokev1.InvokeMethodRequest) {
 	req.Metadata()[invokev1.DestinationIDHeader] = &internalv1pb.ListStringValue{
 		Values: []string{appID},
@@ -14,11 +14,15 @@ limitations under the License.
 package messaging
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/valyala/fasthttp"
 
+	"github.com/dapr/dapr/pkg/channel"
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
 )
 
@@ -120,3 +124,25 @@ func TestKubernetesNamespace(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestInvokeHTTPEndpoint(t *testing.T) {
+	endpoint := new(channelt.MockAppChannel)
+	fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+	endpoint.On("InvokeMethod", mock.Anything, mock.AnythingOfType("*v1.InvokeMethodRequest")).Return(fakeResp, nil)
+
+	dm := newDirectMessaging()
+	dm.hostAddress = "1"
+	dm.hostName = "2"
+	dm.httpEndpoints = map[string]channel.AppChannel{
+		"external": endpoint,
+	}
+
+	req := invokev1.NewInvokeMethodRequest("method")
+	req.WithMetadata(map[string][]string{})
+
+	resp, err := dm.Invoke(context.Background(), "external", req)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(200), resp.Status().Code)
+	endpoint.AssertNumberOfCalls(t, "InvokeMethod", 1)
+	assert.Nil(t, req.Metadata()[fasthttp.HeaderXForwardedFor])
+}
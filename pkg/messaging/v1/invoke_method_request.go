@@ -14,7 +14,9 @@ limitations under the License.
 package v1
 
 import (
+	"bytes"
 	"errors"
+	"io"
 	"strings"
 
 	"github.com/valyala/fasthttp"
@@ -33,7 +35,9 @@ const (
 // InvokeMethodRequest holds InternalInvokeRequest protobuf message
 // and provides the helpers to manage it.
 type InvokeMethodRequest struct {
-	r *internalv1pb.InternalInvokeRequest
+	r          *internalv1pb.InternalInvokeRequest
+	dataStream io.Reader
+	streamErr  error
 }
 
 // NewInvokeMethodRequest creates InvokeMethodRequest object for method.
@@ -98,9 +102,67 @@ func (imr *InvokeMethodRequest) WithRawData(data []byte, contentType string) *In
 	}
 	imr.r.Message.ContentType = contentType
 	imr.r.Message.Data = &anypb.Any{Value: data}
+	imr.dataStream = nil
+	imr.streamErr = nil
 	return imr
 }
 
+// WithRawDataStream sets message content_type and a stream to read the data from.
+// The stream is read only once, when the request is sent.
+func (imr *InvokeMethodRequest) WithRawDataStream(data io.Reader, contentType string) *InvokeMethodRequest {
+	// TODO: Remove the entire block once feature is finalized
+	if contentType == "" && !config.GetNoDefaultContentType() {
+		contentType = JSONContentType
+	}
+	imr.r.Message.ContentType = contentType
+	imr.r.Message.Data = nil
+	imr.dataStream = data
+	imr.streamErr = nil
+	return imr
+}
+
+// HasStream returns true if the data of the request is a stream which has not been read yet.
+func (imr *InvokeMethodRequest) HasStream() bool {
+	return imr.dataStream != nil
+}
+
+// RawDataStream returns content_type and a reader for the body.
+// If the data is a stream, it can be read only once.
+func (imr *InvokeMethodRequest) RawDataStream() (string, io.Reader) {
+	if imr.dataStream == nil {
+		contentType, data := imr.RawData()
+		return contentType, bytes.NewReader(data)
+	}
+
+	contentType := imr.r.Message.GetContentType()
+	data := imr.dataStream
+	imr.dataStream = nil
+	return contentType, data
+}
+
+// BufferStream reads the whole data stream, if any, into the message,
+// so that the request can be sent as a single message.
+// If the stream can't be read, the request has no data and the error is returned by all the following calls.
+func (imr *InvokeMethodRequest) BufferStream() error {
+	if imr.dataStream == nil {
+		return imr.streamErr
+	}
+
+	data, err := io.ReadAll(imr.dataStream)
+	imr.dataStream = nil
+	if err != nil {
+		// Truncated data must not be mistaken for the whole request.
+		imr.streamErr = err
+		imr.r.Message.Data = nil
+		return err
+	}
+	if imr.r.Message.Data == nil {
+		imr.r.Message.Data = &anypb.Any{}
+	}
+	imr.r.Message.Data.Value = data
+	return nil
+}
+
 // WithHTTPExtension sets new HTTP extension with verb and querystring.
 func (imr *InvokeMethodRequest) WithHTTPExtension(verb string, querystring string) *InvokeMethodRequest {
 	httpMethod, ok := commonv1pb.HTTPExtension_Verb_value[strings.ToUpper(verb)]
@@ -152,7 +214,10 @@ func (imr *InvokeMethodRequest) Metadata() DaprInternalMetadata {
 }
 
 // Proto returns InternalInvokeRequest Proto object.
+// A data stream is read into the message first: callers of requests which may have a stream
+// must call BufferStream before and handle its error, as the message has no data if it failed.
 func (imr *InvokeMethodRequest) Proto() *internalv1pb.InternalInvokeRequest {
+	imr.BufferStream()
 	return imr.r
 }
 
@@ -167,7 +232,11 @@ func (imr *InvokeMethodRequest) Message() *commonv1pb.InvokeRequest {
 }
 
 // RawData returns content_type and byte array body.
+// A data stream is read into the message first: callers of requests which may have a stream
+// must call BufferStream before and handle its error, as the message has no data if it failed.
 func (imr *InvokeMethodRequest) RawData() (string, []byte) {
+	imr.BufferStream()
+
 	m := imr.r.Message
 	if m == nil || m.Data == nil {
 		return "", nil
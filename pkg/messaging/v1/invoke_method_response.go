@@ -14,6 +14,10 @@ limitations under the License.
 package v1
 
 import (
+	"bytes"
+	"io"
+	"net/http"
+
 	"github.com/valyala/fasthttp"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -26,7 +30,9 @@ import (
 // InvokeMethodResponse holds InternalInvokeResponse protobuf message
 // and provides the helpers to manage it.
 type InvokeMethodResponse struct {
-	r *internalv1pb.InternalInvokeResponse
+	r          *internalv1pb.InternalInvokeResponse
+	dataStream io.ReadCloser
+	streamErr  error
 }
 
 // NewInvokeMethodResponse returns new InvokeMethodResponse object with status.
@@ -66,10 +72,81 @@ func (imr *InvokeMethodResponse) WithRawData(data []byte, contentType string) *I
 
 	// Clone data to prevent GC from deallocating data
 	imr.r.Message.Data = &anypb.Any{Value: cloneBytes(data)}
+	imr.dataStream = nil
+	imr.streamErr = nil
 
 	return imr
 }
 
+// WithRawDataStream sets Message content type and a stream to read the data from.
+// The stream is closed once read, or by Close.
+func (imr *InvokeMethodResponse) WithRawDataStream(data io.ReadCloser, contentType string) *InvokeMethodResponse {
+	// TODO: Remove the entire block once feature is finalized
+	if contentType == "" && !config.GetNoDefaultContentType() {
+		contentType = JSONContentType
+	}
+
+	imr.r.Message.ContentType = contentType
+	imr.r.Message.Data = nil
+	imr.dataStream = data
+	imr.streamErr = nil
+
+	return imr
+}
+
+// HasStream returns true if the data of the response is a stream which has not been read yet.
+func (imr *InvokeMethodResponse) HasStream() bool {
+	return imr.dataStream != nil
+}
+
+// RawDataStream returns content_type and a reader for the body, which the caller must close.
+// If the data is a stream, it can be read only once.
+func (imr *InvokeMethodResponse) RawDataStream() (string, io.ReadCloser) {
+	if imr.dataStream == nil {
+		contentType, data := imr.RawData()
+		return contentType, io.NopCloser(bytes.NewReader(data))
+	}
+
+	contentType := imr.r.Message.GetContentType()
+	data := imr.dataStream
+	imr.dataStream = nil
+	return contentType, data
+}
+
+// BufferStream reads the whole data stream, if any, into the message.
+// If the stream can't be read, the response has no data and the error is returned by all the following calls.
+func (imr *InvokeMethodResponse) BufferStream() error {
+	if imr.dataStream == nil {
+		return imr.streamErr
+	}
+
+	data, err := io.ReadAll(imr.dataStream)
+	imr.dataStream.Close()
+	imr.dataStream = nil
+	if err != nil {
+		// Truncated data must not be mistaken for the whole response.
+		imr.streamErr = err
+		imr.r.Message.Data = nil
+		return err
+	}
+	if imr.r.Message.Data == nil {
+		imr.r.Message.Data = &anypb.Any{}
+	}
+	imr.r.Message.Data.Value = data
+	return nil
+}
+
+// Close releases the data stream of the response, if it has not been read.
+func (imr *InvokeMethodResponse) Close() error {
+	if imr.dataStream == nil {
+		return nil
+	}
+
+	err := imr.dataStream.Close()
+	imr.dataStream = nil
+	return err
+}
+
 // WithHeaders sets gRPC response header metadata.
 func (imr *InvokeMethodResponse) WithHeaders(headers metadata.MD) *InvokeMethodResponse {
 	imr.r.Headers = MetadataToInternalMetadata(headers)
@@ -90,6 +167,20 @@ func (imr *InvokeMethodResponse) WithFastHTTPHeaders(header *fasthttp.ResponseHe
 	return imr
 }
 
+// WithHTTPHeaders populates net/http response header to gRPC header metadata.
+func (imr *InvokeMethodResponse) WithHTTPHeaders(header http.Header) *InvokeMethodResponse {
+	md := DaprInternalMetadata{}
+	for key, values := range header {
+		md[key] = &internalv1pb.ListStringValue{
+			Values: values,
+		}
+	}
+	if len(md) > 0 {
+		imr.r.Headers = md
+	}
+	return imr
+}
+
 // WithTrailers sets Trailer in internal InvokeMethodResponse.
 func (imr *InvokeMethodResponse) WithTrailers(trailer metadata.MD) *InvokeMethodResponse {
 	imr.r.Trailers = MetadataToInternalMetadata(trailer)
@@ -109,7 +200,10 @@ func (imr *InvokeMethodResponse) IsHTTPResponse() bool {
 }
 
 // Proto clones the internal InvokeMethodResponse pb object.
+// A data stream is read into the message first: callers of responses which may have a stream
+// must call BufferStream before and handle its error, as the message has no data if it failed.
 func (imr *InvokeMethodResponse) Proto() *internalv1pb.InternalInvokeResponse {
+	imr.BufferStream()
 	return imr.r
 }
 
@@ -124,12 +218,19 @@ func (imr *InvokeMethodResponse) Trailers() DaprInternalMetadata {
 }
 
 // Message returns message field in InvokeMethodResponse.
+// A data stream is read into the message first: callers of responses which may have a stream
+// must call BufferStream before and handle its error, as the message has no data if it failed.
 func (imr *InvokeMethodResponse) Message() *commonv1pb.InvokeResponse {
+	imr.BufferStream()
 	return imr.r.Message
 }
 
 // RawData returns content_type and byte array body.
+// A data stream is read into the message first: callers of responses which may have a stream
+// must call BufferStream before and handle its error, as the message has no data if it failed.
 func (imr *InvokeMethodResponse) RawData() (string, []byte) {
+	imr.BufferStream()
+
 	m := imr.r.Message
 	if m == nil || m.GetData() == nil {
 		return "", nil
@@ -0,0 +1,279 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	commonv1pb "github.com/dapr/dapr/pkg/proto/common/v1"
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+// StreamChunkSize is the maximum size of the data sent in a single message of a streamed invocation.
+const StreamChunkSize = 64 << 10
+
+var streamChunkPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, StreamChunkSize)
+		return &b
+	},
+}
+
+// SendStream sends the request with send: the first message holds the request
+// without its data, which is then sent in chunks of at most StreamChunkSize.
+func (imr *InvokeMethodRequest) SendStream(send func(*internalv1pb.InternalInvokeRequestStream) error) error {
+	contentType, data := imr.RawDataStream()
+
+	m := imr.r.Message
+	header := &internalv1pb.InternalInvokeRequest{
+		Ver:      imr.r.Ver,
+		Metadata: imr.r.Metadata,
+		Actor:    imr.r.Actor,
+		Message: &commonv1pb.InvokeRequest{
+			Method:        m.GetMethod(),
+			ContentType:   contentType,
+			HttpExtension: m.GetHttpExtension(),
+		},
+	}
+	if typeURL := m.GetData().GetTypeUrl(); typeURL != "" {
+		header.Message.Data = &anypb.Any{TypeUrl: typeURL}
+	}
+
+	if err := send(&internalv1pb.InternalInvokeRequestStream{Request: header}); err != nil {
+		return err
+	}
+
+	return sendStreamChunks(data, func(payload *internalv1pb.StreamPayload) error {
+		return send(&internalv1pb.InternalInvokeRequestStream{Payload: payload})
+	})
+}
+
+// SendStream sends the response with send: the first message holds the response
+// without its data, which is then sent in chunks of at most StreamChunkSize.
+func (imr *InvokeMethodResponse) SendStream(send func(*internalv1pb.InternalInvokeResponseStream) error) error {
+	contentType, data := imr.RawDataStream()
+	defer data.Close()
+
+	header := &internalv1pb.InternalInvokeResponse{
+		Status:   imr.r.Status,
+		Headers:  imr.r.Headers,
+		Trailers: imr.r.Trailers,
+		Message: &commonv1pb.InvokeResponse{
+			ContentType: contentType,
+		},
+	}
+	if typeURL := imr.r.Message.GetData().GetTypeUrl(); typeURL != "" {
+		header.Message.Data = &anypb.Any{TypeUrl: typeURL}
+	}
+
+	if err := send(&internalv1pb.InternalInvokeResponseStream{Response: header}); err != nil {
+		return err
+	}
+
+	return sendStreamChunks(data, func(payload *internalv1pb.StreamPayload) error {
+		return send(&internalv1pb.InternalInvokeResponseStream{Payload: payload})
+	})
+}
+
+// InternalInvokeRequestFromStream creates InvokeMethodRequest object from the first message
+// received with recv. The data of the request is a stream of the following messages.
+func InternalInvokeRequestFromStream(recv func() (*internalv1pb.InternalInvokeRequestStream, error)) (*InvokeMethodRequest, error) {
+	first, err := recv()
+	if err != nil {
+		return nil, err
+	}
+	if first.GetRequest() == nil {
+		return nil, errors.New("Request field is nil")
+	}
+
+	req, err := InternalInvokeRequest(first.GetRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	pending := first.GetPayload()
+	req.dataStream = &streamPayloadReader{
+		recv: func() (*internalv1pb.StreamPayload, error) {
+			if pending != nil {
+				payload := pending
+				pending = nil
+				return payload, nil
+			}
+			msg, err := recv()
+			if err != nil {
+				return nil, err
+			}
+			return msg.GetPayload(), nil
+		},
+	}
+	return req, nil
+}
+
+// InternalInvokeResponseFromStream creates InvokeMethodResponse object from the first message
+// received with recv. The data of the response is a stream of the following messages.
+// onClose is invoked when the data stream is closed.
+func InternalInvokeResponseFromStream(recv func() (*internalv1pb.InternalInvokeResponseStream, error), onClose func()) (*InvokeMethodResponse, error) {
+	first, err := recv()
+	if err != nil {
+		return nil, err
+	}
+	if first.GetResponse() == nil {
+		return nil, errors.New("Response field is nil")
+	}
+
+	resp, err := InternalInvokeResponse(first.GetResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	pending := first.GetPayload()
+	resp.dataStream = &streamPayloadReader{
+		recv: func() (*internalv1pb.StreamPayload, error) {
+			if pending != nil {
+				payload := pending
+				pending = nil
+				return payload, nil
+			}
+			msg, err := recv()
+			if err != nil {
+				return nil, err
+			}
+			return msg.GetPayload(), nil
+		},
+		onClose: onClose,
+	}
+	return resp, nil
+}
+
+// StreamContext returns the context of a call whose response data is streamed after the call returned.
+// The returned context is canceled with ctx until received is invoked once the response has been received;
+// afterwards it is canceled only by cancel, which must be invoked when the response data stream is closed.
+func StreamContext(ctx context.Context) (streamCtx context.Context, received func(), cancel context.CancelFunc) {
+	streamCtx, cancel = context.WithCancel(detachedContext{parent: ctx})
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	received = func() {
+		stopOnce.Do(func() { close(stop) })
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-stop:
+		case <-streamCtx.Done():
+		}
+	}()
+	return streamCtx, received, cancel
+}
+
+// detachedContext holds the values of its parent context, but not its deadline and cancellation.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
+
+// sendStreamChunks reads data and sends it in chunks of at most StreamChunkSize.
+func sendStreamChunks(data io.Reader, send func(*internalv1pb.StreamPayload) error) error {
+	bufp := streamChunkPool.Get().(*[]byte)
+	defer streamChunkPool.Put(bufp)
+	buf := *bufp
+
+	var seq uint64
+	for {
+		n, err := data.Read(buf)
+		if n > 0 {
+			// The message is serialized by send, so the buffer can be reused afterwards.
+			if sendErr := send(&internalv1pb.StreamPayload{Data: buf[:n], Seq: seq}); sendErr != nil {
+				return sendErr
+			}
+			seq++
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// streamPayloadReader is an io.ReadCloser over the chunks of data received in a stream.
+// Only one chunk is held in memory at any time.
+type streamPayloadReader struct {
+	recv    func() (*internalv1pb.StreamPayload, error)
+	onClose func()
+
+	buf       []byte
+	seq       uint64
+	err       error
+	closeOnce sync.Once
+}
+
+func (r *streamPayloadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		payload, err := r.recv()
+		if err != nil {
+			r.err = err
+			continue
+		}
+		if payload == nil {
+			continue
+		}
+		if payload.Seq != r.seq {
+			r.err = fmt.Errorf("invalid sequence number in stream: expected %d, got %d", r.seq, payload.Seq)
+			continue
+		}
+		r.seq++
+		r.buf = payload.Data
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *streamPayloadReader) Close() error {
+	r.closeOnce.Do(func() {
+		if r.onClose != nil {
+			r.onClose()
+		}
+	})
+	return nil
+}
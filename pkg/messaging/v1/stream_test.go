@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	internalv1pb "github.com/dapr/dapr/pkg/proto/internals/v1"
+)
+
+func TestRequestStream(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), StreamChunkSize/4)
+
+	req := NewInvokeMethodRequest("method").WithHTTPExtension("POST", "a=b")
+	req.WithRawDataStream(bytes.NewReader(data), "application/octet-stream")
+	req.WithCustomHTTPMetadata(map[string]string{"header": "value"})
+
+	var msgs []*internalv1pb.InternalInvokeRequestStream
+	err := req.SendStream(func(msg *internalv1pb.InternalInvokeRequestStream) error {
+		// Messages are serialized when sent, so the chunks must be copied.
+		msgs = append(msgs, proto.Clone(msg).(*internalv1pb.InternalInvokeRequestStream))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Greater(t, len(msgs), 2)
+	assert.NotNil(t, msgs[0].Request)
+	assert.Nil(t, msgs[0].Request.Message.Data)
+	for _, msg := range msgs[1:] {
+		assert.Nil(t, msg.Request)
+		assert.LessOrEqual(t, len(msg.Payload.Data), StreamChunkSize)
+	}
+
+	i := 0
+	received, err := InternalInvokeRequestFromStream(func() (*internalv1pb.InternalInvokeRequestStream, error) {
+		if i == len(msgs) {
+			return nil, io.EOF
+		}
+		i++
+		return msgs[i-1], nil
+	})
+	require.NoError(t, err)
+	assert.True(t, received.HasStream())
+	assert.Equal(t, "method", received.Message().Method)
+	assert.Equal(t, "a=b", received.EncodeHTTPQueryString())
+	assert.Equal(t, "value", received.Metadata()["header"].Values[0])
+
+	contentType, body := received.RawData()
+	assert.Equal(t, "application/octet-stream", contentType)
+	assert.Equal(t, data, body)
+}
+
+func TestResponseStream(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), StreamChunkSize/4)
+
+	resp := NewInvokeMethodResponse(200, "OK", nil)
+	resp.WithRawDataStream(io.NopCloser(bytes.NewReader(data)), "application/octet-stream")
+
+	var msgs []*internalv1pb.InternalInvokeResponseStream
+	err := resp.SendStream(func(msg *internalv1pb.InternalInvokeResponseStream) error {
+		msgs = append(msgs, proto.Clone(msg).(*internalv1pb.InternalInvokeResponseStream))
+		return nil
+	})
+	require.NoError(t, err)
+
+	closed := false
+	i := 0
+	received, err := InternalInvokeResponseFromStream(func() (*internalv1pb.InternalInvokeResponseStream, error) {
+		if i == len(msgs) {
+			return nil, io.EOF
+		}
+		i++
+		return msgs[i-1], nil
+	}, func() {
+		closed = true
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(200), received.Status().Code)
+
+	contentType, body := received.RawDataStream()
+	assert.Equal(t, "application/octet-stream", contentType)
+	b, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, data, b)
+
+	body.Close()
+	assert.True(t, closed)
+}
+
+func TestStreamInvalidSequence(t *testing.T) {
+	msgs := []*internalv1pb.InternalInvokeResponseStream{
+		{Response: NewInvokeMethodResponse(200, "OK", nil).Proto()},
+		{Payload: &internalv1pb.StreamPayload{Data: []byte("a"), Seq: 0}},
+		{Payload: &internalv1pb.StreamPayload{Data: []byte("b"), Seq: 2}},
+	}
+
+	i := 0
+	received, err := InternalInvokeResponseFromStream(func() (*internalv1pb.InternalInvokeResponseStream, error) {
+		if i == len(msgs) {
+			return nil, io.EOF
+		}
+		i++
+		return msgs[i-1], nil
+	}, nil)
+	require.NoError(t, err)
+
+	_, body := received.RawDataStream()
+	_, err = io.ReadAll(body)
+	assert.Error(t, err)
+}
+
+func TestBufferStreamError(t *testing.T) {
+	msgs := []*internalv1pb.InternalInvokeResponseStream{
+		{Response: NewInvokeMethodResponse(200, "OK", nil).Proto()},
+		{Payload: &internalv1pb.StreamPayload{Data: []byte("a"), Seq: 0}},
+	}
+
+	i := 0
+	received, err := InternalInvokeResponseFromStream(func() (*internalv1pb.InternalInvokeResponseStream, error) {
+		if i == len(msgs) {
+			return nil, errors.New("connection reset")
+		}
+		i++
+		return msgs[i-1], nil
+	}, nil)
+	require.NoError(t, err)
+
+	assert.Error(t, received.BufferStream())
+	// The error is kept, and the truncated data is not returned.
+	assert.Error(t, received.BufferStream())
+	_, body := received.RawData()
+	assert.Empty(t, body)
+}
+
+func TestStreamContext(t *testing.T) {
+	type key struct{}
+
+	t.Run("canceled with the call until the response is received", func(t *testing.T) {
+		ctx, cancelCall := context.WithCancel(context.WithValue(context.Background(), key{}, "value"))
+		streamCtx, _, cancel := StreamContext(ctx)
+		defer cancel()
+
+		assert.Equal(t, "value", streamCtx.Value(key{}))
+		cancelCall()
+		select {
+		case <-streamCtx.Done():
+		case <-time.After(time.Second):
+			t.Fatal("stream context was not canceled")
+		}
+	})
+
+	t.Run("outlives the call once the response is received", func(t *testing.T) {
+		ctx, cancelCall := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancelCall()
+		streamCtx, received, cancel := StreamContext(ctx)
+		received()
+
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		assert.NoError(t, streamCtx.Err())
+		_, hasDeadline := streamCtx.Deadline()
+		assert.False(t, hasDeadline)
+
+		cancel()
+		assert.ErrorIs(t, streamCtx.Err(), context.Canceled)
+	})
+}
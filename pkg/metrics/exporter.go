@@ -3,12 +3,18 @@ package metrics
 import (
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
 
 	ocprom "contrib.go.opencensus.io/exporter/prometheus"
 	"github.com/pkg/errors"
 	prom "github.com/prometheus/client_golang/prometheus"
+	"go.opencensus.io/metric/metricexport"
 
 	"github.com/dapr/kit/logger"
+
+	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
 )
 
 const (
@@ -21,8 +27,13 @@ const (
 type Exporter interface {
 	// Init initializes metrics exporter
 	Init() error
+	// InitOTLP starts pushing the metrics to the OTLP endpoint of the options, if one is set.
+	// It is a no-op if the OTLP exporter is already started.
+	InitOTLP() error
 	// Options returns Exporter options
 	Options() *Options
+	// Close stops the OTLP exporter, after pushing the metrics a last time.
+	Close() error
 }
 
 // NewExporter creates new MetricsExporter instance.
@@ -43,6 +54,10 @@ type exporter struct {
 	namespace string
 	options   *Options
 	logger    logger.Logger
+
+	otlpReader   *metricexport.IntervalReader
+	otlpExporter *diag_utils.OTLPMetricsExporter
+	otlpLock     sync.Mutex
 }
 
 // Options returns current metric exporter options.
@@ -50,6 +65,64 @@ func (m *exporter) Options() *Options {
 	return m.options
 }
 
+// InitOTLP starts the OTLP exporter, which periodically pushes the metrics of all the registered views.
+func (m *exporter) InitOTLP() error {
+	m.otlpLock.Lock()
+	defer m.otlpLock.Unlock()
+
+	if m.otlpReader != nil || m.options.OTLP.Endpoint == "" {
+		return nil
+	}
+
+	serviceName := m.options.OTLP.ServiceName
+	if serviceName == "" {
+		serviceName = filepath.Base(os.Args[0])
+	}
+	e, err := diag_utils.NewOTLPMetricsExporter(diag_utils.OTLPMetricsExporterOptions{
+		ServiceName: serviceName,
+		Namespace:   m.namespace,
+		Endpoint:    m.options.OTLP.Endpoint,
+		Protocol:    m.options.OTLP.Protocol,
+		Insecure:    m.options.OTLP.Insecure,
+		Headers:     diag_utils.ParseOTLPHeaders(m.options.OTLP.Headers),
+	})
+	if err != nil {
+		return errors.Errorf("failed to create OTLP exporter: %v", err)
+	}
+	r, err := metricexport.NewIntervalReader(metricexport.NewReader(), e)
+	if err != nil {
+		return errors.Errorf("failed to create OTLP exporter: %v", err)
+	}
+	r.ReportingInterval = m.options.OTLP.OTLPInterval()
+	if err = r.Start(); err != nil {
+		e.Close()
+		return errors.Errorf("failed to start OTLP exporter: %v", err)
+	}
+	m.otlpReader = r
+	m.otlpExporter = e
+
+	m.logger.Infof("metrics are pushed to OTLP collector %s every %v", m.options.OTLP.Endpoint, r.ReportingInterval)
+	return nil
+}
+
+// Close stops the OTLP exporter and pushes the metrics recorded since the last push.
+// It is a no-op if the OTLP exporter is not started.
+func (m *exporter) Close() error {
+	m.otlpLock.Lock()
+	defer m.otlpLock.Unlock()
+
+	if m.otlpReader == nil {
+		return nil
+	}
+
+	m.otlpReader.Stop()
+	m.otlpReader.Flush()
+	err := m.otlpExporter.Close()
+	m.otlpReader = nil
+	m.otlpExporter = nil
+	return err
+}
+
 // promMetricsExporter is prometheus metric exporter.
 type promMetricsExporter struct {
 	*exporter
@@ -57,11 +130,24 @@ type promMetricsExporter struct {
 }
 
 // Init initializes opencensus exporter.
+// The Prometheus endpoint is started first, so that a failure of the OTLP exporter doesn't prevent it from serving the metrics.
 func (m *promMetricsExporter) Init() error {
-	if !m.exporter.Options().MetricsEnabled {
-		return nil
+	var promErr error
+	if m.exporter.Options().MetricsEnabled {
+		promErr = m.initPrometheus()
+	}
+
+	// The OTLP exporter is independent of the Prometheus endpoint.
+	if err := m.exporter.InitOTLP(); err != nil {
+		if promErr != nil {
+			return errors.Errorf("%v; %v", promErr, err)
+		}
+		return err
 	}
+	return promErr
+}
 
+func (m *promMetricsExporter) initPrometheus() error {
 	var err error
 	if m.ocExporter, err = ocprom.NewExporter(ocprom.Options{
 		Namespace: m.namespace,
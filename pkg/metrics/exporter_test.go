@@ -14,9 +14,12 @@ limitations under the License.
 package metrics
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/dapr/kit/logger"
 )
@@ -40,6 +43,43 @@ func TestMetricsExporter(t *testing.T) {
 		assert.Error(t, e.startMetricServer())
 	})
 
+	t.Run("skip starting OTLP exporter without endpoint", func(t *testing.T) {
+		e := NewExporter("test")
+		assert.NoError(t, e.InitOTLP())
+		assert.Nil(t, e.(*promMetricsExporter).otlpReader)
+	})
+
+	t.Run("return error if OTLP protocol is invalid", func(t *testing.T) {
+		e := NewExporter("test")
+		e.Options().OTLP.Endpoint = "localhost:4317"
+		e.Options().OTLP.Protocol = "udp"
+		assert.Error(t, e.InitOTLP())
+	})
+
+	t.Run("close stops the OTLP exporter", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+
+		e := NewExporter("test")
+		e.Options().OTLP.Endpoint = server.URL
+		e.Options().OTLP.Protocol = "http"
+		require.NoError(t, e.InitOTLP())
+		require.NotNil(t, e.(*promMetricsExporter).otlpReader)
+
+		assert.NoError(t, e.Close())
+		assert.Nil(t, e.(*promMetricsExporter).otlpReader)
+		// Closing again is a no-op.
+		assert.NoError(t, e.Close())
+	})
+
+	t.Run("return error if OTLP exporter can't be started", func(t *testing.T) {
+		e := NewExporter("test")
+		e.Options().MetricsEnabled = false
+		e.Options().OTLP.Endpoint = "localhost:4317"
+		e.Options().OTLP.Protocol = "udp"
+		assert.Error(t, e.Init())
+	})
+
 	t.Run("skip starting metric server", func(t *testing.T) {
 		e := NewExporter("test")
 		e.Options().MetricsEnabled = false
@@ -15,11 +15,15 @@ package metrics
 
 import (
 	"strconv"
+	"time"
+
+	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
 )
 
 const (
 	defaultMetricsPort    = "9090"
 	defaultMetricsEnabled = true
+	defaultOTLPInterval   = "60s"
 )
 
 // Options defines the sets of options for Dapr logging.
@@ -28,12 +32,46 @@ type Options struct {
 	MetricsEnabled bool
 
 	Port string
+
+	// OTLP are the options of the OTLP exporter, which pushes the metrics to an OpenTelemetry collector.
+	OTLP OTLPOptions
+}
+
+// OTLPOptions defines the options of the OTLP metrics exporter.
+type OTLPOptions struct {
+	// Endpoint is the address of the OpenTelemetry collector. The metrics are not pushed if it is empty.
+	Endpoint string
+	// Protocol is either "grpc" (default) or "http".
+	Protocol string
+	// Interval is the period between two pushes, such as 30s.
+	Interval string
+	// Headers are added to every export request, in the `key1=value1,key2=value2` format.
+	Headers string
+	// Insecure disables TLS on the connection to the collector.
+	Insecure bool
+	// ServiceName is reported as the `service.name` resource attribute. Defaults to the binary name.
+	ServiceName string
+}
+
+// OTLPInterval gets the period between two pushes of the OTLP exporter.
+func (o *OTLPOptions) OTLPInterval() time.Duration {
+	interval, err := time.ParseDuration(o.Interval)
+	if err != nil || interval <= 0 {
+		// Use default interval as a fallback
+		interval, _ = time.ParseDuration(defaultOTLPInterval)
+	}
+
+	return interval
 }
 
 func defaultMetricOptions() *Options {
 	return &Options{
 		Port:           defaultMetricsPort,
 		MetricsEnabled: defaultMetricsEnabled,
+		OTLP: OTLPOptions{
+			Protocol: diag_utils.OTLPProtocolGRPC,
+			Interval: defaultOTLPInterval,
+		},
 	}
 }
 
@@ -63,6 +101,31 @@ func (o *Options) AttachCmdFlags(
 		"enable-metrics",
 		defaultMetricsEnabled,
 		"Enable prometheus metric")
+	stringVar(
+		&o.OTLP.Endpoint,
+		"metrics-otlp-endpoint",
+		"",
+		"The address of the OpenTelemetry collector the metrics are pushed to over OTLP")
+	stringVar(
+		&o.OTLP.Protocol,
+		"metrics-otlp-protocol",
+		diag_utils.OTLPProtocolGRPC,
+		"The OTLP protocol, either grpc or http")
+	stringVar(
+		&o.OTLP.Interval,
+		"metrics-otlp-interval",
+		defaultOTLPInterval,
+		"The period between two pushes of the metrics to the OpenTelemetry collector")
+	stringVar(
+		&o.OTLP.Headers,
+		"metrics-otlp-headers",
+		"",
+		"Headers added to the OTLP export requests, in the key1=value1,key2=value2 format")
+	boolVar(
+		&o.OTLP.Insecure,
+		"metrics-otlp-insecure",
+		false,
+		"Disable TLS on the connection to the OpenTelemetry collector")
 }
 
 // AttachCmdFlag attaches single metrics option to command flags.
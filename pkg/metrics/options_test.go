@@ -16,6 +16,7 @@ package metrics
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -60,6 +61,17 @@ func TestOptions(t *testing.T) {
 		assert.Equal(t, uint64(1010), o.MetricsPort())
 	})
 
+	t.Run("parse OTLP interval", func(t *testing.T) {
+		o := defaultMetricOptions()
+		assert.Equal(t, 60*time.Second, o.OTLP.OTLPInterval())
+
+		o.OTLP.Interval = "15s"
+		assert.Equal(t, 15*time.Second, o.OTLP.OTLPInterval())
+
+		o.OTLP.Interval = "invalid"
+		assert.Equal(t, 60*time.Second, o.OTLP.OTLPInterval())
+	})
+
 	t.Run("return default port if port is invalid", func(t *testing.T) {
 		o := Options{
 			Port:           "invalid",
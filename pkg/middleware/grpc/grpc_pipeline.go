@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"google.golang.org/grpc"
+)
+
+// Middleware is a gRPC middleware, made of the interceptors of unary and streaming calls.
+// Either interceptor can be nil, if the middleware doesn't apply to that kind of calls.
+type Middleware struct {
+	Unary  grpc.UnaryServerInterceptor
+	Stream grpc.StreamServerInterceptor
+}
+
+// Pipeline defines the gRPC middleware pipeline to be plugged into Dapr sidecar.
+type Pipeline struct {
+	Handlers []Middleware
+}
+
+// UnaryServerInterceptors returns the unary interceptors of the pipeline, in order.
+func (p Pipeline) UnaryServerInterceptors() []grpc.UnaryServerInterceptor {
+	intr := make([]grpc.UnaryServerInterceptor, 0, len(p.Handlers))
+	for _, h := range p.Handlers {
+		if h.Unary != nil {
+			intr = append(intr, h.Unary)
+		}
+	}
+	return intr
+}
+
+// StreamServerInterceptors returns the stream interceptors of the pipeline, in order.
+func (p Pipeline) StreamServerInterceptors() []grpc.StreamServerInterceptor {
+	intr := make([]grpc.StreamServerInterceptor, 0, len(p.Handlers))
+	for _, h := range p.Handlers {
+		if h.Stream != nil {
+			intr = append(intr, h.Stream)
+		}
+	}
+	return intr
+}
@@ -40,6 +40,7 @@ import (
 
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	configurationapi "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subscriptionsapi_v2alpha1 "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	dapr_credentials "github.com/dapr/dapr/pkg/credentials"
@@ -292,6 +293,32 @@ func (a *apiServer) ListResiliency(ctx context.Context, in *operatorv1pb.ListRes
 	return resp, nil
 }
 
+// ListHTTPEndpoints returns a list of Dapr HTTP endpoints.
+func (a *apiServer) ListHTTPEndpoints(ctx context.Context, in *operatorv1pb.ListHTTPEndpointsRequest) (*operatorv1pb.ListHTTPEndpointsResponse, error) {
+	resp := &operatorv1pb.ListHTTPEndpointsResponse{
+		HttpEndpoints: [][]byte{},
+	}
+
+	var endpoints httpendpointsapi.HTTPEndpointList
+	if err := a.Client.List(ctx, &endpoints, &client.ListOptions{
+		Namespace: in.Namespace,
+	}); err != nil {
+		return nil, errors.Wrap(err, "error listing http endpoints")
+	}
+
+	for i := range endpoints.Items {
+		e := endpoints.Items[i] // Make a copy since we will refer to this as a reference in this loop.
+		b, err := json.Marshal(&e)
+		if err != nil {
+			log.Warnf("error marshalling http endpoint %s: %s", e.Name, err)
+			continue
+		}
+		resp.HttpEndpoints = append(resp.HttpEndpoints, b)
+	}
+
+	return resp, nil
+}
+
 // ComponentUpdate updates Dapr sidecars whenever a component in the cluster is modified.
 func (a *apiServer) ComponentUpdate(in *operatorv1pb.ComponentUpdateRequest, srv operatorv1pb.Operator_ComponentUpdateServer) error {
 	log.Info("sidecar connected for component updates")
@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	componentsapi "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	httpendpointsapi "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
 	resiliencyapi "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subscriptionsapi_v2alpha1 "github.com/dapr/dapr/pkg/apis/subscriptions/v2alpha1"
 	"github.com/dapr/dapr/pkg/client/clientset/versioned/scheme"
@@ -423,4 +424,60 @@ func TestListsNamespaced(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(res.GetResiliencies()))
 	})
+	t.Run("list http endpoints namespace scoping", func(t *testing.T) {
+		s := runtime.NewScheme()
+		err := scheme.AddToScheme(s)
+		assert.NoError(t, err)
+
+		err = httpendpointsapi.AddToScheme(s)
+		assert.NoError(t, err)
+
+		av, kind := httpendpointsapi.SchemeGroupVersion.WithKind("HTTPEndpoint").ToAPIVersionAndKind()
+		typeMeta := metav1.TypeMeta{
+			Kind:       kind,
+			APIVersion: av,
+		}
+		client := fake.NewClientBuilder().
+			WithScheme(s).
+			WithObjects(&httpendpointsapi.HTTPEndpoint{
+				TypeMeta: typeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "obj1",
+					Namespace: "namespace-a",
+				},
+				Spec: httpendpointsapi.HTTPEndpointSpec{
+					BaseURL: "https://api.example.com",
+				},
+			}, &httpendpointsapi.HTTPEndpoint{
+				TypeMeta: typeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "obj2",
+					Namespace: "namespace-b",
+				},
+			}).
+			Build()
+
+		api := NewAPIServer(client).(*apiServer)
+
+		res, err := api.ListHTTPEndpoints(context.TODO(), &operatorv1pb.ListHTTPEndpointsRequest{
+			Namespace: "namespace-a",
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetHttpEndpoints()))
+
+		var endpoint httpendpointsapi.HTTPEndpoint
+		err = yaml.Unmarshal(res.GetHttpEndpoints()[0], &endpoint)
+		assert.Nil(t, err)
+
+		assert.Equal(t, "obj1", endpoint.Name)
+		assert.Equal(t, "namespace-a", endpoint.Namespace)
+		assert.Equal(t, "https://api.example.com", endpoint.Spec.BaseURL)
+
+		res, err = api.ListHTTPEndpoints(context.TODO(), &operatorv1pb.ListHTTPEndpointsRequest{
+			Namespace: "namespace-c",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(res.GetHttpEndpoints()))
+	})
 }
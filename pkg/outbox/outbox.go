@@ -0,0 +1,197 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	contrib_metadata "github.com/dapr/components-contrib/metadata"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/kit/logger"
+
+	state_loader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/encryption"
+)
+
+const (
+	outboxPublishPubsubKey = "outboxPublishPubsub"
+	outboxPublishTopicKey  = "outboxPublishTopic"
+
+	// keyPrefix is the prefix of the keys of the outbox records in the state store.
+	// Keys with this prefix are reserved in the state stores with an outbox.
+	keyPrefix = "dapr-outbox-"
+)
+
+var log = logger.NewLogger("dapr.runtime.outbox")
+
+var (
+	outboxStores     = map[string]outboxStore{}
+	outboxStoresLock sync.RWMutex
+)
+
+// outboxStore is a state store with the pubsub and topic its outbox events are published to.
+type outboxStore struct {
+	store      state.Store
+	pubsubName string
+	topic      string
+}
+
+// Event is an event saved in the outbox of a state store in a transaction, and published once the transaction is committed.
+type Event struct {
+	// ID identifies the event. It is the ID of the published cloud event, so that subscribers can detect redeliveries.
+	ID              string            `json:"id"`
+	Data            []byte            `json:"data"`
+	DataContentType string            `json:"dataContentType,omitempty"`
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	TraceID         string            `json:"traceId,omitempty"`
+	TraceState      string            `json:"traceState,omitempty"`
+}
+
+// record is an outbox event as saved in the state store.
+type record struct {
+	Event
+	PubsubName string `json:"pubsubName"`
+	Topic      string `json:"topic"`
+}
+
+// AddOutboxStateStore enables the outbox of a state store if its metadata configures the outbox pubsub and topic.
+// It returns false if the state store doesn't have an outbox.
+// The state store must support the query API, which lists the records left in the outbox when the sidecar restarts.
+func AddOutboxStateStore(storeName string, store state.Store, metadata map[string]string) (bool, error) {
+	pubsubName := metadata[outboxPublishPubsubKey]
+	topic := metadata[outboxPublishTopicKey]
+	if pubsubName == "" && topic == "" {
+		return false, nil
+	}
+	if pubsubName == "" || topic == "" {
+		return false, errors.Errorf("both %s and %s are required to enable the outbox", outboxPublishPubsubKey, outboxPublishTopicKey)
+	}
+	if !state.FeatureTransactional.IsPresent(store.Features()) {
+		return false, errors.New("the outbox requires a state store that supports transactions")
+	}
+	if _, ok := store.(state.Querier); !ok {
+		return false, errors.New("the outbox requires a state store that supports the query API")
+	}
+
+	outboxStoresLock.Lock()
+	defer outboxStoresLock.Unlock()
+
+	outboxStores[storeName] = outboxStore{
+		store:      store,
+		pubsubName: pubsubName,
+		topic:      topic,
+	}
+	return true, nil
+}
+
+// RemoveOutboxStateStore disables the outbox of a state store, when the state store is deleted.
+// The pending events of the state store are retried until it is added again.
+func RemoveOutboxStateStore(storeName string) {
+	outboxStoresLock.Lock()
+	defer outboxStoresLock.Unlock()
+
+	delete(outboxStores, storeName)
+}
+
+// OutboxStateStore returns true if the outbox is enabled for the state store.
+func OutboxStateStore(storeName string) bool {
+	_, ok := getOutboxStore(storeName)
+	return ok
+}
+
+// CheckStateKey returns an error if the key of a state item saved or deleted by the app is reserved for
+// the outbox records of the state store.
+func CheckStateKey(storeName, key string) error {
+	if strings.HasPrefix(key, keyPrefix) && OutboxStateStore(storeName) {
+		return errors.Errorf("keys starting with %s are reserved for the outbox of state store %s", keyPrefix, storeName)
+	}
+	return nil
+}
+
+func getOutboxStore(storeName string) (outboxStore, bool) {
+	outboxStoresLock.RLock()
+	defer outboxStoresLock.RUnlock()
+
+	s, ok := outboxStores[storeName]
+	return s, ok
+}
+
+func getOutboxStoreNames() []string {
+	outboxStoresLock.RLock()
+	defer outboxStoresLock.RUnlock()
+
+	names := make([]string, 0, len(outboxStores))
+	for name := range outboxStores {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Operations returns the operations saving the events in the outbox of the state store, and the keys of the records.
+// The operations must be executed in the same transaction as the state changes, and the relay must be notified
+// with EventsSaved once the transaction is committed.
+// Events without an ID are given a random one. The records have random keys, so that events with the same ID
+// don't overwrite each other.
+func Operations(storeName, appID string, events []Event) ([]state.TransactionalStateOperation, []string, error) {
+	s, ok := getOutboxStore(storeName)
+	if !ok {
+		return nil, nil, errors.Errorf("state store %s doesn't have an outbox configured", storeName)
+	}
+
+	operations := make([]state.TransactionalStateOperation, 0, len(events))
+	keys := make([]string, 0, len(events))
+	for _, e := range events {
+		if e.ID == "" {
+			e.ID = uuid.New().String()
+		}
+		if _, err := contrib_metadata.IsRawPayload(e.Metadata); err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid metadata for event %s", e.ID)
+		}
+
+		key, err := state_loader.GetModifiedStateKey(keyPrefix+uuid.New().String(), storeName, appID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		value, err := json.Marshal(record{
+			Event:      e,
+			PubsubName: s.pubsubName,
+			Topic:      s.topic,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if encryption.EncryptedStateStore(storeName) {
+			value, err = encryption.TryEncryptValue(storeName, value)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		operations = append(operations, state.TransactionalStateOperation{
+			Operation: state.Upsert,
+			Request: state.SetRequest{
+				Key:   key,
+				Value: value,
+			},
+		})
+		keys = append(keys, key)
+	}
+	return operations, keys, nil
+}
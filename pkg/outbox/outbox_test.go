@@ -0,0 +1,242 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/state"
+)
+
+type fakeStateStore struct {
+	state.Store
+	transactional bool
+	items         map[string][]byte
+	deleteErr     error
+	lock          sync.Mutex
+}
+
+func newFakeStateStore() *fakeStateStore {
+	return &fakeStateStore{
+		transactional: true,
+		items:         map[string][]byte{},
+	}
+}
+
+func (s *fakeStateStore) Features() []state.Feature {
+	if s.transactional {
+		return []state.Feature{state.FeatureTransactional}
+	}
+	return nil
+}
+
+func (s *fakeStateStore) Get(req *state.GetRequest) (*state.GetResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return &state.GetResponse{Data: s.items[req.Key]}, nil
+}
+
+func (s *fakeStateStore) Delete(req *state.DeleteRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.deleteErr != nil {
+		return s.deleteErr
+	}
+	delete(s.items, req.Key)
+	return nil
+}
+
+func (s *fakeStateStore) Multi(req *state.TransactionalStateRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, o := range req.Operations {
+		switch o.Operation {
+		case state.Upsert:
+			r := o.Request.(state.SetRequest)
+			s.items[r.Key] = r.Value.([]byte)
+		case state.Delete:
+			r := o.Request.(state.DeleteRequest)
+			delete(s.items, r.Key)
+		}
+	}
+	return nil
+}
+
+// Query returns all the items in a single page.
+func (s *fakeStateStore) Query(req *state.QueryRequest) (*state.QueryResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	res := &state.QueryResponse{}
+	for key, data := range s.items {
+		res.Results = append(res.Results, state.QueryItem{Key: key, Data: data})
+	}
+	sort.Slice(res.Results, func(i, j int) bool {
+		return res.Results[i].Key < res.Results[j].Key
+	})
+	return res, nil
+}
+
+func TestAddOutboxStateStore(t *testing.T) {
+	t.Run("outbox not configured", func(t *testing.T) {
+		outboxStores = map[string]outboxStore{}
+
+		ok, err := AddOutboxStateStore("store1", newFakeStateStore(), map[string]string{})
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.False(t, OutboxStateStore("store1"))
+	})
+
+	t.Run("outbox configured", func(t *testing.T) {
+		outboxStores = map[string]outboxStore{}
+
+		ok, err := AddOutboxStateStore("store1", newFakeStateStore(), map[string]string{
+			outboxPublishPubsubKey: "pubsub1",
+			outboxPublishTopicKey:  "topic1",
+		})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, OutboxStateStore("store1"))
+	})
+
+	t.Run("missing topic", func(t *testing.T) {
+		outboxStores = map[string]outboxStore{}
+
+		ok, err := AddOutboxStateStore("store1", newFakeStateStore(), map[string]string{
+			outboxPublishPubsubKey: "pubsub1",
+		})
+		assert.Error(t, err)
+		assert.False(t, ok)
+		assert.False(t, OutboxStateStore("store1"))
+	})
+
+	t.Run("state store without transactions", func(t *testing.T) {
+		outboxStores = map[string]outboxStore{}
+
+		store := newFakeStateStore()
+		store.transactional = false
+		_, err := AddOutboxStateStore("store1", store, map[string]string{
+			outboxPublishPubsubKey: "pubsub1",
+			outboxPublishTopicKey:  "topic1",
+		})
+		assert.Error(t, err)
+		assert.False(t, OutboxStateStore("store1"))
+	})
+
+	t.Run("state store without query API", func(t *testing.T) {
+		outboxStores = map[string]outboxStore{}
+
+		_, err := AddOutboxStateStore("store1", struct{ state.Store }{newFakeStateStore()}, map[string]string{
+			outboxPublishPubsubKey: "pubsub1",
+			outboxPublishTopicKey:  "topic1",
+		})
+		assert.Error(t, err)
+		assert.False(t, OutboxStateStore("store1"))
+	})
+
+	t.Run("state store removed", func(t *testing.T) {
+		outboxStores = map[string]outboxStore{}
+
+		_, err := AddOutboxStateStore("store1", newFakeStateStore(), map[string]string{
+			outboxPublishPubsubKey: "pubsub1",
+			outboxPublishTopicKey:  "topic1",
+		})
+		require.NoError(t, err)
+
+		RemoveOutboxStateStore("store1")
+		assert.False(t, OutboxStateStore("store1"))
+	})
+}
+
+func TestCheckStateKey(t *testing.T) {
+	outboxStores = map[string]outboxStore{}
+	_, err := AddOutboxStateStore("store1", newFakeStateStore(), map[string]string{
+		outboxPublishPubsubKey: "pubsub1",
+		outboxPublishTopicKey:  "topic1",
+	})
+	require.NoError(t, err)
+
+	assert.NoError(t, CheckStateKey("store1", "key1"))
+	assert.Error(t, CheckStateKey("store1", "dapr-outbox-1"))
+	assert.NoError(t, CheckStateKey("store2", "dapr-outbox-1"))
+}
+
+func TestOperations(t *testing.T) {
+	outboxStores = map[string]outboxStore{}
+	_, err := AddOutboxStateStore("store1", newFakeStateStore(), map[string]string{
+		outboxPublishPubsubKey: "pubsub1",
+		outboxPublishTopicKey:  "topic1",
+	})
+	require.NoError(t, err)
+
+	t.Run("events are saved with the outbox pubsub and topic", func(t *testing.T) {
+		operations, keys, err := Operations("store1", "app1", []Event{
+			{ID: "1", Data: []byte(`{"a":1}`), DataContentType: "application/json"},
+			{Data: []byte("hello")},
+		})
+		require.NoError(t, err)
+		require.Len(t, operations, 2)
+		require.Len(t, keys, 2)
+
+		assert.True(t, strings.HasPrefix(keys[0], "app1||dapr-outbox-"))
+		assert.True(t, strings.HasPrefix(keys[1], "app1||dapr-outbox-"))
+		assert.NotEqual(t, keys[0], keys[1])
+
+		assert.Equal(t, state.Upsert, operations[0].Operation)
+		req := operations[0].Request.(state.SetRequest)
+		assert.Equal(t, keys[0], req.Key)
+
+		var rec record
+		require.NoError(t, json.Unmarshal(req.Value.([]byte), &rec))
+		assert.Equal(t, "1", rec.ID)
+		assert.Equal(t, "pubsub1", rec.PubsubName)
+		assert.Equal(t, "topic1", rec.Topic)
+		assert.Equal(t, []byte(`{"a":1}`), rec.Data)
+		assert.Equal(t, "application/json", rec.DataContentType)
+	})
+
+	t.Run("events with the same ID are saved with different keys", func(t *testing.T) {
+		_, keys, err := Operations("store1", "app1", []Event{
+			{ID: "1"},
+			{ID: "1"},
+		})
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		assert.NotEqual(t, keys[0], keys[1])
+	})
+
+	t.Run("invalid event metadata", func(t *testing.T) {
+		_, _, err := Operations("store1", "app1", []Event{
+			{ID: "1", Metadata: map[string]string{"rawPayload": "maybe"}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("state store without outbox", func(t *testing.T) {
+		_, _, err := Operations("store2", "app1", []Event{
+			{ID: "1"},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	contrib_contenttype "github.com/dapr/components-contrib/contenttype"
+	contrib_metadata "github.com/dapr/components-contrib/metadata"
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+	"github.com/dapr/components-contrib/state/query"
+
+	state_loader "github.com/dapr/dapr/pkg/components/state"
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	"github.com/dapr/dapr/pkg/encryption"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+const (
+	defaultRetryInterval = 5 * time.Second
+	defaultScanInterval  = time.Minute
+	recoveryPageSize     = 100
+)
+
+var (
+	pendingEvents     []pendingEvent
+	pendingEventsLock sync.Mutex
+	// pendingNotify wakes up the relay when events are saved.
+	pendingNotify = make(chan struct{}, 1)
+)
+
+// pendingEvent is an outbox record that hasn't been relayed yet.
+type pendingEvent struct {
+	storeName string
+	key       string
+	// published is true if the event was published but the record couldn't be deleted.
+	published bool
+}
+
+// EventsSaved notifies the relay that the transaction saving the outbox records with the keys was committed.
+func EventsSaved(storeName string, keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+
+	pendingEventsLock.Lock()
+	for _, key := range keys {
+		pendingEvents = append(pendingEvents, pendingEvent{storeName: storeName, key: key})
+	}
+	pendingEventsLock.Unlock()
+
+	select {
+	case pendingNotify <- struct{}{}:
+	default:
+	}
+}
+
+func takePendingEvents() []pendingEvent {
+	pendingEventsLock.Lock()
+	defer pendingEventsLock.Unlock()
+
+	events := pendingEvents
+	pendingEvents = nil
+	return events
+}
+
+// Relay publishes the events saved in the outbox of the state stores, and deletes their records once published.
+// Events are delivered at least once: an event is published again if its record couldn't be deleted.
+type Relay struct {
+	appID         string
+	pubsubAdapter runtime_pubsub.Adapter
+	retryInterval time.Duration
+	scanInterval  time.Duration
+}
+
+// NewRelay returns a relay publishing the outbox events of the app with the pubsub adapter.
+func NewRelay(appID string, pubsubAdapter runtime_pubsub.Adapter) *Relay {
+	return &Relay{
+		appID:         appID,
+		pubsubAdapter: pubsubAdapter,
+		retryInterval: defaultRetryInterval,
+		scanInterval:  defaultScanInterval,
+	}
+}
+
+// Run relays the events left in the outbox of the state stores, which were saved before the sidecar restarted,
+// then relays the saved events until the context is canceled.
+// Events that fail to be relayed are retried periodically, and the outbox is scanned periodically for the events
+// the relay wasn't notified of.
+// Every replica of the app scans the outbox, so pending events may be published by more than one of them:
+// subscribers are expected to discard duplicates by event ID.
+func (r *Relay) Run(ctx context.Context) {
+	retries := r.scan(ctx, nil)
+
+	ticker := time.NewTicker(r.retryInterval)
+	defer ticker.Stop()
+	scanTicker := time.NewTicker(r.scanInterval)
+	defer scanTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-pendingNotify:
+			retries = append(retries, r.relayEvents(ctx, takePendingEvents())...)
+		case <-ticker.C:
+			if len(retries) > 0 {
+				events := retries
+				retries = r.relayEvents(ctx, events)
+			}
+		case <-scanTicker.C:
+			retries = r.scan(ctx, retries)
+		}
+	}
+}
+
+// scan relays the events found in the outbox of the state stores, except the ones already waiting to be retried,
+// and returns the retries with the events that failed.
+// It relays the events of state stores added after the relay started, and the events of transactions that
+// were committed without the relay being notified, e.g. because the transaction timed out while committing.
+func (r *Relay) scan(ctx context.Context, retries []pendingEvent) []pendingEvent {
+	retrying := make(map[pendingEvent]struct{}, len(retries))
+	for _, e := range retries {
+		retrying[pendingEvent{storeName: e.storeName, key: e.key}] = struct{}{}
+	}
+
+	for _, storeName := range getOutboxStoreNames() {
+		events, err := r.recoverEvents(ctx, storeName)
+		if err != nil {
+			log.Warnf("failed to recover the outbox events of state store %s: %s", storeName, err)
+		}
+
+		n := 0
+		for _, e := range events {
+			if _, ok := retrying[e]; !ok {
+				events[n] = e
+				n++
+			}
+		}
+		retries = append(retries, r.relayEvents(ctx, events[:n])...)
+	}
+	return retries
+}
+
+// relayEvents relays the events and returns the ones that failed.
+func (r *Relay) relayEvents(ctx context.Context, events []pendingEvent) []pendingEvent {
+	var failed []pendingEvent
+	for i := range events {
+		e := &events[i]
+		if err := r.relayEvent(ctx, e); err != nil {
+			log.Warnf("failed to relay outbox event %s of state store %s, retrying in %s: %s", e.key, e.storeName, r.retryInterval, err)
+			failed = append(failed, *e)
+		}
+	}
+	return failed
+}
+
+func (r *Relay) relayEvent(ctx context.Context, e *pendingEvent) error {
+	s, ok := getOutboxStore(e.storeName)
+	if !ok {
+		return errors.Errorf("state store %s doesn't have an outbox configured", e.storeName)
+	}
+
+	if !e.published {
+		res, err := s.store.Get(&state.GetRequest{Key: e.key})
+		if err != nil {
+			return err
+		}
+		if res == nil || len(res.Data) == 0 {
+			// The event was already relayed.
+			return nil
+		}
+
+		data := res.Data
+		if encryption.EncryptedStateStore(e.storeName) {
+			data, err = encryption.TryDecryptValue(e.storeName, data)
+			if err != nil {
+				return err
+			}
+		}
+
+		var rec record
+		if err = json.Unmarshal(data, &rec); err != nil {
+			// The record can't be relayed, it is left in the state store to be inspected.
+			log.Errorf("invalid outbox event %s in state store %s: %s", e.key, e.storeName, err)
+			return nil
+		}
+
+		start := time.Now()
+		err = r.publish(&rec)
+		elapsed := diag.ElapsedSince(start)
+
+		diag.DefaultComponentMonitoring.PubsubEgressEvent(ctx, rec.PubsubName, rec.Topic, err == nil, elapsed)
+
+		if err != nil {
+			return err
+		}
+		e.published = true
+	}
+
+	return s.store.Delete(&state.DeleteRequest{Key: e.key})
+}
+
+func (r *Relay) publish(rec *record) error {
+	thepubsub := r.pubsubAdapter.GetPubSub(rec.PubsubName)
+	if thepubsub == nil {
+		return runtime_pubsub.NotFoundError{PubsubName: rec.PubsubName}
+	}
+
+	data := rec.Data
+	rawPayload, err := contrib_metadata.IsRawPayload(rec.Metadata)
+	if err != nil {
+		return err
+	}
+	if !rawPayload {
+		var envelope map[string]interface{}
+		if contrib_contenttype.IsCloudEventContentType(rec.DataContentType) {
+			envelope, err = pubsub.FromCloudEvent(rec.Data, rec.Topic, rec.PubsubName, rec.TraceID, rec.TraceState)
+			if err != nil {
+				return err
+			}
+		} else {
+			// The ID of the event is kept when it is published again, so that subscribers can detect redeliveries.
+			envelope = pubsub.NewCloudEventsEnvelope(rec.ID, r.appID, pubsub.DefaultCloudEventType, "", rec.Topic,
+				rec.PubsubName, rec.DataContentType, rec.Data, rec.TraceID, rec.TraceState)
+		}
+
+		pubsub.ApplyMetadata(envelope, thepubsub.Features(), rec.Metadata)
+
+		data, err = json.Marshal(envelope)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.pubsubAdapter.Publish(&pubsub.PublishRequest{
+		PubsubName: rec.PubsubName,
+		Topic:      rec.Topic,
+		Data:       data,
+		Metadata:   rec.Metadata,
+	})
+}
+
+// recoverEvents returns the outbox records of the app in the state store, using the query API to list its keys.
+func (r *Relay) recoverEvents(ctx context.Context, storeName string) ([]pendingEvent, error) {
+	s, ok := getOutboxStore(storeName)
+	if !ok {
+		return nil, nil
+	}
+	querier, ok := s.store.(state.Querier)
+	if !ok {
+		return nil, errors.Errorf("state store %s doesn't support the query API", storeName)
+	}
+
+	var events []pendingEvent
+	req := &state.QueryRequest{
+		Query: query.Query{
+			Page: query.Pagination{
+				Limit: recoveryPageSize,
+			},
+		},
+	}
+	for {
+		if ctx.Err() != nil {
+			return events, ctx.Err()
+		}
+
+		resp, err := querier.Query(req)
+		if err != nil {
+			return events, err
+		}
+
+		for _, item := range resp.Results {
+			if r.isOutboxKey(storeName, item.Key) {
+				events = append(events, pendingEvent{storeName: storeName, key: item.Key})
+			}
+		}
+
+		if resp.Token == "" || len(resp.Results) == 0 {
+			return events, nil
+		}
+		req.Query.Page.Token = resp.Token
+	}
+}
+
+// isOutboxKey returns true if the key is the key of an outbox record of the app.
+func (r *Relay) isOutboxKey(storeName, key string) bool {
+	originalKey := state_loader.GetOriginalStateKey(key)
+	if !strings.HasPrefix(originalKey, keyPrefix) {
+		return false
+	}
+	modifiedKey, err := state_loader.GetModifiedStateKey(originalKey, storeName, r.appID)
+	return err == nil && modifiedKey == key
+}
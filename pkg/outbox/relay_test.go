@@ -0,0 +1,254 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/pubsub"
+	"github.com/dapr/components-contrib/state"
+
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+type fakePubSub struct {
+	pubsub.PubSub
+}
+
+func (p *fakePubSub) Features() []pubsub.Feature {
+	return nil
+}
+
+type fakePubsubAdapter struct {
+	published  []*pubsub.PublishRequest
+	publishErr error
+	lock       sync.Mutex
+}
+
+func (a *fakePubsubAdapter) GetPubSub(pubsubName string) pubsub.PubSub {
+	if pubsubName != "pubsub1" {
+		return nil
+	}
+	return &fakePubSub{}
+}
+
+func (a *fakePubsubAdapter) Publish(req *pubsub.PublishRequest) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.publishErr != nil {
+		return a.publishErr
+	}
+	a.published = append(a.published, req)
+	return nil
+}
+
+func (a *fakePubsubAdapter) BulkPublish(req *runtime_pubsub.BulkPublishRequest) (runtime_pubsub.BulkPublishResponse, error) {
+	return runtime_pubsub.BulkPublishResponse{}, nil
+}
+
+func (a *fakePubsubAdapter) publishedCount() int {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return len(a.published)
+}
+
+// saveEvents saves the events in the outbox of the state store, as the state transaction API does.
+func saveEvents(t *testing.T, store *fakeStateStore, events ...Event) []string {
+	operations, keys, err := Operations("store1", "app1", events)
+	require.NoError(t, err)
+	require.NoError(t, store.Multi(&state.TransactionalStateRequest{Operations: operations}))
+	return keys
+}
+
+func newTestOutbox(t *testing.T) *fakeStateStore {
+	outboxStores = map[string]outboxStore{}
+	pendingEvents = nil
+
+	store := newFakeStateStore()
+	_, err := AddOutboxStateStore("store1", store, map[string]string{
+		outboxPublishPubsubKey: "pubsub1",
+		outboxPublishTopicKey:  "topic1",
+	})
+	require.NoError(t, err)
+	return store
+}
+
+func TestRelayEvents(t *testing.T) {
+	t.Run("events are published then deleted", func(t *testing.T) {
+		store := newTestOutbox(t)
+		adapter := &fakePubsubAdapter{}
+		relay := NewRelay("app1", adapter)
+
+		keys := saveEvents(t, store, Event{ID: "1", Data: []byte(`{"a":1}`), DataContentType: "application/json"})
+		failed := relay.relayEvents(context.Background(), []pendingEvent{{storeName: "store1", key: keys[0]}})
+		assert.Empty(t, failed)
+
+		require.Len(t, adapter.published, 1)
+		assert.Equal(t, "pubsub1", adapter.published[0].PubsubName)
+		assert.Equal(t, "topic1", adapter.published[0].Topic)
+
+		var envelope map[string]interface{}
+		require.NoError(t, json.Unmarshal(adapter.published[0].Data, &envelope))
+		assert.Equal(t, "1", envelope[pubsub.IDField])
+		assert.Equal(t, "app1", envelope[pubsub.SourceField])
+		assert.Equal(t, map[string]interface{}{"a": float64(1)}, envelope[pubsub.DataField])
+
+		assert.Empty(t, store.items)
+	})
+
+	t.Run("raw payload", func(t *testing.T) {
+		store := newTestOutbox(t)
+		adapter := &fakePubsubAdapter{}
+		relay := NewRelay("app1", adapter)
+
+		keys := saveEvents(t, store, Event{ID: "1", Data: []byte("hello"), Metadata: map[string]string{"rawPayload": "true"}})
+		failed := relay.relayEvents(context.Background(), []pendingEvent{{storeName: "store1", key: keys[0]}})
+		assert.Empty(t, failed)
+
+		require.Len(t, adapter.published, 1)
+		assert.Equal(t, []byte("hello"), adapter.published[0].Data)
+	})
+
+	t.Run("event already relayed", func(t *testing.T) {
+		newTestOutbox(t)
+		adapter := &fakePubsubAdapter{}
+		relay := NewRelay("app1", adapter)
+
+		failed := relay.relayEvents(context.Background(), []pendingEvent{{storeName: "store1", key: "app1||dapr-outbox-a"}})
+		assert.Empty(t, failed)
+		assert.Empty(t, adapter.published)
+	})
+
+	t.Run("failed publish is retried", func(t *testing.T) {
+		store := newTestOutbox(t)
+		adapter := &fakePubsubAdapter{publishErr: errors.New("publish error")}
+		relay := NewRelay("app1", adapter)
+
+		keys := saveEvents(t, store, Event{ID: "1", Data: []byte("hello")})
+		failed := relay.relayEvents(context.Background(), []pendingEvent{{storeName: "store1", key: keys[0]}})
+		require.Len(t, failed, 1)
+		assert.False(t, failed[0].published)
+		assert.Len(t, store.items, 1)
+
+		adapter.publishErr = nil
+		failed = relay.relayEvents(context.Background(), failed)
+		assert.Empty(t, failed)
+		assert.Len(t, adapter.published, 1)
+		assert.Empty(t, store.items)
+	})
+
+	t.Run("failed delete is retried without publishing again", func(t *testing.T) {
+		store := newTestOutbox(t)
+		store.deleteErr = errors.New("delete error")
+		adapter := &fakePubsubAdapter{}
+		relay := NewRelay("app1", adapter)
+
+		keys := saveEvents(t, store, Event{ID: "1", Data: []byte("hello")})
+		failed := relay.relayEvents(context.Background(), []pendingEvent{{storeName: "store1", key: keys[0]}})
+		require.Len(t, failed, 1)
+		assert.True(t, failed[0].published)
+
+		store.deleteErr = nil
+		failed = relay.relayEvents(context.Background(), failed)
+		assert.Empty(t, failed)
+		assert.Len(t, adapter.published, 1)
+		assert.Empty(t, store.items)
+	})
+}
+
+func TestRecoverEvents(t *testing.T) {
+	store := newTestOutbox(t)
+	keys := saveEvents(t, store, Event{ID: "1"}, Event{ID: "2"})
+	store.items["app1||key1"] = []byte("value")
+	store.items["app2||dapr-outbox-3"] = []byte("{}")
+
+	relay := NewRelay("app1", &fakePubsubAdapter{})
+	events, err := relay.recoverEvents(context.Background(), "store1")
+	require.NoError(t, err)
+	assert.Equal(t, []pendingEvent{
+		{storeName: "store1", key: keys[0]},
+		{storeName: "store1", key: keys[1]},
+	}, events)
+
+	// The pending events can't be listed without the query API.
+	outboxStores["store2"] = outboxStore{store: struct{ state.Store }{store}}
+	_, err = relay.recoverEvents(context.Background(), "store2")
+	assert.Error(t, err)
+}
+
+func TestRelayRun(t *testing.T) {
+	store := newTestOutbox(t)
+	adapter := &fakePubsubAdapter{}
+	relay := NewRelay("app1", adapter)
+
+	// Saved before the relay starts, relayed once recovered.
+	saveEvents(t, store, Event{ID: "1", Data: []byte("hello")})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go relay.Run(ctx)
+
+	assert.Eventually(t, func() bool {
+		return adapter.publishedCount() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	EventsSaved("store1", saveEvents(t, store, Event{ID: "2", Data: []byte("world")}))
+
+	assert.Eventually(t, func() bool {
+		return adapter.publishedCount() == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestRelayScan(t *testing.T) {
+	store := newTestOutbox(t)
+	adapter := &fakePubsubAdapter{}
+	relay := NewRelay("app1", adapter)
+	relay.scanInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go relay.Run(ctx)
+
+	// Saved without notifying the relay, e.g. because the transaction timed out while committing.
+	saveEvents(t, store, Event{ID: "1", Data: []byte("hello")})
+
+	assert.Eventually(t, func() bool {
+		return adapter.publishedCount() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// A state store added after the relay started.
+	store2 := newFakeStateStore()
+	_, err := AddOutboxStateStore("store2", store2, map[string]string{
+		outboxPublishPubsubKey: "pubsub1",
+		outboxPublishTopicKey:  "topic1",
+	})
+	require.NoError(t, err)
+	operations, _, err := Operations("store2", "app1", []Event{{ID: "2", Data: []byte("world")}})
+	require.NoError(t, err)
+	require.NoError(t, store2.Multi(&state.TransactionalStateRequest{Operations: operations}))
+
+	assert.Eventually(t, func() bool {
+		return adapter.publishedCount() == 2
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dapr_credentials "github.com/dapr/dapr/pkg/credentials"
+	auth "github.com/dapr/dapr/pkg/runtime/security"
+)
+
+const (
+	adminStatePath  = "/v1.0/placement/state"
+	adminActorsPath = "/v1.0/placement/actors/"
+)
+
+// AdminState is the read-only view of the placement state served by the admin API.
+type AdminState struct {
+	// TableGeneration is the generation of the consistent hashing tables.
+	TableGeneration uint64 `json:"tableGeneration"`
+	// IsLeader is true when this placement node is the raft leader.
+	IsLeader bool `json:"isLeader"`
+	// Leader is the raft address of the current leader node.
+	Leader string `json:"leader"`
+	// Peers is the list of nodes in the raft cluster.
+	Peers []AdminPeer `json:"peers"`
+	// ActorTypes has the hosts serving each actor type across all namespaces.
+	ActorTypes map[string][]AdminHost `json:"actorTypes"`
+}
+
+// AdminPeer represents a node in the raft cluster.
+type AdminPeer struct {
+	ID      string `json:"id"`
+	Address string `json:"address"`
+}
+
+// AdminHost represents a Dapr runtime host in the hashing tables.
+type AdminHost struct {
+	Name      string `json:"name"`
+	AppID     string `json:"appId"`
+	Namespace string `json:"namespace,omitempty"`
+	Port      int64  `json:"port"`
+	Load      int64  `json:"load"`
+}
+
+// AdminState returns the current hashing tables and raft cluster information.
+func (p *Service) AdminState() (*AdminState, error) {
+	peers, err := p.raftNode.Peers()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &AdminState{
+		TableGeneration: p.raftNode.FSM().State().TableGeneration(),
+		IsLeader:        p.raftNode.IsLeader(),
+		Leader:          p.raftNode.Leader(),
+		Peers:           make([]AdminPeer, len(peers)),
+		ActorTypes:      map[string][]AdminHost{},
+	}
+	for i, peer := range peers {
+		state.Peers[i] = AdminPeer{ID: peer.ID, Address: peer.Address}
+	}
+
+	for _, ns := range p.raftNode.FSM().State().Namespaces() {
+		tables := p.raftNode.FSM().PlacementState(ns)
+		for actorType, table := range tables.Entries {
+			hosts := state.ActorTypes[actorType]
+			for _, h := range table.LoadMap {
+				hosts = append(hosts, AdminHost{
+					Name:      h.Name,
+					AppID:     h.Id,
+					Namespace: h.Namespace,
+					Port:      h.Port,
+					Load:      h.Load,
+				})
+			}
+			state.ActorTypes[actorType] = hosts
+		}
+	}
+	for _, hosts := range state.ActorTypes {
+		sort.Slice(hosts, func(i, j int) bool {
+			if hosts[i].Namespace != hosts[j].Namespace {
+				return hosts[i].Namespace < hosts[j].Namespace
+			}
+			return hosts[i].Name < hosts[j].Name
+		})
+	}
+
+	return state, nil
+}
+
+// AdminServerOptions configures the admin server.
+type AdminServerOptions struct {
+	// Address is the address the admin server listens on.
+	Address string
+	// Port is the port the admin server listens on.
+	Port int
+	// CertChain enables mTLS: clients must present a certificate issued by its root CA.
+	CertChain *dapr_credentials.CertChain
+	// APIToken is the token clients must send in the dapr-api-token header.
+	APIToken string
+}
+
+// RunAdminServer starts a read-only HTTP server exposing the placement state, until the context is canceled.
+// Clients are authenticated with mTLS, with an API token or both: the server doesn't start without either.
+func (p *Service) RunAdminServer(ctx context.Context, opts AdminServerOptions) error {
+	if opts.CertChain == nil && opts.APIToken == "" {
+		return errors.New("the admin server requires mTLS or an API token")
+	}
+
+	srv := &http.Server{
+		Addr:    net.JoinHostPort(opts.Address, strconv.Itoa(opts.Port)),
+		Handler: p.adminHandler(opts.APIToken),
+	}
+	if opts.CertChain != nil {
+		tlsConfig, err := adminTLSConfig(opts.CertChain)
+		if err != nil {
+			return err
+		}
+		srv.TLSConfig = tlsConfig
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Errorf("error while shutting down admin server: %v", err)
+		}
+	}()
+
+	log.Infof("admin server is listening on %s", srv.Addr)
+
+	// Blocking call
+	var err error
+	if srv.TLSConfig != nil {
+		err = srv.ListenAndServeTLS("", "")
+	} else {
+		err = srv.ListenAndServe()
+	}
+	if err != http.ErrServerClosed {
+		return err
+	}
+
+	return nil
+}
+
+// adminTLSConfig returns the TLS config of the admin server, which requires the clients to present a certificate
+// issued by the root CA of the cert chain.
+func adminTLSConfig(certChain *dapr_credentials.CertChain) (*tls.Config, error) {
+	cp := x509.NewCertPool()
+	if !cp.AppendCertsFromPEM(certChain.RootCA) {
+		return nil, errors.New("failed to append PEM root cert to x509 CertPool")
+	}
+
+	cert, err := tls.X509KeyPair(certChain.Cert, certChain.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint:gosec
+	return &tls.Config{
+		ClientCAs: cp,
+		// Require cert verification
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		Certificates: []tls.Certificate{cert},
+	}, nil
+}
+
+func (p *Service) adminHandler(apiToken string) http.Handler {
+	router := http.NewServeMux()
+	router.HandleFunc(adminStatePath, p.onGetAdminState)
+	router.HandleFunc(adminActorsPath, p.onLookupActor)
+	if apiToken == "" {
+		return router
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(auth.APITokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(apiToken)) != 1 {
+			writeAdminError(w, http.StatusUnauthorized, "invalid api token")
+			return
+		}
+		router.ServeHTTP(w, r)
+	})
+}
+
+func (p *Service) onGetAdminState(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	state, err := p.AdminState()
+	if err != nil {
+		writeAdminError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeAdminJSON(w, http.StatusOK, state)
+}
+
+// onLookupActor returns the host owning an actor.
+// The request path is /v1.0/placement/actors/{actorType}/{actorId}?namespace={namespace}.
+func (p *Service) onLookupActor(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, adminActorsPath), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		writeAdminError(w, http.StatusBadRequest, "actor type and actor id are required")
+		return
+	}
+
+	namespace := r.URL.Query().Get("namespace")
+	host, err := p.raftNode.FSM().LookupActor(namespace, parts[0], parts[1])
+	if err != nil {
+		writeAdminError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	writeAdminJSON(w, http.StatusOK, AdminHost{
+		Name:      host.Name,
+		AppID:     host.AppID,
+		Namespace: namespace,
+		Port:      host.Port,
+		Load:      host.Load,
+	})
+}
+
+func writeAdminJSON(w http.ResponseWriter, code int, obj interface{}) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		writeAdminError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(b)
+}
+
+func writeAdminError(w http.ResponseWriter, code int, message string) {
+	b, _ := json.Marshal(map[string]string{"message": message})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(b)
+}
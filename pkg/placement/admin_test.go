@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/placement/raft"
+	auth "github.com/dapr/dapr/pkg/runtime/security"
+)
+
+func TestAdminAPI(t *testing.T) {
+	testServer := NewPlacementService(testRaftServer)
+	handler := testServer.adminHandler("")
+
+	host := raft.DaprHostMember{
+		Name:      "127.0.0.1:50100",
+		AppID:     "adminTestApp",
+		Namespace: "ns1",
+		Entities:  []string{"adminActorType"},
+		UpdatedAt: time.Now().UnixNano(),
+	}
+	_, err := testRaftServer.ApplyCommand(raft.MemberUpsert, host)
+	require.NoError(t, err)
+	defer testRaftServer.ApplyCommand(raft.MemberRemove, raft.DaprHostMember{Name: host.Name})
+
+	t.Run("get state", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, adminStatePath, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var state AdminState
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &state))
+		assert.True(t, state.IsLeader)
+		assert.NotEmpty(t, state.Leader)
+		assert.Equal(t, 1, len(state.Peers))
+		assert.Equal(t, "testnode", state.Peers[0].ID)
+		assert.Equal(t, testRaftServer.FSM().State().TableGeneration(), state.TableGeneration)
+		require.Equal(t, 1, len(state.ActorTypes["adminActorType"]))
+		assert.Equal(t, host.Name, state.ActorTypes["adminActorType"][0].Name)
+		assert.Equal(t, host.AppID, state.ActorTypes["adminActorType"][0].AppID)
+		assert.Equal(t, host.Namespace, state.ActorTypes["adminActorType"][0].Namespace)
+	})
+
+	t.Run("lookup actor", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, adminActorsPath+"adminActorType/actor1?namespace=ns1", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var owner AdminHost
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &owner))
+		assert.Equal(t, host.Name, owner.Name)
+		assert.Equal(t, host.AppID, owner.AppID)
+	})
+
+	t.Run("lookup actor in another namespace", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, adminActorsPath+"adminActorType/actor1?namespace=ns2", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("lookup actor of unknown type", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, adminActorsPath+"unknownActorType/actor1", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("lookup actor without id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, adminActorsPath+"adminActorType", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("state is read-only", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, adminStatePath, nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
+
+func TestAdminAPIAuthentication(t *testing.T) {
+	testServer := NewPlacementService(testRaftServer)
+
+	t.Run("server requires mTLS or a token", func(t *testing.T) {
+		err := testServer.RunAdminServer(context.Background(), AdminServerOptions{
+			Address: "localhost",
+			Port:    0,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("request without token is rejected", func(t *testing.T) {
+		handler := testServer.adminHandler("token1")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, adminStatePath, nil))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("request with invalid token is rejected", func(t *testing.T) {
+		handler := testServer.adminHandler("token1")
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, adminStatePath, nil)
+		req.Header.Set(auth.APITokenHeader, "token2")
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("request with token", func(t *testing.T) {
+		handler := testServer.adminHandler("token1")
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, adminStatePath, nil)
+		req.Header.Set(auth.APITokenHeader, "token1")
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
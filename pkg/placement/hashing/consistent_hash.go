@@ -51,6 +51,8 @@ type Host struct {
 	Port  int64
 	Load  int64
 	AppID string
+	// Namespace is the namespace of the host. Hosts only serve actors of their own namespace.
+	Namespace string
 }
 
 // Consistent represents a data structure for consistent hashing.
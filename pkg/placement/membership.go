@@ -281,18 +281,25 @@ func (p *Service) performTableDissemination() {
 		p.disseminateLock.Lock()
 		defer p.disseminateLock.Unlock()
 
-		state := p.raftNode.FSM().PlacementState()
+		generation := p.raftNode.FSM().State().TableGeneration()
 		log.Infof(
-			"Start disseminating tables. memberUpdateCount: %d, streams: %d, targets: %d, table generation: %s",
-			cnt, nStreamConnPool, nTargetConns, state.Version)
+			"Start disseminating tables. memberUpdateCount: %d, streams: %d, targets: %d, table generation: %d",
+			cnt, nStreamConnPool, nTargetConns, generation)
+
+		// Each Dapr runtime only receives the hashing tables of its own namespace.
 		p.streamConnPoolLock.RLock()
-		streamConnPool := make([]placementGRPCStream, len(p.streamConnPool))
-		copy(streamConnPool, p.streamConnPool)
+		streamConnPools := map[string][]placementGRPCStream{}
+		for _, conn := range p.streamConnPool {
+			ns := p.streamConnNamespace[conn]
+			streamConnPools[ns] = append(streamConnPools[ns], conn)
+		}
 		p.streamConnPoolLock.RUnlock()
-		p.performTablesUpdate(streamConnPool, state)
+		for ns, streamConnPool := range streamConnPools {
+			p.performTablesUpdate(streamConnPool, p.raftNode.FSM().PlacementState(ns))
+		}
 		log.Infof(
-			"Completed dissemination. memberUpdateCount: %d, streams: %d, targets: %d, table generation: %s",
-			cnt, nStreamConnPool, nTargetConns, state.Version)
+			"Completed dissemination. memberUpdateCount: %d, streams: %d, targets: %d, table generation: %d",
+			cnt, nStreamConnPool, nTargetConns, generation)
 		p.memberUpdateCount.Store(0)
 
 		// set faultyHostDetectDuration to the default duration.
@@ -83,6 +83,8 @@ type Service struct {
 	grpcServer *grpc.Server
 	// streamConnPool has the stream connections established between placement gRPC server and Dapr runtime.
 	streamConnPool []placementGRPCStream
+	// streamConnNamespace is the namespace of Dapr runtime for each stream connection.
+	streamConnNamespace map[placementGRPCStream]string
 	// streamConnPoolLock is the lock for streamConnPool change.
 	streamConnPoolLock *sync.RWMutex
 
@@ -122,6 +124,7 @@ func NewPlacementService(raftNode *raft.Server) *Service {
 	return &Service{
 		disseminateLock:          &sync.Mutex{},
 		streamConnPool:           []placementGRPCStream{},
+		streamConnNamespace:      map[placementGRPCStream]string{},
 		streamConnPoolLock:       &sync.RWMutex{},
 		membershipCh:             make(chan hostMemberChange, membershipChangeChSize),
 		faultyHostDetectDuration: atomic.NewInt64(int64(faultyHostDetectInitialDuration)),
@@ -200,10 +203,10 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 		case nil:
 			if registeredMemberID == "" {
 				registeredMemberID = req.Name
-				p.addStreamConn(stream)
+				p.addStreamConn(stream, req.Namespace)
 				// TODO: If each sidecar can report table version, then placement
 				// doesn't need to disseminate tables to each sidecar.
-				p.performTablesUpdate([]placementGRPCStream{stream}, p.raftNode.FSM().PlacementState())
+				p.performTablesUpdate([]placementGRPCStream{stream}, p.raftNode.FSM().PlacementState(req.Namespace))
 				log.Debugf("Stream connection is established from %s", registeredMemberID)
 			}
 
@@ -225,7 +228,7 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 			// the existing member info is unmatched with the incoming member info.
 			upsertRequired := true
 			if m, ok := members[req.Name]; ok {
-				if m.AppID == req.Id && m.Name == req.Name && cmp.Equal(m.Entities, req.Entities) {
+				if m.AppID == req.Id && m.Name == req.Name && m.Namespace == req.Namespace && cmp.Equal(m.Entities, req.Entities) {
 					upsertRequired = false
 				}
 			}
@@ -236,6 +239,7 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 					host: raft.DaprHostMember{
 						Name:      req.Name,
 						AppID:     req.Id,
+						Namespace: req.Namespace,
 						Entities:  req.Entities,
 						UpdatedAt: time.Now().UnixNano(),
 					},
@@ -270,9 +274,10 @@ func (p *Service) ReportDaprStatus(stream placementv1pb.Placement_ReportDaprStat
 }
 
 // addStreamConn adds stream connection between runtime and placement to the dissemination pool.
-func (p *Service) addStreamConn(conn placementGRPCStream) {
+func (p *Service) addStreamConn(conn placementGRPCStream, namespace string) {
 	p.streamConnPoolLock.Lock()
 	p.streamConnPool = append(p.streamConnPool, conn)
+	p.streamConnNamespace[conn] = namespace
 	p.streamConnPoolLock.Unlock()
 }
 
@@ -281,6 +286,7 @@ func (p *Service) deleteStreamConn(conn placementGRPCStream) {
 	for i, c := range p.streamConnPool {
 		if c == conn {
 			p.streamConnPool = append(p.streamConnPool[:i], p.streamConnPool[i+1:]...)
+			delete(p.streamConnNamespace, conn)
 			break
 		}
 	}
@@ -63,8 +63,8 @@ func (c *FSM) State() *DaprHostMemberState {
 	return c.state
 }
 
-// PlacementState returns the current placement tables.
-func (c *FSM) PlacementState() *v1pb.PlacementTables {
+// PlacementState returns the current placement tables of the given namespace.
+func (c *FSM) PlacementState(namespace string) *v1pb.PlacementTables {
 	c.stateLock.RLock()
 	defer c.stateLock.RUnlock()
 
@@ -77,7 +77,7 @@ func (c *FSM) PlacementState() *v1pb.PlacementTables {
 	totalSortedSet := 0
 	totalLoadMap := 0
 
-	entries := c.state.hashingTableMap()
+	entries := c.state.hashingTableMap(namespace)
 	for k, v := range entries {
 		var table v1pb.PlacementTable
 		v.ReadInternals(func(hosts map[uint64]string, sortedSet []uint64, loadMap map[string]*hashing.Host, totalLoad int64) {
@@ -96,10 +96,11 @@ func (c *FSM) PlacementState() *v1pb.PlacementTables {
 
 			for lk, lv := range loadMap {
 				h := v1pb.Host{
-					Name: lv.Name,
-					Load: lv.Load,
-					Port: lv.Port,
-					Id:   lv.AppID,
+					Name:      lv.Name,
+					Load:      lv.Load,
+					Port:      lv.Port,
+					Id:        lv.AppID,
+					Namespace: namespace,
 				}
 				table.LoadMap[lk] = &h
 			}
@@ -117,6 +118,25 @@ func (c *FSM) PlacementState() *v1pb.PlacementTables {
 	return newTable
 }
 
+// LookupActor returns the host which owns the given actor in the namespace
+// according to the current consistent hashing tables.
+func (c *FSM) LookupActor(namespace, actorType, actorID string) (*hashing.Host, error) {
+	c.stateLock.RLock()
+	defer c.stateLock.RUnlock()
+
+	table, ok := c.state.hashingTableMap(namespace)[actorType]
+	if !ok {
+		return nil, errors.Errorf("no hosts found for actor type %s in namespace %q", actorType, namespace)
+	}
+
+	host, err := table.GetHost(actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return hashing.NewHost(host.Name, host.AppID, host.Load, host.Port), nil
+}
+
 func (c *FSM) upsertMember(cmdData []byte) (bool, error) {
 	var host DaprHostMember
 	if err := unmarshalMsgPack(cmdData, &host); err != nil {
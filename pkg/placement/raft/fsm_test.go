@@ -94,7 +94,7 @@ func TestRestore(t *testing.T) {
 	// assert
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(fsm.State().Members()))
-	assert.Equal(t, 2, len(fsm.State().hashingTableMap()))
+	assert.Equal(t, 2, len(fsm.State().hashingTableMap("")))
 }
 
 func TestPlacementState(t *testing.T) {
@@ -114,7 +114,33 @@ func TestPlacementState(t *testing.T) {
 		Data:  cmdLog,
 	})
 
-	newTable := fsm.PlacementState()
+	newTable := fsm.PlacementState("")
 	assert.Equal(t, "1", newTable.Version)
 	assert.Equal(t, 2, len(newTable.Entries))
 }
+
+func TestLookupActor(t *testing.T) {
+	fsm := newFSM()
+	m := DaprHostMember{
+		Name:     "127.0.0.1:3030",
+		AppID:    "fakeAppID",
+		Entities: []string{"actorTypeOne"},
+	}
+	cmdLog, err := makeRaftLogCommand(MemberUpsert, m)
+	assert.NoError(t, err)
+
+	fsm.Apply(&raft.Log{
+		Index: 1,
+		Term:  1,
+		Type:  raft.LogCommand,
+		Data:  cmdLog,
+	})
+
+	host, err := fsm.LookupActor("", "actorTypeOne", "actorID")
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:3030", host.Name)
+	assert.Equal(t, "fakeAppID", host.AppID)
+
+	_, err = fsm.LookupActor("", "unknownActorType", "actorID")
+	assert.Error(t, err)
+}
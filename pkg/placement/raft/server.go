@@ -249,6 +249,31 @@ func (s *Server) IsLeader() bool {
 	return s.raft.State() == raft.Leader
 }
 
+// Leader returns the address of the current leader node.
+// It returns an empty string if there is no current leader.
+func (s *Server) Leader() string {
+	return string(s.raft.Leader())
+}
+
+// Peers returns the nodes of the current raft cluster configuration.
+func (s *Server) Peers() ([]PeerInfo, error) {
+	future := s.raft.GetConfiguration()
+	if err := future.Error(); err != nil {
+		return nil, err
+	}
+
+	servers := future.Configuration().Servers
+	peers := make([]PeerInfo, len(servers))
+	for i, srv := range servers {
+		peers[i] = PeerInfo{
+			ID:      string(srv.ID),
+			Address: string(srv.Address),
+		}
+	}
+
+	return peers, nil
+}
+
 // ApplyCommand applies command log to state machine to upsert or remove members.
 func (s *Server) ApplyCommand(cmdType CommandType, data DaprHostMember) (bool, error) {
 	if !s.IsLeader() {
@@ -29,6 +29,8 @@ type DaprHostMember struct {
 	Name string
 	// AppID is Dapr runtime app ID.
 	AppID string
+	// Namespace is the namespace of Dapr runtime host. Actor types are isolated per namespace.
+	Namespace string
 	// Entities is the list of Actor Types which this Dapr runtime supports.
 	Entities []string
 
@@ -47,10 +49,10 @@ type DaprHostMemberStateData struct {
 	TableGeneration uint64
 
 	// hashingTableMap is the map for storing consistent hashing data
-	// per Actor types. This will be generated when log entries are replayed.
+	// per namespace and Actor types. This will be generated when log entries are replayed.
 	// While snapshotting the state, this member will not be saved. Instead,
 	// hashingTableMap will be recovered in snapshot recovery process.
-	hashingTableMap map[string]*hashing.Consistent
+	hashingTableMap map[string]map[string]*hashing.Consistent
 }
 
 // DaprHostMemberState is the state to store Dapr runtime host and
@@ -67,7 +69,7 @@ func newDaprHostMemberState() *DaprHostMemberState {
 			Index:           0,
 			TableGeneration: 0,
 			Members:         map[string]*DaprHostMember{},
-			hashingTableMap: map[string]*hashing.Consistent{},
+			hashingTableMap: map[string]map[string]*hashing.Consistent{},
 		},
 	}
 }
@@ -97,11 +99,23 @@ func (s *DaprHostMemberState) TableGeneration() uint64 {
 	return s.data.TableGeneration
 }
 
-func (s *DaprHostMemberState) hashingTableMap() map[string]*hashing.Consistent {
+// Namespaces returns the namespaces which have consistent hashing tables.
+func (s *DaprHostMemberState) Namespaces() []string {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
-	return s.data.hashingTableMap
+	namespaces := make([]string, 0, len(s.data.hashingTableMap))
+	for ns := range s.data.hashingTableMap {
+		namespaces = append(namespaces, ns)
+	}
+	return namespaces
+}
+
+func (s *DaprHostMemberState) hashingTableMap(namespace string) map[string]*hashing.Consistent {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.data.hashingTableMap[namespace]
 }
 
 func (s *DaprHostMemberState) clone() *DaprHostMemberState {
@@ -120,6 +134,7 @@ func (s *DaprHostMemberState) clone() *DaprHostMemberState {
 		m := &DaprHostMember{
 			Name:      v.Name,
 			AppID:     v.AppID,
+			Namespace: v.Namespace,
 			Entities:  make([]string, len(v.Entities)),
 			UpdatedAt: v.UpdatedAt,
 		}
@@ -131,28 +146,43 @@ func (s *DaprHostMemberState) clone() *DaprHostMemberState {
 
 // caller should holds lock.
 func (s *DaprHostMemberState) updateHashingTables(host *DaprHostMember) {
+	tables, ok := s.data.hashingTableMap[host.Namespace]
+	if !ok {
+		tables = map[string]*hashing.Consistent{}
+		s.data.hashingTableMap[host.Namespace] = tables
+	}
+
 	for _, e := range host.Entities {
-		if _, ok := s.data.hashingTableMap[e]; !ok {
-			s.data.hashingTableMap[e] = hashing.NewConsistentHash()
+		if _, ok := tables[e]; !ok {
+			tables[e] = hashing.NewConsistentHash()
 		}
 
-		s.data.hashingTableMap[e].Add(host.Name, host.AppID, 0)
+		tables[e].Add(host.Name, host.AppID, 0)
 	}
 }
 
 // caller should holds lock.
 func (s *DaprHostMemberState) removeHashingTables(host *DaprHostMember) {
+	tables, ok := s.data.hashingTableMap[host.Namespace]
+	if !ok {
+		return
+	}
+
 	for _, e := range host.Entities {
-		if t, ok := s.data.hashingTableMap[e]; ok {
+		if t, ok := tables[e]; ok {
 			t.Remove(host.Name)
 
 			// if no dedicated actor service instance for the particular actor type,
 			// we must delete consistent hashing table to avoid the memory leak.
 			if len(t.Hosts()) == 0 {
-				delete(s.data.hashingTableMap, e)
+				delete(tables, e)
 			}
 		}
 	}
+
+	if len(tables) == 0 {
+		delete(s.data.hashingTableMap, host.Namespace)
+	}
 }
 
 // upsertMember upserts member host info to the FSM state and returns true
@@ -167,7 +197,7 @@ func (s *DaprHostMemberState) upsertMember(host *DaprHostMember) bool {
 
 	if m, ok := s.data.Members[host.Name]; ok {
 		// No need to update consistent hashing table if the same dapr host member exists
-		if m.AppID == host.AppID && m.Name == host.Name && cmp.Equal(m.Entities, host.Entities) {
+		if m.AppID == host.AppID && m.Name == host.Name && m.Namespace == host.Namespace && cmp.Equal(m.Entities, host.Entities) {
 			m.UpdatedAt = host.UpdatedAt
 			return false
 		}
@@ -180,6 +210,7 @@ func (s *DaprHostMemberState) upsertMember(host *DaprHostMember) bool {
 	s.data.Members[host.Name] = &DaprHostMember{
 		Name:      host.Name,
 		AppID:     host.AppID,
+		Namespace: host.Namespace,
 		UpdatedAt: host.UpdatedAt,
 	}
 
@@ -220,7 +251,7 @@ func (s *DaprHostMemberState) isActorHost(host *DaprHostMember) bool {
 // caller should holds lock.
 func (s *DaprHostMemberState) restoreHashingTables() {
 	if s.data.hashingTableMap == nil {
-		s.data.hashingTableMap = map[string]*hashing.Consistent{}
+		s.data.hashingTableMap = map[string]map[string]*hashing.Consistent{}
 	}
 
 	for _, m := range s.data.Members {
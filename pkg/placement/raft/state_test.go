@@ -26,7 +26,7 @@ func TestNewDaprHostMemberState(t *testing.T) {
 	// assert
 	assert.Equal(t, uint64(0), s.Index())
 	assert.Equal(t, 0, len(s.Members()))
-	assert.Equal(t, 0, len(s.hashingTableMap()))
+	assert.Equal(t, 0, len(s.hashingTableMap("")))
 }
 
 func TestClone(t *testing.T) {
@@ -43,7 +43,7 @@ func TestClone(t *testing.T) {
 
 	// assert
 	assert.NotSame(t, s, newState)
-	assert.Nil(t, newState.hashingTableMap())
+	assert.Nil(t, newState.hashingTableMap(""))
 	assert.Equal(t, s.Index(), newState.Index())
 	assert.EqualValues(t, s.Members(), newState.Members())
 }
@@ -63,7 +63,7 @@ func TestUpsertMember(t *testing.T) {
 
 		// assert
 		assert.Equal(t, 1, len(s.Members()))
-		assert.Equal(t, 2, len(s.hashingTableMap()))
+		assert.Equal(t, 2, len(s.hashingTableMap("")))
 		assert.True(t, updated)
 	})
 
@@ -78,7 +78,7 @@ func TestUpsertMember(t *testing.T) {
 
 		// assert
 		assert.Equal(t, 2, len(s.Members()))
-		assert.Equal(t, 2, len(s.hashingTableMap()))
+		assert.Equal(t, 2, len(s.hashingTableMap("")))
 		assert.True(t, updated)
 
 		// act
@@ -125,10 +125,41 @@ func TestUpsertMember(t *testing.T) {
 		assert.Equal(t, 2, len(s.Members()))
 		assert.True(t, updated)
 		assert.Equal(t, 1, len(s.Members()[testMember.Name].Entities))
-		assert.Equal(t, 3, len(s.hashingTableMap()), "this doesn't delete empty consistent hashing table")
+		assert.Equal(t, 3, len(s.hashingTableMap("")), "this doesn't delete empty consistent hashing table")
 	})
 }
 
+func TestUpsertMemberNamespaces(t *testing.T) {
+	// arrange
+	s := newDaprHostMemberState()
+
+	// act
+	s.upsertMember(&DaprHostMember{
+		Name:      "127.0.0.1:8080",
+		AppID:     "FakeID",
+		Namespace: "ns1",
+		Entities:  []string{"actorTypeOne"},
+	})
+	s.upsertMember(&DaprHostMember{
+		Name:      "127.0.0.1:8081",
+		AppID:     "FakeID",
+		Namespace: "ns2",
+		Entities:  []string{"actorTypeOne"},
+	})
+
+	// assert
+	assert.ElementsMatch(t, []string{"ns1", "ns2"}, s.Namespaces())
+	assert.Equal(t, []string{"127.0.0.1:8080"}, s.hashingTableMap("ns1")["actorTypeOne"].Hosts())
+	assert.Equal(t, []string{"127.0.0.1:8081"}, s.hashingTableMap("ns2")["actorTypeOne"].Hosts())
+
+	// act
+	s.removeMember(&DaprHostMember{Name: "127.0.0.1:8080"})
+
+	// assert
+	assert.Equal(t, []string{"ns2"}, s.Namespaces())
+	assert.Nil(t, s.hashingTableMap("ns1"))
+}
+
 func TestRemoveMember(t *testing.T) {
 	// arrange
 	s := newDaprHostMemberState()
@@ -144,7 +175,7 @@ func TestRemoveMember(t *testing.T) {
 		// assert
 		assert.Equal(t, 1, len(s.Members()))
 		assert.True(t, updated)
-		assert.Equal(t, 2, len(s.hashingTableMap()))
+		assert.Equal(t, 2, len(s.hashingTableMap("")))
 
 		// act
 		updated = s.removeMember(&DaprHostMember{
@@ -154,7 +185,7 @@ func TestRemoveMember(t *testing.T) {
 		// assert
 		assert.Equal(t, 0, len(s.Members()))
 		assert.True(t, updated)
-		assert.Equal(t, 0, len(s.hashingTableMap()))
+		assert.Equal(t, 0, len(s.hashingTableMap("")))
 	})
 
 	t.Run("no table update required", func(t *testing.T) {
@@ -166,7 +197,7 @@ func TestRemoveMember(t *testing.T) {
 		// assert
 		assert.Equal(t, 0, len(s.Members()))
 		assert.False(t, updated)
-		assert.Equal(t, 0, len(s.hashingTableMap()))
+		assert.Equal(t, 0, len(s.hashingTableMap("")))
 	})
 }
 
@@ -186,9 +217,9 @@ func TestUpdateHashingTable(t *testing.T) {
 		// act
 		s.updateHashingTables(testMember)
 
-		assert.Equal(t, 2, len(s.hashingTableMap()))
+		assert.Equal(t, 2, len(s.hashingTableMap("")))
 		for _, ent := range testMember.Entities {
-			assert.NotNil(t, s.hashingTableMap()[ent])
+			assert.NotNil(t, s.hashingTableMap("")[ent])
 		}
 	})
 
@@ -202,9 +233,9 @@ func TestUpdateHashingTable(t *testing.T) {
 		// act
 		s.updateHashingTables(testMember)
 
-		assert.Equal(t, 3, len(s.hashingTableMap()))
+		assert.Equal(t, 3, len(s.hashingTableMap("")))
 		for _, ent := range testMember.Entities {
-			assert.NotNil(t, s.hashingTableMap()[ent])
+			assert.NotNil(t, s.hashingTableMap("")[ent])
 		}
 	})
 }
@@ -239,7 +270,7 @@ func TestRemoveHashingTable(t *testing.T) {
 			testMember.Name = tc.name
 			s.removeHashingTables(testMember)
 
-			assert.Equal(t, tc.totalTable, len(s.hashingTableMap()))
+			assert.Equal(t, tc.totalTable, len(s.hashingTableMap("")))
 		})
 	}
 }
@@ -267,11 +298,11 @@ func TestRestoreHashingTables(t *testing.T) {
 		}
 		s.lock.Unlock()
 	}
-	assert.Equal(t, 0, len(s.hashingTableMap()))
+	assert.Equal(t, 0, len(s.hashingTableMap("")))
 
 	// act
 	s.restoreHashingTables()
 
 	// assert
-	assert.Equal(t, 2, len(s.hashingTableMap()))
+	assert.Equal(t, 2, len(s.hashingTableMap("")))
 }
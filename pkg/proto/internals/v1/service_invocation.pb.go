@@ -296,6 +296,180 @@ func (x *ListStringValue) GetValues() []string {
 	return nil
 }
 
+// StreamPayload is a chunk of the data of a streamed request or response.
+type StreamPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Data sent in the chunk.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Sequence number of the chunk, starting at 0.
+	Seq uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (x *StreamPayload) Reset() {
+	*x = StreamPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_internals_v1_service_invocation_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPayload) ProtoMessage() {}
+
+func (x *StreamPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_internals_v1_service_invocation_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPayload.ProtoReflect.Descriptor instead.
+func (*StreamPayload) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_internals_v1_service_invocation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamPayload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StreamPayload) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// InternalInvokeRequestStream is a chunk of a request sent with CallLocalStream.
+type InternalInvokeRequestStream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Request details, only set in the first message of the stream.
+	Request *InternalInvokeRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// Chunk of the request data.
+	Payload *StreamPayload `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *InternalInvokeRequestStream) Reset() {
+	*x = InternalInvokeRequestStream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_internals_v1_service_invocation_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InternalInvokeRequestStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalInvokeRequestStream) ProtoMessage() {}
+
+func (x *InternalInvokeRequestStream) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_internals_v1_service_invocation_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalInvokeRequestStream.ProtoReflect.Descriptor instead.
+func (*InternalInvokeRequestStream) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_internals_v1_service_invocation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InternalInvokeRequestStream) GetRequest() *InternalInvokeRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *InternalInvokeRequestStream) GetPayload() *StreamPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// InternalInvokeResponseStream is a chunk of a response sent with CallLocalStream.
+type InternalInvokeResponseStream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Response details, only set in the first message of the stream.
+	Response *InternalInvokeResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// Chunk of the response data.
+	Payload *StreamPayload `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *InternalInvokeResponseStream) Reset() {
+	*x = InternalInvokeResponseStream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dapr_proto_internals_v1_service_invocation_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InternalInvokeResponseStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalInvokeResponseStream) ProtoMessage() {}
+
+func (x *InternalInvokeResponseStream) ProtoReflect() protoreflect.Message {
+	mi := &file_dapr_proto_internals_v1_service_invocation_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalInvokeResponseStream.ProtoReflect.Descriptor instead.
+func (*InternalInvokeResponseStream) Descriptor() ([]byte, []int) {
+	return file_dapr_proto_internals_v1_service_invocation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InternalInvokeResponseStream) GetResponse() *InternalInvokeResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *InternalInvokeResponseStream) GetPayload() *StreamPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
 var File_dapr_proto_internals_v1_service_invocation_proto protoreflect.FileDescriptor
 
 var file_dapr_proto_internals_v1_service_invocation_proto_rawDesc = []byte{
@@ -375,26 +549,60 @@ var file_dapr_proto_internals_v1_service_invocation_proto_rawDesc = []byte{
 	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29, 0x0a, 0x0f,
 	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
 	0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x32, 0xf3, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6e, 0x0a,
-	0x09, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x2e, 0x64, 0x61, 0x70,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76,
-	0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x61, 0x70,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76,
-	0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a,
-	0x09, 0x43, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x2e, 0x2e, 0x64, 0x61, 0x70,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76,
-	0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x64, 0x61, 0x70,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0xa9,
+	0x01, 0x0a, 0x1b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x6b,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x48,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x70, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x1c, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x4b, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x70, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x32, 0xfa, 0x02, 0x0a, 0x11, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x6e, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6e, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x2e, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x84, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x34, 0x2e, 0x64, 0x61, 0x70, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a, 0x35, 0x2e, 0x64, 0x61, 0x70,
 	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
 	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76,
-	0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x37, 0x5a,
-	0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72,
-	0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f, 0x64, 0x61, 0x70, 0x72, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -409,41 +617,50 @@ func file_dapr_proto_internals_v1_service_invocation_proto_rawDescGZIP() []byte
 	return file_dapr_proto_internals_v1_service_invocation_proto_rawDescData
 }
 
-var file_dapr_proto_internals_v1_service_invocation_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_dapr_proto_internals_v1_service_invocation_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_dapr_proto_internals_v1_service_invocation_proto_goTypes = []interface{}{
-	(*Actor)(nil),                  // 0: dapr.proto.internals.v1.Actor
-	(*InternalInvokeRequest)(nil),  // 1: dapr.proto.internals.v1.InternalInvokeRequest
-	(*InternalInvokeResponse)(nil), // 2: dapr.proto.internals.v1.InternalInvokeResponse
-	(*ListStringValue)(nil),        // 3: dapr.proto.internals.v1.ListStringValue
-	nil,                            // 4: dapr.proto.internals.v1.InternalInvokeRequest.MetadataEntry
-	nil,                            // 5: dapr.proto.internals.v1.InternalInvokeResponse.HeadersEntry
-	nil,                            // 6: dapr.proto.internals.v1.InternalInvokeResponse.TrailersEntry
-	(APIVersion)(0),                // 7: dapr.proto.internals.v1.APIVersion
-	(*v1.InvokeRequest)(nil),       // 8: dapr.proto.common.v1.InvokeRequest
-	(*Status)(nil),                 // 9: dapr.proto.internals.v1.Status
-	(*v1.InvokeResponse)(nil),      // 10: dapr.proto.common.v1.InvokeResponse
+	(*Actor)(nil),                        // 0: dapr.proto.internals.v1.Actor
+	(*InternalInvokeRequest)(nil),        // 1: dapr.proto.internals.v1.InternalInvokeRequest
+	(*InternalInvokeResponse)(nil),       // 2: dapr.proto.internals.v1.InternalInvokeResponse
+	(*ListStringValue)(nil),              // 3: dapr.proto.internals.v1.ListStringValue
+	(*StreamPayload)(nil),                // 4: dapr.proto.internals.v1.StreamPayload
+	(*InternalInvokeRequestStream)(nil),  // 5: dapr.proto.internals.v1.InternalInvokeRequestStream
+	(*InternalInvokeResponseStream)(nil), // 6: dapr.proto.internals.v1.InternalInvokeResponseStream
+	nil,                                  // 7: dapr.proto.internals.v1.InternalInvokeRequest.MetadataEntry
+	nil,                                  // 8: dapr.proto.internals.v1.InternalInvokeResponse.HeadersEntry
+	nil,                                  // 9: dapr.proto.internals.v1.InternalInvokeResponse.TrailersEntry
+	(APIVersion)(0),                      // 10: dapr.proto.internals.v1.APIVersion
+	(*v1.InvokeRequest)(nil),             // 11: dapr.proto.common.v1.InvokeRequest
+	(*Status)(nil),                       // 12: dapr.proto.internals.v1.Status
+	(*v1.InvokeResponse)(nil),            // 13: dapr.proto.common.v1.InvokeResponse
 }
 var file_dapr_proto_internals_v1_service_invocation_proto_depIdxs = []int32{
-	7,  // 0: dapr.proto.internals.v1.InternalInvokeRequest.ver:type_name -> dapr.proto.internals.v1.APIVersion
-	4,  // 1: dapr.proto.internals.v1.InternalInvokeRequest.metadata:type_name -> dapr.proto.internals.v1.InternalInvokeRequest.MetadataEntry
-	8,  // 2: dapr.proto.internals.v1.InternalInvokeRequest.message:type_name -> dapr.proto.common.v1.InvokeRequest
+	10, // 0: dapr.proto.internals.v1.InternalInvokeRequest.ver:type_name -> dapr.proto.internals.v1.APIVersion
+	7,  // 1: dapr.proto.internals.v1.InternalInvokeRequest.metadata:type_name -> dapr.proto.internals.v1.InternalInvokeRequest.MetadataEntry
+	11, // 2: dapr.proto.internals.v1.InternalInvokeRequest.message:type_name -> dapr.proto.common.v1.InvokeRequest
 	0,  // 3: dapr.proto.internals.v1.InternalInvokeRequest.actor:type_name -> dapr.proto.internals.v1.Actor
-	9,  // 4: dapr.proto.internals.v1.InternalInvokeResponse.status:type_name -> dapr.proto.internals.v1.Status
-	5,  // 5: dapr.proto.internals.v1.InternalInvokeResponse.headers:type_name -> dapr.proto.internals.v1.InternalInvokeResponse.HeadersEntry
-	6,  // 6: dapr.proto.internals.v1.InternalInvokeResponse.trailers:type_name -> dapr.proto.internals.v1.InternalInvokeResponse.TrailersEntry
-	10, // 7: dapr.proto.internals.v1.InternalInvokeResponse.message:type_name -> dapr.proto.common.v1.InvokeResponse
-	3,  // 8: dapr.proto.internals.v1.InternalInvokeRequest.MetadataEntry.value:type_name -> dapr.proto.internals.v1.ListStringValue
-	3,  // 9: dapr.proto.internals.v1.InternalInvokeResponse.HeadersEntry.value:type_name -> dapr.proto.internals.v1.ListStringValue
-	3,  // 10: dapr.proto.internals.v1.InternalInvokeResponse.TrailersEntry.value:type_name -> dapr.proto.internals.v1.ListStringValue
-	1,  // 11: dapr.proto.internals.v1.ServiceInvocation.CallActor:input_type -> dapr.proto.internals.v1.InternalInvokeRequest
-	1,  // 12: dapr.proto.internals.v1.ServiceInvocation.CallLocal:input_type -> dapr.proto.internals.v1.InternalInvokeRequest
-	2,  // 13: dapr.proto.internals.v1.ServiceInvocation.CallActor:output_type -> dapr.proto.internals.v1.InternalInvokeResponse
-	2,  // 14: dapr.proto.internals.v1.ServiceInvocation.CallLocal:output_type -> dapr.proto.internals.v1.InternalInvokeResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	12, // 4: dapr.proto.internals.v1.InternalInvokeResponse.status:type_name -> dapr.proto.internals.v1.Status
+	8,  // 5: dapr.proto.internals.v1.InternalInvokeResponse.headers:type_name -> dapr.proto.internals.v1.InternalInvokeResponse.HeadersEntry
+	9,  // 6: dapr.proto.internals.v1.InternalInvokeResponse.trailers:type_name -> dapr.proto.internals.v1.InternalInvokeResponse.TrailersEntry
+	13, // 7: dapr.proto.internals.v1.InternalInvokeResponse.message:type_name -> dapr.proto.common.v1.InvokeResponse
+	1,  // 8: dapr.proto.internals.v1.InternalInvokeRequestStream.request:type_name -> dapr.proto.internals.v1.InternalInvokeRequest
+	4,  // 9: dapr.proto.internals.v1.InternalInvokeRequestStream.payload:type_name -> dapr.proto.internals.v1.StreamPayload
+	2,  // 10: dapr.proto.internals.v1.InternalInvokeResponseStream.response:type_name -> dapr.proto.internals.v1.InternalInvokeResponse
+	4,  // 11: dapr.proto.internals.v1.InternalInvokeResponseStream.payload:type_name -> dapr.proto.internals.v1.StreamPayload
+	3,  // 12: dapr.proto.internals.v1.InternalInvokeRequest.MetadataEntry.value:type_name -> dapr.proto.internals.v1.ListStringValue
+	3,  // 13: dapr.proto.internals.v1.InternalInvokeResponse.HeadersEntry.value:type_name -> dapr.proto.internals.v1.ListStringValue
+	3,  // 14: dapr.proto.internals.v1.InternalInvokeResponse.TrailersEntry.value:type_name -> dapr.proto.internals.v1.ListStringValue
+	1,  // 15: dapr.proto.internals.v1.ServiceInvocation.CallActor:input_type -> dapr.proto.internals.v1.InternalInvokeRequest
+	1,  // 16: dapr.proto.internals.v1.ServiceInvocation.CallLocal:input_type -> dapr.proto.internals.v1.InternalInvokeRequest
+	5,  // 17: dapr.proto.internals.v1.ServiceInvocation.CallLocalStream:input_type -> dapr.proto.internals.v1.InternalInvokeRequestStream
+	2,  // 18: dapr.proto.internals.v1.ServiceInvocation.CallActor:output_type -> dapr.proto.internals.v1.InternalInvokeResponse
+	2,  // 19: dapr.proto.internals.v1.ServiceInvocation.CallLocal:output_type -> dapr.proto.internals.v1.InternalInvokeResponse
+	6,  // 20: dapr.proto.internals.v1.ServiceInvocation.CallLocalStream:output_type -> dapr.proto.internals.v1.InternalInvokeResponseStream
+	18, // [18:21] is the sub-list for method output_type
+	15, // [15:18] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_dapr_proto_internals_v1_service_invocation_proto_init() }
@@ -502,6 +719,42 @@ func file_dapr_proto_internals_v1_service_invocation_proto_init() {
 				return nil
 			}
 		}
+		file_dapr_proto_internals_v1_service_invocation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_internals_v1_service_invocation_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternalInvokeRequestStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dapr_proto_internals_v1_service_invocation_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternalInvokeResponseStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -509,7 +762,7 @@ func file_dapr_proto_internals_v1_service_invocation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dapr_proto_internals_v1_service_invocation_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
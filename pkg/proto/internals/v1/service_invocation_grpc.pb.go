@@ -22,6 +22,14 @@ type ServiceInvocationClient interface {
 	CallActor(ctx context.Context, in *InternalInvokeRequest, opts ...grpc.CallOption) (*InternalInvokeResponse, error)
 	// Invokes a method of the specific service.
 	CallLocal(ctx context.Context, in *InternalInvokeRequest, opts ...grpc.CallOption) (*InternalInvokeResponse, error)
+	// Invokes a method of the specific service, streaming the request and
+	// response payloads in chunks.
+	//
+	// The first message sent by the caller contains the request, without the
+	// data. The data is then sent in the payload of the following messages.
+	// The callee replies with the response, without the data, followed by
+	// the response data in the same way.
+	CallLocalStream(ctx context.Context, opts ...grpc.CallOption) (ServiceInvocation_CallLocalStreamClient, error)
 }
 
 type serviceInvocationClient struct {
@@ -50,6 +58,37 @@ func (c *serviceInvocationClient) CallLocal(ctx context.Context, in *InternalInv
 	return out, nil
 }
 
+func (c *serviceInvocationClient) CallLocalStream(ctx context.Context, opts ...grpc.CallOption) (ServiceInvocation_CallLocalStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ServiceInvocation_ServiceDesc.Streams[0], "/dapr.proto.internals.v1.ServiceInvocation/CallLocalStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceInvocationCallLocalStreamClient{stream}
+	return x, nil
+}
+
+type ServiceInvocation_CallLocalStreamClient interface {
+	Send(*InternalInvokeRequestStream) error
+	Recv() (*InternalInvokeResponseStream, error)
+	grpc.ClientStream
+}
+
+type serviceInvocationCallLocalStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceInvocationCallLocalStreamClient) Send(m *InternalInvokeRequestStream) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serviceInvocationCallLocalStreamClient) Recv() (*InternalInvokeResponseStream, error) {
+	m := new(InternalInvokeResponseStream)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceInvocationServer is the server API for ServiceInvocation service.
 // All implementations should embed UnimplementedServiceInvocationServer
 // for forward compatibility
@@ -58,6 +97,14 @@ type ServiceInvocationServer interface {
 	CallActor(context.Context, *InternalInvokeRequest) (*InternalInvokeResponse, error)
 	// Invokes a method of the specific service.
 	CallLocal(context.Context, *InternalInvokeRequest) (*InternalInvokeResponse, error)
+	// Invokes a method of the specific service, streaming the request and
+	// response payloads in chunks.
+	//
+	// The first message sent by the caller contains the request, without the
+	// data. The data is then sent in the payload of the following messages.
+	// The callee replies with the response, without the data, followed by
+	// the response data in the same way.
+	CallLocalStream(ServiceInvocation_CallLocalStreamServer) error
 }
 
 // UnimplementedServiceInvocationServer should be embedded to have forward compatible implementations.
@@ -70,6 +117,9 @@ func (UnimplementedServiceInvocationServer) CallActor(context.Context, *Internal
 func (UnimplementedServiceInvocationServer) CallLocal(context.Context, *InternalInvokeRequest) (*InternalInvokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CallLocal not implemented")
 }
+func (UnimplementedServiceInvocationServer) CallLocalStream(ServiceInvocation_CallLocalStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method CallLocalStream not implemented")
+}
 
 // UnsafeServiceInvocationServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ServiceInvocationServer will
@@ -118,6 +168,32 @@ func _ServiceInvocation_CallLocal_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServiceInvocation_CallLocalStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServiceInvocationServer).CallLocalStream(&serviceInvocationCallLocalStreamServer{stream})
+}
+
+type ServiceInvocation_CallLocalStreamServer interface {
+	Send(*InternalInvokeResponseStream) error
+	Recv() (*InternalInvokeRequestStream, error)
+	grpc.ServerStream
+}
+
+type serviceInvocationCallLocalStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceInvocationCallLocalStreamServer) Send(m *InternalInvokeResponseStream) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serviceInvocationCallLocalStreamServer) Recv() (*InternalInvokeRequestStream, error) {
+	m := new(InternalInvokeRequestStream)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceInvocation_ServiceDesc is the grpc.ServiceDesc for ServiceInvocation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -134,6 +210,13 @@ var ServiceInvocation_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ServiceInvocation_CallLocal_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CallLocalStream",
+			Handler:       _ServiceInvocation_CallLocalStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "dapr/proto/internals/v1/service_invocation.proto",
 }
@@ -30,6 +30,8 @@ type DaprClient interface {
 	SaveState(ctx context.Context, in *SaveStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Queries the state.
 	QueryStateAlpha1(ctx context.Context, in *QueryStateRequest, opts ...grpc.CallOption) (*QueryStateResponse, error)
+	// Starts rewriting the values of an encrypted state store with its primary encryption key.
+	ReEncryptStateAlpha1(ctx context.Context, in *ReEncryptStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Deletes the state for a specific key.
 	DeleteState(ctx context.Context, in *DeleteStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Deletes a bulk of state items for a list of keys
@@ -38,6 +40,8 @@ type DaprClient interface {
 	ExecuteStateTransaction(ctx context.Context, in *ExecuteStateTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Publishes events to the specific topic.
 	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Bulk Publishes multiple events to the specified topic.
+	BulkPublishEvent(ctx context.Context, in *BulkPublishRequest, opts ...grpc.CallOption) (*BulkPublishResponse, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -54,6 +58,10 @@ type DaprClient interface {
 	UnregisterActorReminder(ctx context.Context, in *UnregisterActorReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Rename an actor reminder.
 	RenameActorReminder(ctx context.Context, in *RenameActorReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the reminders of an actor type, optionally filtered by actor id.
+	ListActorReminders(ctx context.Context, in *ListActorRemindersRequest, opts ...grpc.CallOption) (*ListActorRemindersResponse, error)
+	// Deletes all the reminders of an actor.
+	DeleteActorReminders(ctx context.Context, in *DeleteActorRemindersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Gets the state for a specific actor.
 	GetActorState(ctx context.Context, in *GetActorStateRequest, opts ...grpc.CallOption) (*GetActorStateResponse, error)
 	// Executes state transactions for a specified actor
@@ -131,6 +139,15 @@ func (c *daprClient) QueryStateAlpha1(ctx context.Context, in *QueryStateRequest
 	return out, nil
 }
 
+func (c *daprClient) ReEncryptStateAlpha1(ctx context.Context, in *ReEncryptStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/ReEncryptStateAlpha1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) DeleteState(ctx context.Context, in *DeleteStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/DeleteState", in, out, opts...)
@@ -167,6 +184,15 @@ func (c *daprClient) PublishEvent(ctx context.Context, in *PublishEventRequest,
 	return out, nil
 }
 
+func (c *daprClient) BulkPublishEvent(ctx context.Context, in *BulkPublishRequest, opts ...grpc.CallOption) (*BulkPublishResponse, error) {
+	out := new(BulkPublishResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/BulkPublishEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) InvokeBinding(ctx context.Context, in *InvokeBindingRequest, opts ...grpc.CallOption) (*InvokeBindingResponse, error) {
 	out := new(InvokeBindingResponse)
 	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/InvokeBinding", in, out, opts...)
@@ -239,6 +265,24 @@ func (c *daprClient) RenameActorReminder(ctx context.Context, in *RenameActorRem
 	return out, nil
 }
 
+func (c *daprClient) ListActorReminders(ctx context.Context, in *ListActorRemindersRequest, opts ...grpc.CallOption) (*ListActorRemindersResponse, error) {
+	out := new(ListActorRemindersResponse)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/ListActorReminders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daprClient) DeleteActorReminders(ctx context.Context, in *DeleteActorRemindersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/DeleteActorReminders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daprClient) GetActorState(ctx context.Context, in *GetActorStateRequest, opts ...grpc.CallOption) (*GetActorStateResponse, error) {
 	out := new(GetActorStateResponse)
 	err := c.cc.Invoke(ctx, "/dapr.proto.runtime.v1.Dapr/GetActorState", in, out, opts...)
@@ -375,6 +419,8 @@ type DaprServer interface {
 	SaveState(context.Context, *SaveStateRequest) (*emptypb.Empty, error)
 	// Queries the state.
 	QueryStateAlpha1(context.Context, *QueryStateRequest) (*QueryStateResponse, error)
+	// Starts rewriting the values of an encrypted state store with its primary encryption key.
+	ReEncryptStateAlpha1(context.Context, *ReEncryptStateRequest) (*emptypb.Empty, error)
 	// Deletes the state for a specific key.
 	DeleteState(context.Context, *DeleteStateRequest) (*emptypb.Empty, error)
 	// Deletes a bulk of state items for a list of keys
@@ -383,6 +429,8 @@ type DaprServer interface {
 	ExecuteStateTransaction(context.Context, *ExecuteStateTransactionRequest) (*emptypb.Empty, error)
 	// Publishes events to the specific topic.
 	PublishEvent(context.Context, *PublishEventRequest) (*emptypb.Empty, error)
+	// Bulk Publishes multiple events to the specified topic.
+	BulkPublishEvent(context.Context, *BulkPublishRequest) (*BulkPublishResponse, error)
 	// Invokes binding data to specific output bindings
 	InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error)
 	// Gets secrets from secret stores.
@@ -399,6 +447,10 @@ type DaprServer interface {
 	UnregisterActorReminder(context.Context, *UnregisterActorReminderRequest) (*emptypb.Empty, error)
 	// Rename an actor reminder.
 	RenameActorReminder(context.Context, *RenameActorReminderRequest) (*emptypb.Empty, error)
+	// Lists the reminders of an actor type, optionally filtered by actor id.
+	ListActorReminders(context.Context, *ListActorRemindersRequest) (*ListActorRemindersResponse, error)
+	// Deletes all the reminders of an actor.
+	DeleteActorReminders(context.Context, *DeleteActorRemindersRequest) (*emptypb.Empty, error)
 	// Gets the state for a specific actor.
 	GetActorState(context.Context, *GetActorStateRequest) (*GetActorStateResponse, error)
 	// Executes state transactions for a specified actor
@@ -442,6 +494,9 @@ func (UnimplementedDaprServer) SaveState(context.Context, *SaveStateRequest) (*e
 func (UnimplementedDaprServer) QueryStateAlpha1(context.Context, *QueryStateRequest) (*QueryStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryStateAlpha1 not implemented")
 }
+func (UnimplementedDaprServer) ReEncryptStateAlpha1(context.Context, *ReEncryptStateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReEncryptStateAlpha1 not implemented")
+}
 func (UnimplementedDaprServer) DeleteState(context.Context, *DeleteStateRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteState not implemented")
 }
@@ -454,6 +509,9 @@ func (UnimplementedDaprServer) ExecuteStateTransaction(context.Context, *Execute
 func (UnimplementedDaprServer) PublishEvent(context.Context, *PublishEventRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishEvent not implemented")
 }
+func (UnimplementedDaprServer) BulkPublishEvent(context.Context, *BulkPublishRequest) (*BulkPublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkPublishEvent not implemented")
+}
 func (UnimplementedDaprServer) InvokeBinding(context.Context, *InvokeBindingRequest) (*InvokeBindingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeBinding not implemented")
 }
@@ -478,6 +536,12 @@ func (UnimplementedDaprServer) UnregisterActorReminder(context.Context, *Unregis
 func (UnimplementedDaprServer) RenameActorReminder(context.Context, *RenameActorReminderRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameActorReminder not implemented")
 }
+func (UnimplementedDaprServer) ListActorReminders(context.Context, *ListActorRemindersRequest) (*ListActorRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActorReminders not implemented")
+}
+func (UnimplementedDaprServer) DeleteActorReminders(context.Context, *DeleteActorRemindersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteActorReminders not implemented")
+}
 func (UnimplementedDaprServer) GetActorState(context.Context, *GetActorStateRequest) (*GetActorStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetActorState not implemented")
 }
@@ -613,6 +677,24 @@ func _Dapr_QueryStateAlpha1_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_ReEncryptStateAlpha1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReEncryptStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ReEncryptStateAlpha1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/ReEncryptStateAlpha1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ReEncryptStateAlpha1(ctx, req.(*ReEncryptStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_DeleteState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteStateRequest)
 	if err := dec(in); err != nil {
@@ -685,6 +767,24 @@ func _Dapr_PublishEvent_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_BulkPublishEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkPublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).BulkPublishEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/BulkPublishEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).BulkPublishEvent(ctx, req.(*BulkPublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_InvokeBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InvokeBindingRequest)
 	if err := dec(in); err != nil {
@@ -829,6 +929,42 @@ func _Dapr_RenameActorReminder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dapr_ListActorReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActorRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).ListActorReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/ListActorReminders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).ListActorReminders(ctx, req.(*ListActorRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dapr_DeleteActorReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteActorRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaprServer).DeleteActorReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dapr.proto.runtime.v1.Dapr/DeleteActorReminders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaprServer).DeleteActorReminders(ctx, req.(*DeleteActorRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dapr_GetActorState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetActorStateRequest)
 	if err := dec(in); err != nil {
@@ -1057,6 +1193,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryStateAlpha1",
 			Handler:    _Dapr_QueryStateAlpha1_Handler,
 		},
+		{
+			MethodName: "ReEncryptStateAlpha1",
+			Handler:    _Dapr_ReEncryptStateAlpha1_Handler,
+		},
 		{
 			MethodName: "DeleteState",
 			Handler:    _Dapr_DeleteState_Handler,
@@ -1073,6 +1213,10 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublishEvent",
 			Handler:    _Dapr_PublishEvent_Handler,
 		},
+		{
+			MethodName: "BulkPublishEvent",
+			Handler:    _Dapr_BulkPublishEvent_Handler,
+		},
 		{
 			MethodName: "InvokeBinding",
 			Handler:    _Dapr_InvokeBinding_Handler,
@@ -1105,6 +1249,14 @@ var Dapr_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenameActorReminder",
 			Handler:    _Dapr_RenameActorReminder_Handler,
 		},
+		{
+			MethodName: "ListActorReminders",
+			Handler:    _Dapr_ListActorReminders_Handler,
+		},
+		{
+			MethodName: "DeleteActorReminders",
+			Handler:    _Dapr_DeleteActorReminders_Handler,
+		},
 		{
 			MethodName: "GetActorState",
 			Handler:    _Dapr_GetActorState_Handler,
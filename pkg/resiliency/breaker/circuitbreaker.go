@@ -19,6 +19,7 @@ import (
 
 	"github.com/sony/gobreaker"
 
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/kit/logger"
 )
@@ -28,6 +29,12 @@ import (
 type CircuitBreaker struct {
 	// Name is the circuit breaker name.
 	Name string
+	// Policy is the name of the circuit breaker policy, and Target is the
+	// app, actor type or component the circuit breaker applies to.
+	// They identify the circuit breaker in the metrics, as instances may be
+	// created per endpoint or per actor.
+	Policy string `mapstructure:"-"`
+	Target string `mapstructure:"-"`
 	// The maximum number of requests allowed to pass through when
 	// the circuit breaker is half-open.
 	// Default is 1.
@@ -56,6 +63,10 @@ var (
 	ErrTooManyRequests = gobreaker.ErrTooManyRequests
 )
 
+// Counts holds the numbers of requests and their successes/failures
+// in the current generation of the circuit breaker.
+type Counts = gobreaker.Counts
+
 // IsErrorPermanent returns true if `err` should be treated as a
 // permanent error that cannot be retried.
 func IsErrorPermanent(err error) bool {
@@ -97,6 +108,7 @@ func (c *CircuitBreaker) Initialize(log logger.Logger) {
 		ReadyToTrip: tripFn,
 		OnStateChange: func(name string, from, to gobreaker.State) {
 			log.Infof("Circuit breaker %q changed state from %s to %s", name, from, to)
+			diag.DefaultResiliencyMonitoring.CircuitBreakerStateChanged(c.Policy, c.Target, to.String())
 		},
 	})
 }
@@ -120,3 +132,13 @@ func (c *CircuitBreaker) Execute(oper func() error) error {
 		return err //nolint:wrapcheck
 	}
 }
+
+// State returns the current state of the circuit breaker: closed, half-open or open.
+func (c *CircuitBreaker) State() string {
+	return c.breaker.State().String()
+}
+
+// Counts returns the internal counts of the circuit breaker.
+func (c *CircuitBreaker) Counts() Counts {
+	return c.breaker.Counts()
+}
@@ -38,16 +38,23 @@ func TestCircuitBreaker(t *testing.T) {
 		Timeout: 100 * time.Millisecond,
 	}
 	cb.Initialize(log)
-	for i := 0; i < 3; i++ {
+	assert.Equal(t, "closed", cb.State())
+	for i := 0; i < 2; i++ {
 		cb.Execute(func() error {
 			return errors.New("test")
 		})
 	}
+	assert.Equal(t, uint32(2), cb.Counts().ConsecutiveFailures)
+	cb.Execute(func() error {
+		return errors.New("test")
+	})
+	assert.Equal(t, "open", cb.State())
 	err = cb.Execute(func() error {
 		return nil
 	})
 	assert.EqualError(t, err, "circuit breaker is open")
 	time.Sleep(500 * time.Millisecond)
+	assert.Equal(t, "half-open", cb.State())
 	err = cb.Execute(func() error {
 		return nil
 	})
@@ -0,0 +1,171 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dapr/dapr/pkg/concurrency"
+)
+
+// ErrBulkheadFull is returned when an operation waited longer than the max queue wait of a bulkhead.
+var ErrBulkheadFull = errors.New("bulkhead is full: too many concurrent calls")
+
+// Bulkhead limits the number of concurrent executions of an operation.
+// Calls over the limit wait for at most MaxQueueWait before being rejected with ErrBulkheadFull.
+type Bulkhead struct {
+	Name          string
+	MaxConcurrent int
+	// MaxQueueWait is the maximum time a call waits for a slot.
+	// A zero value means that calls wait until their context is done.
+	MaxQueueWait time.Duration
+
+	limiter *concurrency.Limiter
+}
+
+// Initialize creates the limiter of the bulkhead.
+func (b *Bulkhead) Initialize() {
+	b.limiter = concurrency.NewLimiter(b.MaxConcurrent)
+}
+
+// Execute invokes oper once a slot is available.
+// The slot is released once oper returns, unless it is still held by the work oper started, see holdBulkheadSlots.
+func (b *Bulkhead) Execute(ctx context.Context, oper Operation) error {
+	waitCtx := ctx
+	if b.MaxQueueWait > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, b.MaxQueueWait)
+		defer cancel()
+	}
+
+	release, err := b.limiter.Acquire(waitCtx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return ErrBulkheadFull
+	}
+
+	slot := &bulkheadSlot{
+		refs:    1,
+		release: release,
+	}
+	slot.parent, _ = ctx.Value(bulkheadSlotKey{}).(*bulkheadSlot)
+	defer slot.done()
+
+	return oper(context.WithValue(ctx, bulkheadSlotKey{}, slot))
+}
+
+// InProgress returns the number of calls currently executing.
+func (b *Bulkhead) InProgress() int {
+	return b.limiter.InProgress()
+}
+
+type bulkheadSlotKey struct{}
+
+// bulkheadSlot is a slot acquired in a bulkhead by an operation.
+// It is released once the operation and all the holders of the slot are done.
+type bulkheadSlot struct {
+	// parent is the slot acquired by the operation in the bulkhead of an outer policy.
+	parent  *bulkheadSlot
+	refs    int32
+	release func()
+}
+
+func (s *bulkheadSlot) hold() func() {
+	atomic.AddInt32(&s.refs, 1)
+	var once sync.Once
+	return func() {
+		once.Do(s.done)
+	}
+}
+
+func (s *bulkheadSlot) done() {
+	if atomic.AddInt32(&s.refs, -1) == 0 {
+		s.release()
+	}
+}
+
+// holdBulkheadSlots holds the bulkhead slots of the operation running with ctx until the returned function
+// is called, for work that outlives the operation.
+func holdBulkheadSlots(ctx context.Context) func() {
+	var releases []func()
+	for s, _ := ctx.Value(bulkheadSlotKey{}).(*bulkheadSlot); s != nil; s = s.parent {
+		releases = append(releases, s.hold())
+	}
+	return func() {
+		for _, release := range releases {
+			release()
+		}
+	}
+}
+
+// ReleaseBulkheadSlotsOnClose holds the bulkhead slots of the operation running with ctx until body is closed,
+// so that a response body read after the operation returned is still bounded by the bulkheads.
+func ReleaseBulkheadSlotsOnClose(ctx context.Context, body io.ReadCloser) io.ReadCloser {
+	return &releaseOnCloseBody{
+		ReadCloser: body,
+		release:    holdBulkheadSlots(ctx),
+	}
+}
+
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// bulkheadInstances stores bulkhead state for the targets of a bulkhead policy.
+type bulkheadInstances struct {
+	sync.RWMutex
+	bhs map[string]*Bulkhead
+}
+
+// Get returns a cached bulkhead if one exists.
+// Otherwise, it returns a new bulkhead based on the provided template.
+func (e *bulkheadInstances) Get(instanceName string, template *Bulkhead) *Bulkhead {
+	e.RLock()
+	bh, ok := e.bhs[instanceName]
+	e.RUnlock()
+	if ok {
+		return bh
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	// Another caller may have created the bulkhead in the meantime.
+	if bh, ok = e.bhs[instanceName]; ok {
+		return bh
+	}
+
+	bh = &Bulkhead{
+		Name:          template.Name + "-" + instanceName,
+		MaxConcurrent: template.MaxConcurrent,
+		MaxQueueWait:  template.MaxQueueWait,
+	}
+	bh.Initialize()
+	e.bhs[instanceName] = bh
+
+	return bh
+}
@@ -74,3 +74,8 @@ func (*NoOp) BuiltInPolicy(ctx context.Context, name BuiltInPolicyName) Runner {
 func (*NoOp) PolicyDefined(target string, policyType PolicyType) bool {
 	return true
 }
+
+// Status returns an empty status, as no policies are loaded.
+func (*NoOp) Status() Status {
+	return Status{}
+}
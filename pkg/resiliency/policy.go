@@ -15,10 +15,12 @@ package resiliency
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
 
+	diag "github.com/dapr/dapr/pkg/diagnostics"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/kit/logger"
 	"github.com/dapr/kit/retry"
@@ -34,19 +36,24 @@ type (
 
 // Policy returns a policy runner that encapsulates the configured
 // resiliency policies in a simple execution wrapper.
-func Policy(ctx context.Context, log logger.Logger, operationName string, t time.Duration, r *retry.Config, cb *breaker.CircuitBreaker) Runner {
+// The target identifies the app, actor type or component in the metrics of the policies.
+func Policy(ctx context.Context, log logger.Logger, operationName string, target string, t time.Duration, r *RetryPolicy, cb *breaker.CircuitBreaker, bh *Bulkhead) Runner {
 	return func(oper Operation) error {
 		operation := oper
 		if t > 0 {
 			// Handle timeout.
 			// TODO: This should ideally be handled by the underlying service/component. Revisit once those understand contexts.
 			operCopy := operation
-			operation = func(ctx context.Context) error {
-				ctx, cancel := context.WithTimeout(ctx, t)
+			operation = func(parentCtx context.Context) error {
+				ctx, cancel := context.WithTimeout(parentCtx, t)
 				defer cancel()
 
+				// The operation keeps running after the timeout: its bulkhead slots are held until it returns,
+				// so that the bulkhead bounds the number of operations actually running.
+				release := holdBulkheadSlots(parentCtx)
 				done := make(chan error, 1)
 				go func() {
+					defer release()
 					done <- operCopy(ctx)
 				}()
 
@@ -54,6 +61,9 @@ func Policy(ctx context.Context, log logger.Logger, operationName string, t time
 				case err := <-done:
 					return err
 				case <-ctx.Done():
+					if parentCtx.Err() == nil {
+						diag.DefaultResiliencyMonitoring.TimeoutHit(target)
+					}
 					return ctx.Err()
 				}
 			}
@@ -73,16 +83,44 @@ func Policy(ctx context.Context, log logger.Logger, operationName string, t time
 			}
 		}
 
+		if bh != nil {
+			// The bulkhead wraps the circuit breaker, so that the rejected calls are not counted as failures,
+			// and the timeout starts once the operation has a slot in the bulkhead.
+			operCopy := operation
+			operation = func(ctx context.Context) error {
+				err := bh.Execute(ctx, operCopy)
+				if r != nil && errors.Is(err, ErrBulkheadFull) {
+					// Break out of retry, the call already waited for the max queue wait.
+					err = backoff.Permanent(err)
+				}
+				return err
+			}
+		}
+
 		if r == nil {
 			return operation(ctx)
 		}
 
+		if r.Matching != nil {
+			operCopy := operation
+			operation = func(ctx context.Context) error {
+				err := operCopy(ctx)
+				var permanent *backoff.PermanentError
+				if err != nil && !errors.As(err, &permanent) && !r.Matching.IsRetriable(err) {
+					// Break out of retry.
+					err = backoff.Permanent(err)
+				}
+				return err
+			}
+		}
+
 		// Use retry/back off.
 		b := r.NewBackOffWithContext(ctx)
 		return retry.NotifyRecover(func() error {
 			return operation(ctx)
 		}, b, func(_ error, _ time.Duration) {
 			log.Infof("Error processing operation %s. Retrying...", operationName)
+			diag.DefaultResiliencyMonitoring.RetryAttempted(target)
 		}, func() {
 			log.Infof("Recovered processing operation %s.", operationName)
 		})
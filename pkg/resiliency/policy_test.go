@@ -15,12 +15,19 @@ package resiliency_test
 
 import (
 	"context"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	resiliency_v1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/expr"
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/dapr/pkg/resiliency/breaker"
 	"github.com/dapr/kit/logger"
@@ -30,23 +37,30 @@ import (
 var log = logger.NewLogger("dapr.resiliency.test")
 
 func TestPolicy(t *testing.T) {
-	retryValue := retry.DefaultConfig()
+	retryValue := resiliency.RetryPolicy{Config: retry.DefaultConfig()}
 	cbValue := breaker.CircuitBreaker{
 		Name:     "test",
 		Interval: 10 * time.Millisecond,
 		Timeout:  10 * time.Millisecond,
 	}
 	cbValue.Initialize(log)
+	bhValue := resiliency.Bulkhead{
+		Name:          "test",
+		MaxConcurrent: 1,
+	}
+	bhValue.Initialize()
 	tests := map[string]struct {
 		t  time.Duration
-		r  *retry.Config
+		r  *resiliency.RetryPolicy
 		cb *breaker.CircuitBreaker
+		bh *resiliency.Bulkhead
 	}{
 		"empty": {},
 		"all": {
 			t:  10 * time.Millisecond,
 			r:  &retryValue,
 			cb: &cbValue,
+			bh: &bhValue,
 		},
 	}
 
@@ -59,7 +73,7 @@ func TestPolicy(t *testing.T) {
 
 				return nil
 			}
-			policy := resiliency.Policy(ctx, log, name, tt.t, tt.r, tt.cb)
+			policy := resiliency.Policy(ctx, log, name, name, tt.t, tt.r, tt.cb, tt.bh)
 			policy(fn)
 			assert.True(t, called)
 		})
@@ -96,7 +110,7 @@ func TestPolicyTimeout(t *testing.T) {
 				return nil
 			}
 
-			policy := resiliency.Policy(context.Background(), log, "timeout", test.timeout, nil, nil)
+			policy := resiliency.Policy(context.Background(), log, "timeout", "timeout", test.timeout, nil, nil, nil)
 			policy(fn)
 
 			assert.Equal(t, test.expected, called)
@@ -137,9 +151,206 @@ func TestPolicyRetry(t *testing.T) {
 				return nil
 			}
 
-			policy := resiliency.Policy(context.Background(), log, "retry", 10*time.Millisecond, &retry.Config{MaxRetries: int64(test.maxRetries)}, nil)
+			policy := resiliency.Policy(context.Background(), log, "retry", "retry", 10*time.Millisecond, &resiliency.RetryPolicy{Config: retry.Config{MaxRetries: int64(test.maxRetries)}}, nil, nil)
 			policy(fn)
 			assert.Equal(t, test.expected, called)
 		})
 	}
 }
+
+func TestPolicyBulkhead(t *testing.T) {
+	bh := resiliency.Bulkhead{
+		Name:          "test",
+		MaxConcurrent: 1,
+		MaxQueueWait:  10 * time.Millisecond,
+	}
+	bh.Initialize()
+	policy := resiliency.Policy(context.Background(), log, "bulkhead", "bulkhead", 0, nil, nil, &bh)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- policy(func(ctx context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+	assert.Equal(t, 1, bh.InProgress())
+
+	t.Run("call is rejected when the queue wait elapses", func(t *testing.T) {
+		called := false
+		err := policy(func(ctx context.Context) error {
+			called = true
+			return nil
+		})
+		assert.Equal(t, resiliency.ErrBulkheadFull, err)
+		assert.False(t, called)
+	})
+
+	t.Run("call is executed once a slot is released", func(t *testing.T) {
+		close(release)
+		assert.NoError(t, <-done)
+
+		called := false
+		err := policy(func(ctx context.Context) error {
+			called = true
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.True(t, called)
+		assert.Equal(t, 0, bh.InProgress())
+	})
+}
+
+func TestPolicyBulkheadTimeout(t *testing.T) {
+	bh := resiliency.Bulkhead{
+		Name:          "test",
+		MaxConcurrent: 1,
+		MaxQueueWait:  10 * time.Millisecond,
+	}
+	bh.Initialize()
+	policy := resiliency.Policy(context.Background(), log, "bulkhead", "bulkhead", 10*time.Millisecond, nil, nil, &bh)
+
+	release := make(chan struct{})
+	err := policy(func(ctx context.Context) error {
+		<-release
+		return nil
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// The slot is held until the timed out operation returns.
+	assert.Equal(t, 1, bh.InProgress())
+	err = policy(func(ctx context.Context) error {
+		return nil
+	})
+	assert.Equal(t, resiliency.ErrBulkheadFull, err)
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		return bh.InProgress() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestPolicyBulkheadReleaseOnClose(t *testing.T) {
+	bh := resiliency.Bulkhead{
+		Name:          "test",
+		MaxConcurrent: 1,
+	}
+	bh.Initialize()
+	policy := resiliency.Policy(context.Background(), log, "bulkhead", "bulkhead", 0, nil, nil, &bh)
+
+	var body io.ReadCloser
+	err := policy(func(ctx context.Context) error {
+		body = resiliency.ReleaseBulkheadSlotsOnClose(ctx, io.NopCloser(strings.NewReader("body")))
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The slot is held until the body is closed.
+	assert.Equal(t, 1, bh.InProgress())
+	require.NoError(t, body.Close())
+	assert.Equal(t, 0, bh.InProgress())
+	require.NoError(t, body.Close())
+	assert.Equal(t, 0, bh.InProgress())
+}
+
+func TestPolicyBulkheadFull(t *testing.T) {
+	var trip expr.Expr
+	require.NoError(t, trip.DecodeString("consecutiveFailures > 0"))
+	cb := breaker.CircuitBreaker{
+		Name:    "test",
+		Trip:    &trip,
+		Timeout: time.Minute,
+	}
+	cb.Initialize(log)
+	bh := resiliency.Bulkhead{
+		Name:          "test",
+		MaxConcurrent: 1,
+		MaxQueueWait:  10 * time.Millisecond,
+	}
+	bh.Initialize()
+	policy := resiliency.Policy(context.Background(), log, "bulkhead", "bulkhead", 0,
+		&resiliency.RetryPolicy{Config: retry.Config{Policy: retry.PolicyConstant, Duration: time.Second, MaxRetries: 3}}, &cb, &bh)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- policy(func(ctx context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	start := time.Now()
+	err := policy(func(ctx context.Context) error {
+		return nil
+	})
+	assert.Equal(t, resiliency.ErrBulkheadFull, err)
+	// The rejected call is not retried.
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	close(release)
+	assert.NoError(t, <-done)
+
+	// The rejected call is not counted as a failure by the circuit breaker.
+	assert.Equal(t, "closed", cb.State())
+	assert.Equal(t, uint32(0), cb.Counts().TotalFailures)
+}
+
+func TestPolicyRetryMatching(t *testing.T) {
+	matching, err := resiliency.ParseRetryMatching(resiliency_v1alpha.RetryMatching{
+		HTTPStatusCodes:          "429,500-599",
+		PermanentGRPCStatusCodes: "INVALID_ARGUMENT",
+	})
+	require.NoError(t, err)
+	r := &resiliency.RetryPolicy{
+		Config:   retry.Config{MaxRetries: 3},
+		Matching: matching,
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{
+			name:     "retriable HTTP status code",
+			err:      resiliency.NewHTTPStatusCodeError(503),
+			expected: 4,
+		},
+		{
+			name:     "permanent HTTP status code",
+			err:      resiliency.NewHTTPStatusCodeError(400),
+			expected: 1,
+		},
+		{
+			name:     "permanent gRPC code",
+			err:      status.Error(codes.InvalidArgument, "invalid"),
+			expected: 1,
+		},
+		{
+			name:     "error without status code",
+			err:      errors.New("unknown"),
+			expected: 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			called := 0
+			policy := resiliency.Policy(context.Background(), log, "retry", "retry", 0, r, nil, nil)
+			err := policy(func(ctx context.Context) error {
+				called++
+				return test.err
+			})
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected, called)
+		})
+	}
+}
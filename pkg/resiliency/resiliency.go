@@ -81,21 +81,26 @@ type (
 		BuiltInPolicy(ctx context.Context, name BuiltInPolicyName) Runner
 		// PolicyDefined returns a boolean stating if the given target has a policy.
 		PolicyDefined(target string, policyType PolicyType) bool
+		// Status returns the loaded policies and targets, and the state of the circuit breakers.
+		Status() Status
 	}
 
-	// Resiliency encapsulates configuration for timeouts, retries, and circuit breakers.
+	// Resiliency encapsulates configuration for timeouts, retries, circuit breakers and bulkheads.
 	// It maps services, actors, components, and routes to each of these configurations.
 	// Lastly, it maintains circuit breaker state across invocations.
 	Resiliency struct {
-		log logger.Logger
+		log  logger.Logger
+		lock sync.RWMutex
 
 		timeouts        map[string]time.Duration
-		retries         map[string]*retry.Config
+		retries         map[string]*RetryPolicy
 		circuitBreakers map[string]*breaker.CircuitBreaker
+		bulkheads       map[string]*Bulkhead
 
-		actorCBCaches map[string]*lru.Cache
-		serviceCBs    map[string]*lru.Cache
-		componentCBs  *circuitBreakerInstances
+		actorCBCaches     map[string]*lru.Cache
+		serviceCBs        map[string]*lru.Cache
+		componentCBs      *circuitBreakerInstances
+		bulkheadInstances *bulkheadInstances
 
 		apps       map[string]PolicyNames
 		actors     map[string]ActorPolicies
@@ -115,12 +120,13 @@ type (
 		Outbound PolicyNames
 	}
 
-	// PolicyNames contains the policy names for a timeout, retry, and circuit breaker.
+	// PolicyNames contains the policy names for a timeout, retry, circuit breaker and bulkhead.
 	// Empty values mean that no policy is configured.
 	PolicyNames struct {
 		Timeout        string
 		Retry          string
 		CircuitBreaker string
+		Bulkhead       string
 	}
 
 	// Actors have different behavior before and after locking.
@@ -134,6 +140,7 @@ type (
 		Retry               string
 		CircuitBreaker      string
 		CircuitBreakerScope ActorCircuitBreakerScope
+		Bulkhead            string
 	}
 
 	// Policy used after an actor is locked. It only uses timeout as retry/circuit breaker is handled before locking.
@@ -229,13 +236,17 @@ func New(log logger.Logger) *Resiliency {
 	return &Resiliency{
 		log:             log,
 		timeouts:        make(map[string]time.Duration),
-		retries:         make(map[string]*retry.Config),
+		retries:         make(map[string]*RetryPolicy),
 		circuitBreakers: make(map[string]*breaker.CircuitBreaker),
+		bulkheads:       make(map[string]*Bulkhead),
 		actorCBCaches:   make(map[string]*lru.Cache),
 		serviceCBs:      make(map[string]*lru.Cache),
 		componentCBs: &circuitBreakerInstances{
 			cbs: make(map[string]*breaker.CircuitBreaker, 10),
 		},
+		bulkheadInstances: &bulkheadInstances{
+			bhs: make(map[string]*Bulkhead, 10),
+		},
 		apps:       make(map[string]PolicyNames),
 		actors:     make(map[string]ActorPolicies),
 		components: make(map[string]ComponentPolicyNames),
@@ -257,35 +268,61 @@ func (r *Resiliency) DecodeConfiguration(c *resiliency_v1alpha.Resiliency) error
 	return r.decodeTargets(c)
 }
 
+// Reload replaces all policies and targets with the ones decoded from `c`.
+// Circuit breakers and bulkheads are recreated, so their state is reset.
+func (r *Resiliency) Reload(c ...*resiliency_v1alpha.Resiliency) {
+	updated := FromConfigurations(r.log, c...)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.timeouts = updated.timeouts
+	r.retries = updated.retries
+	r.circuitBreakers = updated.circuitBreakers
+	r.bulkheads = updated.bulkheads
+	r.actorCBCaches = updated.actorCBCaches
+	r.serviceCBs = updated.serviceCBs
+	r.componentCBs = updated.componentCBs
+	r.bulkheadInstances = updated.bulkheadInstances
+	r.apps = updated.apps
+	r.actors = updated.actors
+	r.components = updated.components
+}
+
 // Adds policies that cover the existing retries in Dapr like service invocation.
 func (r *Resiliency) addBuiltInPolicies() {
 	// Cover retries for remote service invocation, but don't overwrite anything that is already present.
 	if _, ok := r.retries[fmt.Sprintf("%s", BuiltInServiceRetries)]; !ok {
-		r.retries[fmt.Sprintf("%s", BuiltInServiceRetries)] = &retry.Config{
-			Policy:     retry.PolicyConstant,
-			MaxRetries: 3,
-			Duration:   time.Second,
+		r.retries[fmt.Sprintf("%s", BuiltInServiceRetries)] = &RetryPolicy{
+			Config: retry.Config{
+				Policy:     retry.PolicyConstant,
+				MaxRetries: 3,
+				Duration:   time.Second,
+			},
 		}
 	}
 
 	// Cover retries for remote actor invocation, but don't overwrite anything that is already present.
 	if _, ok := r.retries[fmt.Sprintf("%s", BuiltInActorRetries)]; !ok {
-		r.retries[fmt.Sprintf("%s", BuiltInActorRetries)] = &retry.Config{
-			Policy:     retry.PolicyConstant,
-			MaxRetries: 3,
-			Duration:   time.Second,
+		r.retries[fmt.Sprintf("%s", BuiltInActorRetries)] = &RetryPolicy{
+			Config: retry.Config{
+				Policy:     retry.PolicyConstant,
+				MaxRetries: 3,
+				Duration:   time.Second,
+			},
 		}
 	}
 
 	// Cover retries for actor reminder operations, but don't overwrite anything that is already present.
 	if _, ok := r.retries[fmt.Sprintf("%s", BuiltInActorReminderRetries)]; !ok {
-		r.retries[fmt.Sprintf("%s", BuiltInActorReminderRetries)] = &retry.Config{
-			Policy:              retry.PolicyExponential,
-			InitialInterval:     500 * time.Millisecond,
-			RandomizationFactor: 0.5,
-			Multiplier:          1.5,
-			MaxInterval:         60 * time.Second,
-			MaxElapsedTime:      15 * time.Minute,
+		r.retries[fmt.Sprintf("%s", BuiltInActorReminderRetries)] = &RetryPolicy{
+			Config: retry.Config{
+				Policy:              retry.PolicyExponential,
+				InitialInterval:     500 * time.Millisecond,
+				RandomizationFactor: 0.5,
+				Multiplier:          1.5,
+				MaxInterval:         60 * time.Second,
+				MaxElapsedTime:      15 * time.Minute,
+			},
 		}
 	}
 }
@@ -308,7 +345,21 @@ func (r *Resiliency) decodePolicies(c *resiliency_v1alpha.Resiliency) (err error
 		if err = retry.DecodeConfig(&rc, m); err != nil {
 			return fmt.Errorf("invalid retry configuration %q: %w", name, err)
 		}
-		r.retries[name] = &rc
+		if t.Jitter != "" {
+			jitter, err := strconv.ParseFloat(t.Jitter, 32)
+			if err != nil || jitter < 0 || jitter > 1 {
+				return fmt.Errorf("invalid retry configuration %q: jitter must be a number between 0 and 1", name)
+			}
+			rc.RandomizationFactor = float32(jitter)
+		}
+		matching, err := ParseRetryMatching(t.Matching)
+		if err != nil {
+			return fmt.Errorf("invalid retry configuration %q: %w", name, err)
+		}
+		r.retries[name] = &RetryPolicy{
+			Config:   rc,
+			Matching: matching,
+		}
 	}
 
 	for name, t := range policies.CircuitBreakers {
@@ -321,10 +372,27 @@ func (r *Resiliency) decodePolicies(c *resiliency_v1alpha.Resiliency) (err error
 			return fmt.Errorf("invalid retry configuration %q: %w", name, err)
 		}
 		cb.Name = name
+		cb.Policy = name
 		cb.Initialize(r.log)
 		r.circuitBreakers[name] = &cb
 	}
 
+	for name, t := range policies.Bulkheads {
+		if t.MaxConcurrent <= 0 {
+			return fmt.Errorf("invalid bulkhead configuration %q: maxConcurrent must be greater than 0", name)
+		}
+		bh := Bulkhead{
+			Name:          name,
+			MaxConcurrent: t.MaxConcurrent,
+		}
+		if t.MaxQueueWait != "" {
+			if bh.MaxQueueWait, err = parseDuration(t.MaxQueueWait); err != nil {
+				return fmt.Errorf("invalid bulkhead configuration %q, max queue wait %s: %w", name, t.MaxQueueWait, err)
+			}
+		}
+		r.bulkheads[name] = &bh
+	}
+
 	return nil
 }
 
@@ -336,6 +404,7 @@ func (r *Resiliency) decodeTargets(c *resiliency_v1alpha.Resiliency) (err error)
 			Timeout:        t.Timeout,
 			Retry:          t.Retry,
 			CircuitBreaker: t.CircuitBreaker,
+			Bulkhead:       t.Bulkhead,
 		}
 		if t.CircuitBreakerCacheSize == 0 {
 			t.CircuitBreakerCacheSize = defaultEndpointCacheSize
@@ -362,6 +431,7 @@ func (r *Resiliency) decodeTargets(c *resiliency_v1alpha.Resiliency) (err error)
 					Retry:               t.Retry,
 					CircuitBreaker:      t.CircuitBreaker,
 					CircuitBreakerScope: scope,
+					Bulkhead:            t.Bulkhead,
 				},
 				PostLockPolicies: ActorPostLockPolicyNames{
 					Timeout: t.Timeout,
@@ -372,6 +442,7 @@ func (r *Resiliency) decodeTargets(c *resiliency_v1alpha.Resiliency) (err error)
 				PreLockPolicies: ActorPreLockPolicyNames{
 					Retry:          t.Retry,
 					CircuitBreaker: "",
+					Bulkhead:       t.Bulkhead,
 				},
 				PostLockPolicies: ActorPostLockPolicyNames{
 					Timeout: t.Timeout,
@@ -394,11 +465,13 @@ func (r *Resiliency) decodeTargets(c *resiliency_v1alpha.Resiliency) (err error)
 				Timeout:        t.Inbound.Timeout,
 				Retry:          t.Inbound.Retry,
 				CircuitBreaker: t.Inbound.CircuitBreaker,
+				Bulkhead:       t.Inbound.Bulkhead,
 			},
 			Outbound: PolicyNames{
 				Timeout:        t.Outbound.Timeout,
 				Retry:          t.Outbound.Retry,
 				CircuitBreaker: t.Outbound.CircuitBreaker,
+				Bulkhead:       t.Outbound.Bulkhead,
 			},
 		}
 	}
@@ -409,12 +482,16 @@ func (r *Resiliency) decodeTargets(c *resiliency_v1alpha.Resiliency) (err error)
 // EndpointPolicy returns the policy for a service endpoint.
 func (r *Resiliency) EndpointPolicy(ctx context.Context, app string, endpoint string) Runner {
 	var t time.Duration
-	var rc *retry.Config
+	var rc *RetryPolicy
 	var cb *breaker.CircuitBreaker
+	var bh *Bulkhead
 	operationName := fmt.Sprintf("endpoint[%s, %s]", app, endpoint)
+	target := "app-" + app
 	if r == nil {
-		return Policy(ctx, r.log, operationName, t, rc, cb)
+		return Policy(ctx, r.log, operationName, target, t, rc, cb, bh)
 	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	policyNames, ok := r.apps[app]
 	if ok {
 		if policyNames.Timeout != "" {
@@ -434,6 +511,8 @@ func (r *Resiliency) EndpointPolicy(ctx context.Context, app string, endpoint st
 					} else {
 						cb = &breaker.CircuitBreaker{
 							Name:        endpoint,
+							Policy:      template.Name,
+							Target:      target,
 							MaxRequests: template.MaxRequests,
 							Interval:    template.Interval,
 							Timeout:     template.Timeout,
@@ -445,20 +524,26 @@ func (r *Resiliency) EndpointPolicy(ctx context.Context, app string, endpoint st
 				}
 			}
 		}
+		// The bulkhead is shared by all the endpoints of the app.
+		bh = r.getBulkhead(policyNames.Bulkhead, target)
 	}
 
-	return Policy(ctx, r.log, operationName, t, rc, cb)
+	return Policy(ctx, r.log, operationName, target, t, rc, cb, bh)
 }
 
 // ActorPreLockPolicy returns the policy for an actor instance to be used before an actor lock is acquired.
 func (r *Resiliency) ActorPreLockPolicy(ctx context.Context, actorType string, id string) Runner {
 	var t time.Duration
-	var rc *retry.Config
+	var rc *RetryPolicy
 	var cb *breaker.CircuitBreaker
+	var bh *Bulkhead
 	operationName := fmt.Sprintf("actor[%s, %s]", actorType, id)
+	target := "actor-" + actorType
 	if r == nil {
-		return Policy(ctx, r.log, operationName, t, rc, cb)
+		return Policy(ctx, r.log, operationName, target, t, rc, cb, bh)
 	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	actorPolicies, ok := r.actors[actorType]
 	if policyNames := actorPolicies.PreLockPolicies; ok {
 		if policyNames.Retry != "" {
@@ -482,6 +567,8 @@ func (r *Resiliency) ActorPreLockPolicy(ctx context.Context, actorType string, i
 					} else {
 						cb = &breaker.CircuitBreaker{
 							Name:        key,
+							Policy:      template.Name,
+							Target:      target,
 							MaxRequests: template.MaxRequests,
 							Interval:    template.Interval,
 							Timeout:     template.Timeout,
@@ -493,20 +580,26 @@ func (r *Resiliency) ActorPreLockPolicy(ctx context.Context, actorType string, i
 				}
 			}
 		}
+		// The bulkhead is shared by all the actors of the type, and applied before the lock is acquired.
+		bh = r.getBulkhead(policyNames.Bulkhead, target)
 	}
 
-	return Policy(ctx, r.log, operationName, t, rc, cb)
+	return Policy(ctx, r.log, operationName, target, t, rc, cb, bh)
 }
 
 // ActorPostLockPolicy returns the policy for an actor instance to be used after an actor lock is acquired.
 func (r *Resiliency) ActorPostLockPolicy(ctx context.Context, actorType string, id string) Runner {
 	var t time.Duration
-	var rc *retry.Config
+	var rc *RetryPolicy
 	var cb *breaker.CircuitBreaker
+	var bh *Bulkhead
 	operationName := fmt.Sprintf("actor[%s, %s]", actorType, id)
+	target := "actor-" + actorType
 	if r == nil {
-		return Policy(ctx, r.log, operationName, t, rc, cb)
+		return Policy(ctx, r.log, operationName, target, t, rc, cb, bh)
 	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	actorPolicies, ok := r.actors[actorType]
 	if policyNames := actorPolicies.PostLockPolicies; ok {
 		if policyNames.Timeout != "" {
@@ -514,18 +607,22 @@ func (r *Resiliency) ActorPostLockPolicy(ctx context.Context, actorType string,
 		}
 	}
 
-	return Policy(ctx, r.log, operationName, t, rc, cb)
+	return Policy(ctx, r.log, operationName, target, t, rc, cb, bh)
 }
 
 // ComponentPolicy returns the output policy for a component.
 func (r *Resiliency) ComponentOutboundPolicy(ctx context.Context, name string) Runner {
 	var t time.Duration
-	var rc *retry.Config
+	var rc *RetryPolicy
 	var cb *breaker.CircuitBreaker
+	var bh *Bulkhead
 	operationName := fmt.Sprintf("component[%s] output", name)
+	target := "component-" + name + "-outbound"
 	if r == nil {
-		return Policy(ctx, r.log, operationName, t, rc, cb)
+		return Policy(ctx, r.log, operationName, target, t, rc, cb, bh)
 	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	componentPolicies, ok := r.components[name]
 	if ok {
 		if componentPolicies.Outbound.Timeout != "" {
@@ -536,22 +633,27 @@ func (r *Resiliency) ComponentOutboundPolicy(ctx context.Context, name string) R
 		}
 		if componentPolicies.Outbound.CircuitBreaker != "" {
 			template := r.circuitBreakers[componentPolicies.Outbound.CircuitBreaker]
-			cb = r.componentCBs.Get(r.log, name, template)
+			cb = r.componentCBs.Get(r.log, name, "component-"+name, template)
 		}
+		bh = r.getBulkhead(componentPolicies.Outbound.Bulkhead, target)
 	}
 
-	return Policy(ctx, r.log, operationName, t, rc, cb)
+	return Policy(ctx, r.log, operationName, target, t, rc, cb, bh)
 }
 
 // ComponentPolicy returns the policy for a component.
 func (r *Resiliency) ComponentInboundPolicy(ctx context.Context, name string) Runner {
 	var t time.Duration
-	var rc *retry.Config
+	var rc *RetryPolicy
 	var cb *breaker.CircuitBreaker
+	var bh *Bulkhead
 	operationName := fmt.Sprintf("component[%s] input", name)
+	target := "component-" + name + "-inbound"
 	if r == nil {
-		return Policy(ctx, r.log, operationName, t, rc, cb)
+		return Policy(ctx, r.log, operationName, target, t, rc, cb, bh)
 	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	componentPolicies, ok := r.components[name]
 	if ok {
 		if componentPolicies.Inbound.Timeout != "" {
@@ -562,11 +664,12 @@ func (r *Resiliency) ComponentInboundPolicy(ctx context.Context, name string) Ru
 		}
 		if componentPolicies.Inbound.CircuitBreaker != "" {
 			template := r.circuitBreakers[componentPolicies.Inbound.CircuitBreaker]
-			cb = r.componentCBs.Get(r.log, name, template)
+			cb = r.componentCBs.Get(r.log, name, "component-"+name, template)
 		}
+		bh = r.getBulkhead(componentPolicies.Inbound.Bulkhead, target)
 	}
 
-	return Policy(ctx, r.log, operationName, t, rc, cb)
+	return Policy(ctx, r.log, operationName, target, t, rc, cb, bh)
 }
 
 // BuiltInPolicy returns a policy that represents a specific built-in retry scenario.
@@ -574,11 +677,15 @@ func (r *Resiliency) BuiltInPolicy(ctx context.Context, name BuiltInPolicyName)
 	var t time.Duration
 	var cb *breaker.CircuitBreaker
 	stringName := fmt.Sprintf("%s", name)
-	return Policy(ctx, r.log, stringName, t, r.retries[stringName], cb)
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return Policy(ctx, r.log, stringName, stringName, t, r.retries[stringName], cb, nil)
 }
 
 // Returns true if a target has a defined policy.
 func (r *Resiliency) PolicyDefined(target string, policyType PolicyType) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	var exists bool
 	switch policyType {
 	case Endpoint:
@@ -591,9 +698,22 @@ func (r *Resiliency) PolicyDefined(target string, policyType PolicyType) bool {
 	return exists
 }
 
+// getBulkhead returns the bulkhead of the target instance for the given policy, if the policy exists.
+// It must be called with the lock held.
+func (r *Resiliency) getBulkhead(policyName string, instanceName string) *Bulkhead {
+	if policyName == "" {
+		return nil
+	}
+	template, ok := r.bulkheads[policyName]
+	if !ok {
+		return nil
+	}
+	return r.bulkheadInstances.Get(instanceName, template)
+}
+
 // Get returns a cached circuit breaker if one exists.
-// Otherwise, it returns a new circuit breaker based on the provided template.
-func (e *circuitBreakerInstances) Get(log logger.Logger, instanceName string, template *breaker.CircuitBreaker) *breaker.CircuitBreaker {
+// Otherwise, it returns a new circuit breaker of the target based on the provided template.
+func (e *circuitBreakerInstances) Get(log logger.Logger, instanceName string, target string, template *breaker.CircuitBreaker) *breaker.CircuitBreaker {
 	e.RLock()
 	cb, ok := e.cbs[instanceName]
 	e.RUnlock()
@@ -603,6 +723,8 @@ func (e *circuitBreakerInstances) Get(log logger.Logger, instanceName string, te
 
 	cb = &breaker.CircuitBreaker{
 		Name:        template.Name + "-" + instanceName,
+		Policy:      template.Name,
+		Target:      target,
 		MaxRequests: template.MaxRequests,
 		Interval:    template.Interval,
 		Timeout:     template.Timeout,
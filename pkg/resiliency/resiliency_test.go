@@ -23,6 +23,7 @@ import (
 
 	"github.com/phayes/freeport"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
 	resiliency_v1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
@@ -334,3 +335,192 @@ func TestResiliencyHasBuiltInPolicy(t *testing.T) {
 	assert.NotNil(t, r.BuiltInPolicy(context.Background(), BuiltInActorRetries))
 	assert.NotNil(t, r.BuiltInPolicy(context.Background(), BuiltInActorReminderRetries))
 }
+
+func TestResiliencyReload(t *testing.T) {
+	r := FromConfigurations(log, &resiliency_v1alpha.Resiliency{
+		Spec: resiliency_v1alpha.ResiliencySpec{
+			Targets: resiliency_v1alpha.Targets{
+				Apps: map[string]resiliency_v1alpha.EndpointPolicyNames{
+					"oldApp": {},
+				},
+			},
+		},
+	})
+	assert.True(t, r.PolicyDefined("oldApp", Endpoint))
+
+	r.Reload(&resiliency_v1alpha.Resiliency{
+		Spec: resiliency_v1alpha.ResiliencySpec{
+			Policies: resiliency_v1alpha.Policies{
+				Timeouts: map[string]string{
+					"fast": "10ms",
+				},
+			},
+			Targets: resiliency_v1alpha.Targets{
+				Components: map[string]resiliency_v1alpha.ComponentPolicyNames{
+					"newComponent": {
+						Outbound: resiliency_v1alpha.PolicyNames{
+							Timeout: "fast",
+						},
+					},
+				},
+			},
+		},
+	})
+
+	assert.False(t, r.PolicyDefined("oldApp", Endpoint))
+	assert.True(t, r.PolicyDefined("newComponent", Component))
+	assert.NotNil(t, r.BuiltInPolicy(context.Background(), BuiltInServiceRetries))
+
+	err := r.ComponentOutboundPolicy(context.Background(), "newComponent")(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestBulkheadPolicies(t *testing.T) {
+	r := FromConfigurations(log, &resiliency_v1alpha.Resiliency{
+		Spec: resiliency_v1alpha.ResiliencySpec{
+			Policies: resiliency_v1alpha.Policies{
+				Bulkheads: map[string]resiliency_v1alpha.Bulkhead{
+					"single": {
+						MaxConcurrent: 1,
+						MaxQueueWait:  "10ms",
+					},
+				},
+			},
+			Targets: resiliency_v1alpha.Targets{
+				Apps: map[string]resiliency_v1alpha.EndpointPolicyNames{
+					"appB": {Bulkhead: "single"},
+				},
+				Actors: map[string]resiliency_v1alpha.ActorPolicyNames{
+					"myActorType": {Bulkhead: "single"},
+				},
+				Components: map[string]resiliency_v1alpha.ComponentPolicyNames{
+					"statestore1": {
+						Outbound: resiliency_v1alpha.PolicyNames{Bulkhead: "single"},
+					},
+				},
+			},
+		},
+	})
+
+	tests := map[string]struct {
+		first  Runner
+		second Runner
+	}{
+		"app endpoints share the bulkhead": {
+			first:  r.EndpointPolicy(context.Background(), "appB", "127.0.0.1:3500"),
+			second: r.EndpointPolicy(context.Background(), "appB", "127.0.0.1:3501"),
+		},
+		"actors of a type share the bulkhead": {
+			first:  r.ActorPreLockPolicy(context.Background(), "myActorType", "1"),
+			second: r.ActorPreLockPolicy(context.Background(), "myActorType", "2"),
+		},
+		"component": {
+			first:  r.ComponentOutboundPolicy(context.Background(), "statestore1"),
+			second: r.ComponentOutboundPolicy(context.Background(), "statestore1"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			started := make(chan struct{})
+			release := make(chan struct{})
+			done := make(chan error)
+			go func() {
+				done <- tt.first(func(ctx context.Context) error {
+					close(started)
+					<-release
+					return nil
+				})
+			}()
+			<-started
+
+			err := tt.second(func(ctx context.Context) error {
+				return nil
+			})
+			assert.ErrorIs(t, err, ErrBulkheadFull)
+
+			close(release)
+			assert.NoError(t, <-done)
+		})
+	}
+
+	t.Run("other targets are not limited", func(t *testing.T) {
+		err := r.ComponentInboundPolicy(context.Background(), "statestore1")(func(ctx context.Context) error {
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid max concurrent calls", func(t *testing.T) {
+		err := New(log).DecodeConfiguration(&resiliency_v1alpha.Resiliency{
+			Spec: resiliency_v1alpha.ResiliencySpec{
+				Policies: resiliency_v1alpha.Policies{
+					Bulkheads: map[string]resiliency_v1alpha.Bulkhead{
+						"invalid": {MaxQueueWait: "1s"},
+					},
+				},
+			},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestRetryPolicyDecoding(t *testing.T) {
+	t.Run("jitter and matching", func(t *testing.T) {
+		r := New(log)
+		err := r.DecodeConfiguration(&resiliency_v1alpha.Resiliency{
+			Spec: resiliency_v1alpha.ResiliencySpec{
+				Policies: resiliency_v1alpha.Policies{
+					Retries: map[string]resiliency_v1alpha.Retry{
+						"matching": {
+							Policy:     "exponential",
+							MaxRetries: 3,
+							Jitter:     "0.2",
+							Matching: resiliency_v1alpha.RetryMatching{
+								HTTPStatusCodes: "500-599",
+							},
+						},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		rc := r.retries["matching"]
+		require.NotNil(t, rc)
+		assert.Equal(t, float32(0.2), rc.RandomizationFactor)
+		require.NotNil(t, rc.Matching)
+		assert.False(t, rc.Matching.IsRetriable(NewHTTPStatusCodeError(400)))
+		assert.True(t, rc.Matching.IsRetriable(NewHTTPStatusCodeError(503)))
+	})
+
+	t.Run("invalid jitter", func(t *testing.T) {
+		err := New(log).DecodeConfiguration(&resiliency_v1alpha.Resiliency{
+			Spec: resiliency_v1alpha.ResiliencySpec{
+				Policies: resiliency_v1alpha.Policies{
+					Retries: map[string]resiliency_v1alpha.Retry{
+						"invalid": {Jitter: "2"},
+					},
+				},
+			},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid matching", func(t *testing.T) {
+		err := New(log).DecodeConfiguration(&resiliency_v1alpha.Resiliency{
+			Spec: resiliency_v1alpha.ResiliencySpec{
+				Policies: resiliency_v1alpha.Policies{
+					Retries: map[string]resiliency_v1alpha.Retry{
+						"invalid": {
+							Matching: resiliency_v1alpha.RetryMatching{GRPCStatusCodes: "NOT_A_CODE"},
+						},
+					},
+				},
+			},
+		})
+		assert.Error(t, err)
+	})
+}
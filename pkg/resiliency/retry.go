@@ -0,0 +1,206 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	resiliency_v1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/kit/retry"
+)
+
+// RetryPolicy is a retry configuration with the rules matching the errors that are retried.
+type RetryPolicy struct {
+	retry.Config
+
+	// Matching restricts the errors that are retried. If nil, all errors are retried.
+	Matching *RetryMatching
+}
+
+// RetryMatching decides whether an error is retried from its HTTP status code or gRPC code.
+// Errors without a status code are always retried.
+type RetryMatching struct {
+	httpStatusCodes          []statusCodeRange
+	grpcStatusCodes          map[codes.Code]struct{}
+	permanentHTTPStatusCodes []statusCodeRange
+	permanentGRPCStatusCodes map[codes.Code]struct{}
+}
+
+type statusCodeRange struct {
+	start int
+	end   int
+}
+
+// HTTPStatusCodeError is returned by operations when a request completes with a non-successful HTTP status code.
+type HTTPStatusCodeError struct {
+	StatusCode int
+	// Err is the error reported to the caller, if any.
+	Err error
+}
+
+// NewHTTPStatusCodeError returns an error for the non-successful HTTP status code.
+func NewHTTPStatusCodeError(statusCode int) error {
+	return &HTTPStatusCodeError{StatusCode: statusCode}
+}
+
+func (e *HTTPStatusCodeError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("Received non-successful status code: %d", e.StatusCode)
+}
+
+func (e *HTTPStatusCodeError) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus returns the gRPC status of the reported error, so that gRPC handlers can return the error as is.
+func (e *HTTPStatusCodeError) GRPCStatus() *status.Status {
+	if e.Err == nil {
+		return status.New(codes.Unknown, e.Error())
+	}
+	return status.Convert(e.Err)
+}
+
+// ParseRetryMatching parses the matching rules of a retry policy.
+// It returns nil if no rules are configured.
+func ParseRetryMatching(m resiliency_v1alpha.RetryMatching) (*RetryMatching, error) {
+	if m == (resiliency_v1alpha.RetryMatching{}) {
+		return nil, nil
+	}
+
+	var (
+		rm  RetryMatching
+		err error
+	)
+	if rm.httpStatusCodes, err = parseHTTPStatusCodes(m.HTTPStatusCodes); err != nil {
+		return nil, err
+	}
+	if rm.permanentHTTPStatusCodes, err = parseHTTPStatusCodes(m.PermanentHTTPStatusCodes); err != nil {
+		return nil, err
+	}
+	if rm.grpcStatusCodes, err = parseGRPCStatusCodes(m.GRPCStatusCodes); err != nil {
+		return nil, err
+	}
+	if rm.permanentGRPCStatusCodes, err = parseGRPCStatusCodes(m.PermanentGRPCStatusCodes); err != nil {
+		return nil, err
+	}
+	return &rm, nil
+}
+
+// IsRetriable returns true if the operation that returned err should be retried.
+// A status code is permanent if it is listed in the permanent codes or if retriable codes
+// are configured and it isn't one of them.
+func (m *RetryMatching) IsRetriable(err error) bool {
+	var httpErr *HTTPStatusCodeError
+	if errors.As(err, &httpErr) {
+		if matchStatusCode(m.permanentHTTPStatusCodes, httpErr.StatusCode) {
+			return false
+		}
+		return len(m.httpStatusCodes) == 0 || matchStatusCode(m.httpStatusCodes, httpErr.StatusCode)
+	}
+
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		code := grpcErr.GRPCStatus().Code()
+		if _, ok := m.permanentGRPCStatusCodes[code]; ok {
+			return false
+		}
+		if len(m.grpcStatusCodes) == 0 {
+			return true
+		}
+		_, ok := m.grpcStatusCodes[code]
+		return ok
+	}
+
+	return true
+}
+
+func matchStatusCode(ranges []statusCodeRange, code int) bool {
+	for _, r := range ranges {
+		if code >= r.start && code <= r.end {
+			return true
+		}
+	}
+	return false
+}
+
+// parseHTTPStatusCodes parses a comma-separated list of HTTP status codes and ranges, such as "429,500-599".
+func parseHTTPStatusCodes(val string) ([]statusCodeRange, error) {
+	var ranges []statusCodeRange
+	for _, item := range splitList(val) {
+		start, end, isRange := strings.Cut(item, "-")
+		if !isRange {
+			end = start
+		}
+		r := statusCodeRange{}
+		var err error
+		if r.start, err = parseHTTPStatusCode(start); err != nil {
+			return nil, err
+		}
+		if r.end, err = parseHTTPStatusCode(end); err != nil {
+			return nil, err
+		}
+		if r.start > r.end {
+			return nil, fmt.Errorf("invalid HTTP status code range %q", item)
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+func parseHTTPStatusCode(val string) (int, error) {
+	code, err := strconv.Atoi(strings.TrimSpace(val))
+	if err != nil || code < 100 || code > 599 {
+		return 0, fmt.Errorf("invalid HTTP status code %q", val)
+	}
+	return code, nil
+}
+
+// parseGRPCStatusCodes parses a comma-separated list of gRPC codes, by name (such as UNAVAILABLE) or number.
+func parseGRPCStatusCodes(val string) (map[codes.Code]struct{}, error) {
+	items := splitList(val)
+	if len(items) == 0 {
+		return nil, nil
+	}
+	res := make(map[codes.Code]struct{}, len(items))
+	for _, item := range items {
+		var code codes.Code
+		b := []byte(item)
+		if _, err := strconv.ParseUint(item, 10, 32); err != nil {
+			b = []byte(strconv.Quote(strings.ToUpper(item)))
+		}
+		if err := code.UnmarshalJSON(b); err != nil {
+			return nil, fmt.Errorf("invalid gRPC status code %q", item)
+		}
+		res[code] = struct{}{}
+	}
+	return res, nil
+}
+
+func splitList(val string) []string {
+	var res []string
+	for _, item := range strings.Split(val, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	resiliency_v1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+func TestParseRetryMatching(t *testing.T) {
+	t.Run("no rules", func(t *testing.T) {
+		m, err := ParseRetryMatching(resiliency_v1alpha.RetryMatching{})
+		assert.NoError(t, err)
+		assert.Nil(t, m)
+	})
+
+	t.Run("valid rules", func(t *testing.T) {
+		m, err := ParseRetryMatching(resiliency_v1alpha.RetryMatching{
+			HTTPStatusCodes:          "429, 500-599",
+			GRPCStatusCodes:          "UNAVAILABLE,4",
+			PermanentHTTPStatusCodes: "501",
+			PermanentGRPCStatusCodes: "invalid_argument",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []statusCodeRange{{429, 429}, {500, 599}}, m.httpStatusCodes)
+		assert.Equal(t, map[codes.Code]struct{}{codes.Unavailable: {}, codes.DeadlineExceeded: {}}, m.grpcStatusCodes)
+		assert.Equal(t, []statusCodeRange{{501, 501}}, m.permanentHTTPStatusCodes)
+		assert.Equal(t, map[codes.Code]struct{}{codes.InvalidArgument: {}}, m.permanentGRPCStatusCodes)
+	})
+
+	invalid := map[string]resiliency_v1alpha.RetryMatching{
+		"HTTP status code":       {HTTPStatusCodes: "abc"},
+		"HTTP status code range": {HTTPStatusCodes: "599-500"},
+		"HTTP status code bound": {PermanentHTTPStatusCodes: "600"},
+		"gRPC code name":         {GRPCStatusCodes: "NOT_A_CODE"},
+		"gRPC code number":       {PermanentGRPCStatusCodes: "42"},
+	}
+	for name, m := range invalid {
+		t.Run("invalid "+name, func(t *testing.T) {
+			_, err := ParseRetryMatching(m)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRetryMatchingIsRetriable(t *testing.T) {
+	m, err := ParseRetryMatching(resiliency_v1alpha.RetryMatching{
+		HTTPStatusCodes:          "429,500-599",
+		GRPCStatusCodes:          "UNAVAILABLE",
+		PermanentHTTPStatusCodes: "501",
+	})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"retriable HTTP status code":            {NewHTTPStatusCodeError(503), true},
+		"HTTP status code outside of the range": {NewHTTPStatusCodeError(400), false},
+		"permanent HTTP status code":            {NewHTTPStatusCodeError(501), false},
+		"wrapped HTTP status code":              {fmt.Errorf("invoke: %w", NewHTTPStatusCodeError(429)), true},
+		"retriable gRPC code":                   {status.Error(codes.Unavailable, "unavailable"), true},
+		"gRPC code not listed":                  {status.Error(codes.InvalidArgument, "invalid"), false},
+		"error without status code":             {errors.New("network"), true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, m.IsRetriable(tt.err))
+		})
+	}
+
+	t.Run("permanent codes only", func(t *testing.T) {
+		m, err := ParseRetryMatching(resiliency_v1alpha.RetryMatching{
+			PermanentGRPCStatusCodes: "INVALID_ARGUMENT",
+		})
+		require.NoError(t, err)
+		assert.True(t, m.IsRetriable(NewHTTPStatusCodeError(400)))
+		assert.True(t, m.IsRetriable(status.Error(codes.Unavailable, "unavailable")))
+		assert.False(t, m.IsRetriable(status.Error(codes.InvalidArgument, "invalid")))
+	})
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"reflect"
+	"sort"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/dapr/dapr/pkg/resiliency/breaker"
+)
+
+type (
+	// Status is a snapshot of the loaded resiliency policies and targets,
+	// and of the state of the circuit breakers instantiated for the targets.
+	Status struct {
+		Policies        PoliciesStatus         `json:"policies"`
+		Targets         []TargetStatus         `json:"targets"`
+		CircuitBreakers []CircuitBreakerStatus `json:"circuitBreakers"`
+	}
+
+	// PoliciesStatus contains the names of the loaded policies, by type.
+	PoliciesStatus struct {
+		Timeouts        []string `json:"timeouts"`
+		Retries         []string `json:"retries"`
+		CircuitBreakers []string `json:"circuitBreakers"`
+		Bulkheads       []string `json:"bulkheads"`
+	}
+
+	// TargetStatus contains the names of the policies applied to a target.
+	TargetStatus struct {
+		Type           PolicyType `json:"type"`
+		Name           string     `json:"name"`
+		Direction      string     `json:"direction,omitempty"`
+		Timeout        string     `json:"timeout,omitempty"`
+		Retry          string     `json:"retry,omitempty"`
+		CircuitBreaker string     `json:"circuitBreaker,omitempty"`
+		Bulkhead       string     `json:"bulkhead,omitempty"`
+	}
+
+	// CircuitBreakerStatus contains the state and the counts of a circuit breaker instance.
+	CircuitBreakerStatus struct {
+		Name                 string `json:"name"`
+		Target               string `json:"target"`
+		State                string `json:"state"`
+		Requests             uint32 `json:"requests"`
+		TotalSuccesses       uint32 `json:"totalSuccesses"`
+		TotalFailures        uint32 `json:"totalFailures"`
+		ConsecutiveSuccesses uint32 `json:"consecutiveSuccesses"`
+		ConsecutiveFailures  uint32 `json:"consecutiveFailures"`
+	}
+)
+
+// Status returns the loaded policies and targets, and the state of the circuit breakers.
+func (r *Resiliency) Status() Status {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	s := Status{
+		Policies: PoliciesStatus{
+			Timeouts:        sortedKeys(r.timeouts),
+			Retries:         sortedKeys(r.retries),
+			CircuitBreakers: sortedKeys(r.circuitBreakers),
+			Bulkheads:       sortedKeys(r.bulkheads),
+		},
+		Targets:         []TargetStatus{},
+		CircuitBreakers: []CircuitBreakerStatus{},
+	}
+
+	for _, name := range sortedKeys(r.apps) {
+		p := r.apps[name]
+		s.Targets = append(s.Targets, TargetStatus{
+			Type:           Endpoint,
+			Name:           name,
+			Timeout:        p.Timeout,
+			Retry:          p.Retry,
+			CircuitBreaker: p.CircuitBreaker,
+			Bulkhead:       p.Bulkhead,
+		})
+		s.CircuitBreakers = appendCacheCircuitBreakers(s.CircuitBreakers, "app-"+name, r.serviceCBs[name])
+	}
+
+	for _, name := range sortedKeys(r.actors) {
+		p := r.actors[name]
+		s.Targets = append(s.Targets, TargetStatus{
+			Type:           Actor,
+			Name:           name,
+			Timeout:        p.PostLockPolicies.Timeout,
+			Retry:          p.PreLockPolicies.Retry,
+			CircuitBreaker: p.PreLockPolicies.CircuitBreaker,
+			Bulkhead:       p.PreLockPolicies.Bulkhead,
+		})
+		s.CircuitBreakers = appendCacheCircuitBreakers(s.CircuitBreakers, "actor-"+name, r.actorCBCaches[name])
+	}
+
+	for _, name := range sortedKeys(r.components) {
+		p := r.components[name]
+		for _, d := range []struct {
+			direction string
+			policies  PolicyNames
+		}{
+			{"inbound", p.Inbound},
+			{"outbound", p.Outbound},
+		} {
+			s.Targets = append(s.Targets, TargetStatus{
+				Type:           Component,
+				Name:           name,
+				Direction:      d.direction,
+				Timeout:        d.policies.Timeout,
+				Retry:          d.policies.Retry,
+				CircuitBreaker: d.policies.CircuitBreaker,
+				Bulkhead:       d.policies.Bulkhead,
+			})
+		}
+	}
+
+	r.componentCBs.RLock()
+	for _, name := range sortedKeys(r.componentCBs.cbs) {
+		s.CircuitBreakers = append(s.CircuitBreakers, circuitBreakerStatus("component-"+name, r.componentCBs.cbs[name]))
+	}
+	r.componentCBs.RUnlock()
+
+	return s
+}
+
+// appendCacheCircuitBreakers appends the status of the circuit breakers in the cache,
+// without updating their recent-ness.
+func appendCacheCircuitBreakers(s []CircuitBreakerStatus, target string, cache *lru.Cache) []CircuitBreakerStatus {
+	if cache == nil {
+		return s
+	}
+	for _, key := range cache.Keys() {
+		cbi, ok := cache.Peek(key)
+		if !ok {
+			continue
+		}
+		if cb, ok := cbi.(*breaker.CircuitBreaker); ok {
+			s = append(s, circuitBreakerStatus(target, cb))
+		}
+	}
+	return s
+}
+
+func circuitBreakerStatus(target string, cb *breaker.CircuitBreaker) CircuitBreakerStatus {
+	counts := cb.Counts()
+	return CircuitBreakerStatus{
+		Name:                 cb.Name,
+		Target:               target,
+		State:                cb.State(),
+		Requests:             counts.Requests,
+		TotalSuccesses:       counts.TotalSuccesses,
+		TotalFailures:        counts.TotalFailures,
+		ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
+		ConsecutiveFailures:  counts.ConsecutiveFailures,
+	}
+}
+
+// sortedKeys returns the keys of a map with string keys, sorted.
+func sortedKeys(m interface{}) []string {
+	mapKeys := reflect.ValueOf(m).MapKeys()
+	keys := make([]string, len(mapKeys))
+	for i, k := range mapKeys {
+		keys[i] = k.String()
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resiliency
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	resiliency_v1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+)
+
+func TestResiliencyStatus(t *testing.T) {
+	r := FromConfigurations(log, &resiliency_v1alpha.Resiliency{
+		Spec: resiliency_v1alpha.ResiliencySpec{
+			Policies: resiliency_v1alpha.Policies{
+				Timeouts: map[string]string{
+					"fast": "10ms",
+				},
+				CircuitBreakers: map[string]resiliency_v1alpha.CircuitBreaker{
+					"trip": {
+						Timeout: "1m",
+						Trip:    "consecutiveFailures > 1",
+					},
+				},
+			},
+			Targets: resiliency_v1alpha.Targets{
+				Apps: map[string]resiliency_v1alpha.EndpointPolicyNames{
+					"appB": {CircuitBreaker: "trip"},
+				},
+				Components: map[string]resiliency_v1alpha.ComponentPolicyNames{
+					"statestore1": {
+						Outbound: resiliency_v1alpha.PolicyNames{
+							Timeout:        "fast",
+							CircuitBreaker: "trip",
+						},
+					},
+				},
+			},
+		},
+	})
+
+	fail := func(ctx context.Context) error {
+		return errors.New("fail")
+	}
+	for i := 0; i < 2; i++ {
+		r.ComponentOutboundPolicy(context.Background(), "statestore1")(fail)
+	}
+	r.EndpointPolicy(context.Background(), "appB", "127.0.0.1:3500")(fail)
+
+	s := r.Status()
+
+	assert.Equal(t, []string{"fast"}, s.Policies.Timeouts)
+	assert.Equal(t, []string{"trip"}, s.Policies.CircuitBreakers)
+	assert.Contains(t, s.Policies.Retries, string(BuiltInServiceRetries))
+	assert.Empty(t, s.Policies.Bulkheads)
+
+	assert.Equal(t, []TargetStatus{
+		{Type: Endpoint, Name: "appB", CircuitBreaker: "trip"},
+		{Type: Component, Name: "statestore1", Direction: "inbound"},
+		{Type: Component, Name: "statestore1", Direction: "outbound", Timeout: "fast", CircuitBreaker: "trip"},
+	}, s.Targets)
+
+	require.Len(t, s.CircuitBreakers, 2)
+	assert.Equal(t, CircuitBreakerStatus{
+		Name:                "127.0.0.1:3500",
+		Target:              "app-appB",
+		State:               "closed",
+		Requests:            1,
+		TotalFailures:       1,
+		ConsecutiveFailures: 1,
+	}, s.CircuitBreakers[0])
+	// The counts are cleared when the circuit breaker trips.
+	assert.Equal(t, CircuitBreakerStatus{
+		Name:   "trip-statestore1",
+		Target: "component-statestore1",
+		State:  "open",
+	}, s.CircuitBreakers[1])
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"context"
+	"crypto/tls"
+	"strings"
+
+	"github.com/dapr/dapr/pkg/apphealth"
+)
+
+// initAppHealthCheck creates the app health monitor, if the health checks are enabled.
+// The probes are started separately, once the runtime is initialized.
+// nolint:gosec
+func (a *DaprRuntime) initAppHealthCheck() {
+	if a.runtimeConfig.AppHealthCheck == nil {
+		return
+	}
+	if a.appChannel == nil {
+		log.Warn("app health checks are enabled but the app channel is not initialized: health checks are disabled")
+		return
+	}
+
+	var probeFn apphealth.ProbeFunction
+	switch a.runtimeConfig.ApplicationProtocol {
+	case GRPCProtocol:
+		probeFn = apphealth.NewGRPCProbe(a.grpc.AppClient)
+	default:
+		path := a.runtimeConfig.AppHealthCheck.Path
+		if path == "" {
+			path = apphealth.DefaultHealthCheckPath
+		}
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+
+		var tlsConfig *tls.Config
+		if a.runtimeConfig.AppSSL {
+			tlsConfig = &tls.Config{InsecureSkipVerify: true}
+		}
+		probeFn = apphealth.NewHTTPProbe(a.appChannel.GetBaseAddress()+path, tlsConfig)
+	}
+
+	a.appHealthLock.Lock()
+	a.appHealth = apphealth.NewAppHealth(*a.runtimeConfig.AppHealthCheck, probeFn)
+	a.appHealthyCh = make(chan struct{})
+	a.appHealthLock.Unlock()
+}
+
+// appHealthChanged pauses the subscriptions and the delivery of input binding events while the app is unhealthy.
+func (a *DaprRuntime) appHealthChanged(healthy bool) {
+	a.appHealthLock.Lock()
+	if healthy {
+		close(a.appHealthyCh)
+	} else {
+		a.appHealthyCh = make(chan struct{})
+	}
+	a.appHealthLock.Unlock()
+
+	if healthy {
+		log.Info("app is healthy: resuming subscriptions and input bindings")
+		a.startSubscribing()
+	} else {
+		log.Warn("app is unhealthy: pausing subscriptions and input bindings")
+		a.stopSubscribing()
+	}
+}
+
+// waitUntilAppIsHealthy blocks until the app is healthy or ctx is canceled.
+// It returns immediately if the app health checks are disabled.
+func (a *DaprRuntime) waitUntilAppIsHealthy(ctx context.Context) error {
+	a.appHealthLock.RLock()
+	ch := a.appHealthyCh
+	a.appHealthLock.RUnlock()
+
+	if ch == nil {
+		return nil
+	}
+
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/apphealth"
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	"github.com/dapr/dapr/pkg/modes"
+)
+
+func TestAppHealthChanged(t *testing.T) {
+	t.Run("health checks disabled", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		defer stopRuntime(t, rt)
+
+		rt.initAppHealthCheck()
+		assert.Nil(t, rt.appHealth)
+		assert.NoError(t, rt.waitUntilAppIsHealthy(context.Background()))
+	})
+
+	t.Run("subscriptions and bindings are paused while the app is unhealthy", func(t *testing.T) {
+		rt := NewTestDaprRuntime(modes.StandaloneMode)
+		defer stopRuntime(t, rt)
+		rt.runtimeConfig.AppHealthCheck = &apphealth.Config{}
+		mockAppChannel := new(channelt.MockAppChannel)
+		mockAppChannel.On("GetBaseAddress").Return("http://127.0.0.1:3000")
+		rt.appChannel = mockAppChannel
+
+		rt.initAppHealthCheck()
+		require.NotNil(t, rt.appHealth)
+		assert.Nil(t, rt.subscribeCancel)
+
+		// The app is unhealthy until the first successful probe.
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.Equal(t, context.DeadlineExceeded, rt.waitUntilAppIsHealthy(ctx))
+
+		rt.appHealthChanged(true)
+		assert.NoError(t, rt.waitUntilAppIsHealthy(context.Background()))
+		assert.NotNil(t, rt.subscribeCancel)
+
+		rt.appHealthChanged(false)
+		assert.Nil(t, rt.subscribeCancel)
+
+		healthy := make(chan struct{})
+		go func() {
+			assert.NoError(t, rt.waitUntilAppIsHealthy(context.Background()))
+			close(healthy)
+		}()
+
+		select {
+		case <-healthy:
+			t.Fatal("app must be unhealthy")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		rt.appHealthChanged(true)
+		<-healthy
+		assert.NotNil(t, rt.subscribeCancel)
+	})
+}
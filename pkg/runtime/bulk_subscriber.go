@@ -0,0 +1,388 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dapr/components-contrib/contenttype"
+	"github.com/dapr/components-contrib/pubsub"
+
+	diag "github.com/dapr/dapr/pkg/diagnostics"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+const (
+	defaultBulkSubscribeMaxMessagesCount   = 100
+	defaultBulkSubscribeMaxAwaitDurationMs = 1000
+
+	// rawPayloadContentType is the content type of the raw payloads without one, as in the CloudEvents wrapping them.
+	rawPayloadContentType = "application/octet-stream"
+)
+
+// bulkSubscribedMessage is a message buffered by a bulkSubscriber until its batch is delivered to the app.
+type bulkSubscribedMessage struct {
+	entryID string
+	msg     *pubsubSubscribedMessage
+	// ctx is the context of the component handler waiting for the result of the message.
+	ctx context.Context
+	// result receives the result of the message returned to the component.
+	result chan error
+}
+
+// bulkDeliverFunc delivers a batch of messages of the same topic and route to the app.
+// It returns the status reported by the app for each message, by entry ID.
+type bulkDeliverFunc func(ctx context.Context, entries []*bulkSubscribedMessage) (map[string]pubsub.AppResponseStatus, error)
+
+// bulkDeadLetterFunc sends a message the app failed to process to the dead letter topic.
+// It returns false if the message wasn't sent, because no dead letter topic is configured or publishing failed.
+type bulkDeadLetterFunc func(msg *pubsubSubscribedMessage) bool
+
+type bulkBatch struct {
+	entries []*bulkSubscribedMessage
+	timer   *time.Timer
+}
+
+// bulkSubscriber buffers the messages of a topic per route and delivers them to the app
+// as a single request, once either the max messages count is reached or the max await
+// duration has elapsed since the first message of the batch was received.
+// The component handler of each message waits for the result of the message, so that the component only
+// acknowledges the messages processed by the app: batches are filled by components delivering messages
+// concurrently, otherwise they are delivered once the max await duration has elapsed.
+type bulkSubscriber struct {
+	ctx              context.Context
+	pubsubName       string
+	topic            string
+	maxMessagesCount int
+	maxAwaitDuration time.Duration
+	deliver          bulkDeliverFunc
+	deadLetter       bulkDeadLetterFunc
+
+	lock    sync.Mutex
+	batches map[string]*bulkBatch
+}
+
+func newBulkSubscriber(ctx context.Context, pubsubName, topic string, opts runtime_pubsub.BulkSubscribe, deliver bulkDeliverFunc, deadLetter bulkDeadLetterFunc) *bulkSubscriber {
+	maxMessagesCount := int(opts.MaxMessagesCount)
+	if maxMessagesCount <= 0 {
+		maxMessagesCount = defaultBulkSubscribeMaxMessagesCount
+	}
+	maxAwaitDurationMs := opts.MaxAwaitDurationMs
+	if maxAwaitDurationMs <= 0 {
+		maxAwaitDurationMs = defaultBulkSubscribeMaxAwaitDurationMs
+	}
+
+	return &bulkSubscriber{
+		ctx:              ctx,
+		pubsubName:       pubsubName,
+		topic:            topic,
+		maxMessagesCount: maxMessagesCount,
+		maxAwaitDuration: time.Duration(maxAwaitDurationMs) * time.Millisecond,
+		deliver:          deliver,
+		deadLetter:       deadLetter,
+		batches:          map[string]*bulkBatch{},
+	}
+}
+
+// enqueue adds the message to the batch of its route and waits for the result of the message.
+// It returns nil if the app processed or dropped the message, or if it was sent to the dead letter topic.
+// Otherwise it returns the error to the component, which delivers the message again according to its
+// own retry policy.
+func (b *bulkSubscriber) enqueue(ctx context.Context, msg *pubsubSubscribedMessage) error {
+	if err := b.ctx.Err(); err != nil {
+		return err
+	}
+
+	entry := &bulkSubscribedMessage{
+		entryID: uuid.New().String(),
+		msg:     msg,
+		ctx:     ctx,
+		result:  make(chan error, 1),
+	}
+	b.add(entry)
+
+	select {
+	case err := <-entry.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-b.ctx.Done():
+		return b.ctx.Err()
+	}
+}
+
+func (b *bulkSubscriber) add(entry *bulkSubscribedMessage) {
+	path := entry.msg.path
+
+	b.lock.Lock()
+	batch, ok := b.batches[path]
+	if !ok {
+		batch = &bulkBatch{}
+		b.batches[path] = batch
+		batch.timer = time.AfterFunc(b.maxAwaitDuration, func() {
+			if b.take(path, batch) {
+				b.deliverBatch(batch.entries)
+			}
+		})
+	}
+	batch.entries = append(batch.entries, entry)
+	full := len(batch.entries) >= b.maxMessagesCount
+	if full {
+		delete(b.batches, path)
+		batch.timer.Stop()
+	}
+	b.lock.Unlock()
+
+	if full {
+		go b.deliverBatch(batch.entries)
+	}
+}
+
+// take removes the batch from the buffer, unless it was already removed because it was full.
+func (b *bulkSubscriber) take(path string, batch *bulkBatch) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.batches[path] != batch {
+		return false
+	}
+	delete(b.batches, path)
+	return true
+}
+
+func (b *bulkSubscriber) deliverBatch(entries []*bulkSubscribedMessage) {
+	if err := b.ctx.Err(); err != nil {
+		// The messages are not acknowledged, so the component delivers them again.
+		log.Warnf("subscription to topic %s on pubsub %s was canceled, %d buffered messages are not delivered", b.topic, b.pubsubName, len(entries))
+		for _, e := range entries {
+			e.result <- err
+		}
+		return
+	}
+
+	// The messages whose handler stopped waiting are not delivered, as the component delivers them again.
+	pending := make([]*bulkSubscribedMessage, 0, len(entries))
+	for _, e := range entries {
+		if err := e.ctx.Err(); err != nil {
+			e.result <- err
+			continue
+		}
+		pending = append(pending, e)
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	start := time.Now()
+	statuses, err := b.deliver(b.ctx, pending)
+	elapsed := diag.ElapsedSince(start)
+
+	for _, e := range pending {
+		if err != nil {
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(b.ctx, b.pubsubName, strings.ToLower(string(pubsub.Retry)), b.topic, elapsed)
+			e.result <- b.fail(e, err)
+			continue
+		}
+
+		eventID := e.msg.cloudEvent[pubsub.IDField]
+		switch s := statuses[e.entryID]; s {
+		case pubsub.Success:
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(b.ctx, b.pubsubName, strings.ToLower(string(pubsub.Success)), b.topic, elapsed)
+			e.result <- nil
+		case pubsub.Drop:
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(b.ctx, b.pubsubName, strings.ToLower(string(pubsub.Drop)), b.topic, elapsed)
+			log.Warnf("DROP status returned from app while processing pub/sub event %v", eventID)
+			e.result <- nil
+		case pubsub.Retry:
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(b.ctx, b.pubsubName, strings.ToLower(string(pubsub.Retry)), b.topic, elapsed)
+			e.result <- b.fail(e, errors.Errorf("RETRY status returned from app while processing pub/sub event %v", eventID))
+		case "":
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(b.ctx, b.pubsubName, strings.ToLower(string(pubsub.Retry)), b.topic, elapsed)
+			e.result <- b.fail(e, errors.Errorf("no status returned from app while processing pub/sub event %v", eventID))
+		default:
+			// Consider unknown status field as error and retry
+			diag.DefaultComponentMonitoring.PubsubIngressEvent(b.ctx, b.pubsubName, strings.ToLower(string(pubsub.Retry)), b.topic, elapsed)
+			e.result <- b.fail(e, errors.Errorf("unknown status returned from app while processing pub/sub event %v: %v", eventID, s))
+		}
+	}
+}
+
+// fail sends the message the app failed to process to the dead letter topic and returns nil,
+// or returns the error if it can't, so that the component delivers the message again.
+func (b *bulkSubscriber) fail(entry *bulkSubscribedMessage, err error) error {
+	if b.deadLetter != nil && b.deadLetter(entry.msg) {
+		log.Warnf("%s, the event was sent to the dead letter topic", err)
+		return nil
+	}
+
+	log.Warnf("%s", err)
+	return err
+}
+
+// bulkEntryContentType returns the content type of the event delivered to the app for the message: a CloudEvent,
+// or the content type of the raw payload.
+func bulkEntryContentType(msg *pubsubSubscribedMessage) string {
+	if !msg.rawPayload {
+		return contenttype.CloudEventContentType
+	}
+	if msg.message.ContentType != nil && *msg.message.ContentType != "" {
+		return *msg.message.ContentType
+	}
+	return rawPayloadContentType
+}
+
+func allBulkEntriesWithStatus(entries []*bulkSubscribedMessage, s pubsub.AppResponseStatus) map[string]pubsub.AppResponseStatus {
+	statuses := make(map[string]pubsub.AppResponseStatus, len(entries))
+	for _, e := range entries {
+		statuses[e.entryID] = s
+	}
+	return statuses
+}
+
+func (a *DaprRuntime) publishBulkMessageHTTP(ctx context.Context, entries []*bulkSubscribedMessage) (map[string]pubsub.AppResponseStatus, error) {
+	first := entries[0].msg
+
+	envelope := runtime_pubsub.BulkSubscribeEnvelope{
+		ID:      uuid.New().String(),
+		Entries: make([]runtime_pubsub.BulkSubscribeMessageItem, len(entries)),
+		Topic:   first.topic,
+		Pubsub:  first.pubsub,
+		Type:    runtime_pubsub.BulkSubscribeEventType,
+	}
+	for i, e := range entries {
+		item := runtime_pubsub.BulkSubscribeMessageItem{
+			EntryID:     e.entryID,
+			Event:       e.msg.cloudEvent,
+			Metadata:    e.msg.metadata,
+			ContentType: bulkEntryContentType(e.msg),
+		}
+		if e.msg.rawPayload {
+			// Raw payloads are delivered as is: JSON is embedded in the envelope, text as a string, and
+			// anything else as a base64 encoded string.
+			switch {
+			case contenttype.IsJSONContentType(item.ContentType) && json.Valid(e.msg.message.Data):
+				item.Event = json.RawMessage(e.msg.message.Data)
+			case contenttype.IsStringContentType(item.ContentType):
+				item.Event = string(e.msg.message.Data)
+			default:
+				item.Event = e.msg.message.Data
+			}
+		}
+		envelope.Entries[i] = item
+	}
+
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, errors.Wrap(err, "error serializing bulk pub/sub events")
+	}
+
+	req := invokev1.NewInvokeMethodRequest(first.path)
+	req.WithHTTPExtension(nethttp.MethodPost, "")
+	req.WithRawData(data, invokev1.JSONContentType)
+
+	resp, err := a.appChannel.InvokeMethod(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "error from app channel while sending bulk pub/sub events to app")
+	}
+
+	statusCode := int(resp.Status().Code)
+	_, body := resp.RawData()
+
+	if (statusCode >= 200) && (statusCode <= 299) {
+		var appResponse runtime_pubsub.BulkSubscribeResponse
+		if err = json.Unmarshal(body, &appResponse); err != nil {
+			return nil, errors.Errorf("error parsing result from bulk pub/sub event %s: %s", envelope.ID, err)
+		}
+
+		statuses := make(map[string]pubsub.AppResponseStatus, len(appResponse.Statuses))
+		for _, s := range appResponse.Statuses {
+			statuses[s.EntryID] = s.Status
+		}
+		return statuses, nil
+	}
+
+	if statusCode == nethttp.StatusNotFound {
+		// Not retriable, consistently with the delivery of a single event.
+		log.Errorf("non-retriable error returned from app while processing bulk pub/sub event %s: %s. status code returned: %v", envelope.ID, body, statusCode)
+		return allBulkEntriesWithStatus(entries, pubsub.Drop), nil
+	}
+
+	log.Warnf("retriable error returned from app while processing bulk pub/sub event %s, topic: %v, body: %s. status code returned: %v", envelope.ID, envelope.Topic, body, statusCode)
+	return nil, errors.Errorf("retriable error returned from app while processing bulk pub/sub event %s, topic: %v, body: %s. status code returned: %v", envelope.ID, envelope.Topic, body, statusCode)
+}
+
+func (a *DaprRuntime) publishBulkMessageGRPC(ctx context.Context, entries []*bulkSubscribedMessage) (map[string]pubsub.AppResponseStatus, error) {
+	first := entries[0].msg
+
+	req := &runtimev1pb.TopicEventBulkRequest{
+		Id:         uuid.New().String(),
+		Entries:    make([]*runtimev1pb.TopicEventBulkRequestEntry, len(entries)),
+		Topic:      first.topic,
+		PubsubName: first.pubsub,
+		Type:       runtime_pubsub.BulkSubscribeEventType,
+		Path:       first.path,
+	}
+	for i, e := range entries {
+		event := e.msg.data
+		if e.msg.rawPayload {
+			event = e.msg.message.Data
+		}
+		req.Entries[i] = &runtimev1pb.TopicEventBulkRequestEntry{
+			EntryId:     e.entryID,
+			Event:       event,
+			ContentType: bulkEntryContentType(e.msg),
+			Metadata:    e.msg.metadata,
+		}
+	}
+
+	clientV1 := runtimev1pb.NewAppCallbackAlphaClient(a.grpc.AppClient)
+	res, err := clientV1.OnBulkTopicEventAlpha1(ctx, req)
+	if err != nil {
+		if errStatus, ok := status.FromError(err); ok && errStatus.Code() == codes.Unimplemented {
+			log.Warnf("non-retriable error returned from app while processing bulk pub/sub event %s: %s", req.Id, err)
+			return allBulkEntriesWithStatus(entries, pubsub.Drop), nil
+		}
+
+		err = errors.Errorf("error returned from app while processing bulk pub/sub event %s: %s", req.Id, err)
+		log.Debug(err)
+		return nil, err
+	}
+
+	statuses := make(map[string]pubsub.AppResponseStatus, len(res.GetStatuses()))
+	for _, s := range res.GetStatuses() {
+		switch s.GetStatus() {
+		case runtimev1pb.TopicEventResponse_SUCCESS:
+			statuses[s.GetEntryId()] = pubsub.Success
+		case runtimev1pb.TopicEventResponse_RETRY:
+			statuses[s.GetEntryId()] = pubsub.Retry
+		case runtimev1pb.TopicEventResponse_DROP:
+			statuses[s.GetEntryId()] = pubsub.Drop
+		default:
+			statuses[s.GetEntryId()] = pubsub.AppResponseStatus(s.GetStatus().String())
+		}
+	}
+	return statuses, nil
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/components-contrib/pubsub"
+
+	channelt "github.com/dapr/dapr/pkg/channel/testing"
+	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	"github.com/dapr/dapr/pkg/modes"
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
+)
+
+func testBulkSubscribedMessage(id string) *pubsubSubscribedMessage {
+	return &pubsubSubscribedMessage{
+		cloudEvent: map[string]interface{}{pubsub.IDField: id},
+		data:       []byte(`{"id":"` + id + `"}`),
+		topic:      "topic1",
+		metadata:   map[string]string{pubsubName: TestPubsubName},
+		path:       "orders",
+		pubsub:     TestPubsubName,
+	}
+}
+
+// enqueueAll enqueues the messages concurrently, as components delivering several messages at a time do,
+// and returns the results returned to the component by message ID.
+func enqueueAll(b *bulkSubscriber, ids ...string) map[string]error {
+	var (
+		wg      sync.WaitGroup
+		lock    sync.Mutex
+		results = make(map[string]error, len(ids))
+	)
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			err := b.enqueue(context.Background(), testBulkSubscribedMessage(id))
+
+			lock.Lock()
+			results[id] = err
+			lock.Unlock()
+		}(id)
+	}
+	wg.Wait()
+	return results
+}
+
+func TestBulkSubscriber(t *testing.T) {
+	t.Run("batch is delivered when max messages count is reached", func(t *testing.T) {
+		delivered := make(chan []*bulkSubscribedMessage, 1)
+		deliver := func(ctx context.Context, entries []*bulkSubscribedMessage) (map[string]pubsub.AppResponseStatus, error) {
+			delivered <- entries
+
+			statuses := map[string]pubsub.AppResponseStatus{}
+			for _, e := range entries {
+				switch e.msg.cloudEvent[pubsub.IDField] {
+				case "1":
+					statuses[e.entryID] = pubsub.Success
+				case "2":
+					statuses[e.entryID] = pubsub.Drop
+				case "3":
+					statuses[e.entryID] = pubsub.Retry
+				}
+			}
+			return statuses, nil
+		}
+		deadLettered := make(chan string, 3)
+		deadLetter := func(msg *pubsubSubscribedMessage) bool {
+			deadLettered <- msg.cloudEvent[pubsub.IDField].(string)
+			return true
+		}
+		b := newBulkSubscriber(context.Background(), TestPubsubName, "topic1", runtime_pubsub.BulkSubscribe{
+			Enabled:            true,
+			MaxMessagesCount:   3,
+			MaxAwaitDurationMs: 60000,
+		}, deliver, deadLetter)
+
+		results := enqueueAll(b, "1", "2", "3")
+
+		assert.Len(t, <-delivered, 3)
+		assert.Equal(t, map[string]error{"1": nil, "2": nil, "3": nil}, results)
+		assert.Equal(t, "3", <-deadLettered)
+		assert.Empty(t, deadLettered)
+	})
+
+	t.Run("batch is delivered when max await duration elapses", func(t *testing.T) {
+		delivered := make(chan int, 1)
+		deliver := func(ctx context.Context, entries []*bulkSubscribedMessage) (map[string]pubsub.AppResponseStatus, error) {
+			delivered <- len(entries)
+			return allBulkEntriesWithStatus(entries, pubsub.Success), nil
+		}
+		b := newBulkSubscriber(context.Background(), TestPubsubName, "topic1", runtime_pubsub.BulkSubscribe{
+			Enabled:            true,
+			MaxMessagesCount:   100,
+			MaxAwaitDurationMs: 10,
+		}, deliver, nil)
+
+		results := enqueueAll(b, "1", "2")
+		assert.Equal(t, map[string]error{"1": nil, "2": nil}, results)
+		assert.Equal(t, 2, <-delivered)
+	})
+
+	t.Run("failed messages are returned to the component without dead letter topic", func(t *testing.T) {
+		var lock sync.Mutex
+		deliveries := 0
+		deliver := func(ctx context.Context, entries []*bulkSubscribedMessage) (map[string]pubsub.AppResponseStatus, error) {
+			lock.Lock()
+			defer lock.Unlock()
+
+			deliveries++
+			if entries[0].msg.cloudEvent[pubsub.IDField] == "1" {
+				return nil, errors.New("app unavailable")
+			}
+			return map[string]pubsub.AppResponseStatus{}, nil
+		}
+		b := newBulkSubscriber(context.Background(), TestPubsubName, "topic1", runtime_pubsub.BulkSubscribe{
+			Enabled:            true,
+			MaxMessagesCount:   1,
+			MaxAwaitDurationMs: 60000,
+		}, deliver, func(msg *pubsubSubscribedMessage) bool {
+			return false
+		})
+
+		assert.Error(t, b.enqueue(context.Background(), testBulkSubscribedMessage("1")))
+		assert.Error(t, b.enqueue(context.Background(), testBulkSubscribedMessage("2")))
+
+		// The messages are not delivered again by the subscriber.
+		lock.Lock()
+		defer lock.Unlock()
+		assert.Equal(t, 2, deliveries)
+		assert.Empty(t, b.batches)
+	})
+
+	t.Run("buffered messages are not acknowledged when the subscription is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		deliver := func(ctx context.Context, entries []*bulkSubscribedMessage) (map[string]pubsub.AppResponseStatus, error) {
+			t.Error("canceled messages must not be delivered")
+			return nil, nil
+		}
+		b := newBulkSubscriber(ctx, TestPubsubName, "topic1", runtime_pubsub.BulkSubscribe{
+			Enabled:            true,
+			MaxMessagesCount:   100,
+			MaxAwaitDurationMs: 10,
+		}, deliver, nil)
+
+		go func() {
+			time.Sleep(time.Millisecond)
+			cancel()
+		}()
+		assert.Equal(t, context.Canceled, b.enqueue(context.Background(), testBulkSubscribedMessage("1")))
+		// Wait for the batch timer.
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	t.Run("messages are not delivered once their handler stopped waiting", func(t *testing.T) {
+		delivered := make(chan int, 1)
+		deliver := func(ctx context.Context, entries []*bulkSubscribedMessage) (map[string]pubsub.AppResponseStatus, error) {
+			delivered <- len(entries)
+			return allBulkEntriesWithStatus(entries, pubsub.Success), nil
+		}
+		b := newBulkSubscriber(context.Background(), TestPubsubName, "topic1", runtime_pubsub.BulkSubscribe{
+			Enabled:            true,
+			MaxMessagesCount:   2,
+			MaxAwaitDurationMs: 60000,
+		}, deliver, nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.Equal(t, context.DeadlineExceeded, b.enqueue(ctx, testBulkSubscribedMessage("1")))
+		assert.NoError(t, b.enqueue(context.Background(), testBulkSubscribedMessage("2")))
+		assert.Equal(t, 1, <-delivered)
+	})
+
+	t.Run("messages are not queued once the subscription is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		b := newBulkSubscriber(ctx, TestPubsubName, "topic1", runtime_pubsub.BulkSubscribe{
+			Enabled:            true,
+			MaxMessagesCount:   100,
+			MaxAwaitDurationMs: 60000,
+		}, nil, nil)
+
+		cancel()
+		assert.Equal(t, context.Canceled, b.enqueue(context.Background(), testBulkSubscribedMessage("1")))
+		assert.Empty(t, b.batches)
+	})
+}
+
+func TestPublishBulkMessageHTTP(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	entries := []*bulkSubscribedMessage{
+		{entryID: "a", msg: testBulkSubscribedMessage("1")},
+		{entryID: "b", msg: testBulkSubscribedMessage("2")},
+	}
+
+	t.Run("app returns per entry statuses", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		body, _ := json.Marshal(runtime_pubsub.BulkSubscribeResponse{
+			Statuses: []runtime_pubsub.BulkSubscribeResponseEntry{
+				{EntryID: "a", Status: pubsub.Success},
+				{EntryID: "b", Status: pubsub.Retry},
+			},
+		})
+		fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+		fakeResp.WithRawData(body, "application/json")
+
+		var req *invokev1.InvokeMethodRequest
+		mockAppChannel.On("InvokeMethod", mock.Anything, mock.AnythingOfType("*v1.InvokeMethodRequest")).
+			Run(func(args mock.Arguments) {
+				req = args.Get(1).(*invokev1.InvokeMethodRequest)
+			}).
+			Return(fakeResp, nil)
+
+		statuses, err := rt.publishBulkMessageHTTP(context.Background(), entries)
+		require.NoError(t, err)
+		assert.Equal(t, pubsub.Success, statuses["a"])
+		assert.Equal(t, pubsub.Retry, statuses["b"])
+
+		require.NotNil(t, req)
+		assert.Equal(t, "orders", req.Message().Method)
+		_, data := req.RawData()
+		var envelope runtime_pubsub.BulkSubscribeEnvelope
+		require.NoError(t, json.Unmarshal(data, &envelope))
+		assert.Equal(t, runtime_pubsub.BulkSubscribeEventType, envelope.Type)
+		assert.Equal(t, "topic1", envelope.Topic)
+		assert.Equal(t, TestPubsubName, envelope.Pubsub)
+		require.Len(t, envelope.Entries, 2)
+		assert.Equal(t, "a", envelope.Entries[0].EntryID)
+		assert.Equal(t, "b", envelope.Entries[1].EntryID)
+	})
+
+	t.Run("raw payloads are delivered with their content type", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		fakeResp := invokev1.NewInvokeMethodResponse(200, "OK", nil)
+		fakeResp.WithRawData([]byte(`{"statuses":[]}`), "application/json")
+
+		var req *invokev1.InvokeMethodRequest
+		mockAppChannel.On("InvokeMethod", mock.Anything, mock.AnythingOfType("*v1.InvokeMethodRequest")).
+			Run(func(args mock.Arguments) {
+				req = args.Get(1).(*invokev1.InvokeMethodRequest)
+			}).
+			Return(fakeResp, nil)
+
+		jsonContentType := "application/json"
+		rawEntries := []*bulkSubscribedMessage{
+			{entryID: "a", msg: testBulkSubscribedMessage("1")},
+			{entryID: "b", msg: testBulkSubscribedMessage("2")},
+			{entryID: "c", msg: testBulkSubscribedMessage("3")},
+		}
+		rawEntries[1].msg.rawPayload = true
+		rawEntries[1].msg.message = &pubsub.NewMessage{Data: []byte(`{"a":1}`), ContentType: &jsonContentType}
+		rawEntries[2].msg.rawPayload = true
+		rawEntries[2].msg.message = &pubsub.NewMessage{Data: []byte{0x1}}
+
+		_, err := rt.publishBulkMessageHTTP(context.Background(), rawEntries)
+		require.NoError(t, err)
+
+		require.NotNil(t, req)
+		_, data := req.RawData()
+		var envelope struct {
+			Entries []struct {
+				Event       json.RawMessage `json:"event"`
+				ContentType string          `json:"contentType"`
+			} `json:"entries"`
+		}
+		require.NoError(t, json.Unmarshal(data, &envelope))
+		require.Len(t, envelope.Entries, 3)
+		assert.Equal(t, "application/cloudevents+json", envelope.Entries[0].ContentType)
+		assert.Equal(t, "application/json", envelope.Entries[1].ContentType)
+		assert.JSONEq(t, `{"a":1}`, string(envelope.Entries[1].Event))
+		assert.Equal(t, "application/octet-stream", envelope.Entries[2].ContentType)
+		assert.Equal(t, `"AQ=="`, string(envelope.Entries[2].Event))
+	})
+
+	t.Run("app returns not found", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		fakeResp := invokev1.NewInvokeMethodResponse(404, "NotFound", nil)
+		mockAppChannel.On("InvokeMethod", mock.Anything, mock.Anything).Return(fakeResp, nil)
+
+		statuses, err := rt.publishBulkMessageHTTP(context.Background(), entries)
+		require.NoError(t, err)
+		assert.Equal(t, pubsub.Drop, statuses["a"])
+		assert.Equal(t, pubsub.Drop, statuses["b"])
+	})
+
+	t.Run("app returns an error", func(t *testing.T) {
+		mockAppChannel := new(channelt.MockAppChannel)
+		rt.appChannel = mockAppChannel
+
+		fakeResp := invokev1.NewInvokeMethodResponse(500, "Internal Error", nil)
+		mockAppChannel.On("InvokeMethod", mock.Anything, mock.Anything).Return(fakeResp, nil)
+
+		_, err := rt.publishBulkMessageHTTP(context.Background(), entries)
+		assert.Error(t, err)
+	})
+}
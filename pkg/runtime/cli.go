@@ -27,6 +27,7 @@ import (
 
 	"github.com/dapr/dapr/pkg/acl"
 	resiliency_v1alpha "github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
+	"github.com/dapr/dapr/pkg/apphealth"
 	global_config "github.com/dapr/dapr/pkg/config"
 	env "github.com/dapr/dapr/pkg/config/env"
 	"github.com/dapr/dapr/pkg/cors"
@@ -69,10 +70,15 @@ func FromFlags() (*DaprRuntime, error) {
 	daprHTTPMaxRequestSize := flag.Int("dapr-http-max-request-size", -1, "Increasing max size of request body in MB to handle uploading of big files. By default 4 MB.")
 	unixDomainSocket := flag.String("unix-domain-socket", "", "Path to a unix domain socket dir mount. If specified, Dapr API servers will use Unix Domain Sockets")
 	daprHTTPReadBufferSize := flag.Int("dapr-http-read-buffer-size", -1, "Increasing max size of read buffer in KB to handle sending multi-KB headers. By default 4 KB.")
-	daprHTTPStreamRequestBody := flag.Bool("dapr-http-stream-request-body", false, "Enables request body streaming on http server")
+	daprHTTPStreamRequestBody := flag.Bool("dapr-http-stream-request-body", false, "Enables request body streaming on http server and streaming of the service invocation payloads")
 	daprGracefulShutdownSeconds := flag.Int("dapr-graceful-shutdown-seconds", -1, "Graceful shutdown time in seconds.")
 	enableAPILogging := flag.Bool("enable-api-logging", false, "Enable API logging for API calls")
 	disableBuiltinK8sSecretStore := flag.Bool("disable-builtin-k8s-secret-store", false, "Disable Builtin Kubernetes Secret Store")
+	enableAppHealthCheck := flag.Bool("enable-app-health-check", false, "Enable health checks for the application; subscriptions and input bindings are paused while the app is unhealthy")
+	appHealthCheckPath := flag.String("app-health-check-path", apphealth.DefaultHealthCheckPath, "Path used for health checks of HTTP apps")
+	appHealthProbeInterval := flag.Int("app-health-probe-interval", int(apphealth.DefaultProbeInterval/time.Second), "Interval in seconds between two health probes of the application")
+	appHealthProbeTimeout := flag.Int("app-health-probe-timeout", int(apphealth.DefaultProbeTimeout/time.Millisecond), "Timeout in milliseconds of the health probes of the application")
+	appHealthThreshold := flag.Int("app-health-threshold", apphealth.DefaultThreshold, "Number of consecutive failed health probes after which the application is considered unhealthy")
 
 	loggerOptions := logger.DefaultOptions()
 	loggerOptions.AttachCmdFlags(flag.StringVar, flag.BoolVar)
@@ -112,6 +118,7 @@ func FromFlags() (*DaprRuntime, error) {
 	log.Infof("log level set to: %s", loggerOptions.OutputLevel)
 
 	// Initialize dapr metrics exporter
+	metricsExporter.Options().OTLP.ServiceName = *appID
 	if err := metricsExporter.Init(); err != nil {
 		log.Fatal(err)
 	}
@@ -205,12 +212,22 @@ func FromFlags() (*DaprRuntime, error) {
 		appPrtcl = *appProtocol
 	}
 
+	var appHealthCheck *apphealth.Config
+	if *enableAppHealthCheck {
+		appHealthCheck = &apphealth.Config{
+			Path:          *appHealthCheckPath,
+			ProbeInterval: time.Duration(*appHealthProbeInterval) * time.Second,
+			ProbeTimeout:  time.Duration(*appHealthProbeTimeout) * time.Millisecond,
+			Threshold:     int32(*appHealthThreshold),
+		}
+	}
+
 	daprAPIListenAddressList := strings.Split(*daprAPIListenAddresses, ",")
 	if len(daprAPIListenAddressList) == 0 {
 		daprAPIListenAddressList = []string{DefaultAPIListenAddress}
 	}
 	runtimeConfig := NewRuntimeConfig(*appID, placementAddresses, *controlPlaneAddress, *allowedOrigins, *config, *componentsPath,
-		appPrtcl, *mode, daprHTTP, daprInternalGRPC, daprAPIGRPC, daprAPIListenAddressList, publicPort, applicationPort, profPort, *enableProfiling, concurrency, *enableMTLS, *sentryAddress, *appSSL, maxRequestBodySize, *unixDomainSocket, readBufferSize, *daprHTTPStreamRequestBody, gracefulShutdownDuration, *enableAPILogging, *disableBuiltinK8sSecretStore)
+		appPrtcl, *mode, daprHTTP, daprInternalGRPC, daprAPIGRPC, daprAPIListenAddressList, publicPort, applicationPort, profPort, *enableProfiling, concurrency, *enableMTLS, *sentryAddress, *appSSL, maxRequestBodySize, *unixDomainSocket, readBufferSize, *daprHTTPStreamRequestBody, gracefulShutdownDuration, *enableAPILogging, *disableBuiltinK8sSecretStore, appHealthCheck)
 
 	// set environment variables
 	// TODO - consider adding host address to runtime config and/or caching result in utils package
@@ -279,6 +296,14 @@ func FromFlags() (*DaprRuntime, error) {
 		globalConfig = global_config.LoadDefaultConfiguration()
 	}
 
+	// Push metrics to the OpenTelemetry collector of the configuration, unless one is set by flag
+	if otelSpec := globalConfig.Spec.MetricSpec.Otel; otelSpec != nil && metricsExporter.Options().OTLP.Endpoint == "" {
+		applyMetricOtelSpec(&metricsExporter.Options().OTLP, otelSpec)
+		if err = metricsExporter.InitOTLP(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	features := globalConfig.Spec.Features
 	resiliencyEnabled := global_config.IsFeatureEnabled(features, global_config.Resiliency)
 
@@ -304,7 +329,21 @@ func FromFlags() (*DaprRuntime, error) {
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
-	return NewDaprRuntime(runtimeConfig, globalConfig, accessControlList, resiliencyProvider), nil
+	rt := NewDaprRuntime(runtimeConfig, globalConfig, accessControlList, resiliencyProvider)
+	rt.metricsExporter = metricsExporter
+	return rt, nil
+}
+
+func applyMetricOtelSpec(opts *metrics.OTLPOptions, spec *global_config.MetricOtelSpec) {
+	opts.Endpoint = spec.EndpointAddress
+	if spec.Protocol != "" {
+		opts.Protocol = spec.Protocol
+	}
+	if spec.Interval != "" {
+		opts.Interval = spec.Interval
+	}
+	opts.Headers = spec.Headers
+	opts.Insecure = spec.Insecure
 }
 
 func parsePlacementAddr(val string) []string {
@@ -16,6 +16,7 @@ package runtime
 import (
 	"time"
 
+	"github.com/dapr/dapr/pkg/apphealth"
 	config "github.com/dapr/dapr/pkg/config/modes"
 	"github.com/dapr/dapr/pkg/credentials"
 	"github.com/dapr/dapr/pkg/modes"
@@ -77,6 +78,7 @@ type Config struct {
 	GracefulShutdownDuration     time.Duration
 	EnableAPILogging             bool
 	DisableBuiltinK8sSecretStore bool
+	AppHealthCheck               *apphealth.Config
 }
 
 // NewRuntimeConfig returns a new runtime config.
@@ -86,6 +88,7 @@ func NewRuntimeConfig(
 	httpPort, internalGRPCPort, apiGRPCPort int, apiListenAddresses []string, publicPort *int, appPort, profilePort int,
 	enableProfiling bool, maxConcurrency int, mtlsEnabled bool, sentryAddress string, appSSL bool, maxRequestBodySize int,
 	unixDomainSocket string, readBufferSize int, streamRequestBody bool, gracefulShutdownDuration time.Duration, enableAPILogging bool, disableBuiltinK8sSecretStore bool,
+	appHealthCheck *apphealth.Config,
 ) *Config {
 	return &Config{
 		ID:                  id,
@@ -119,5 +122,6 @@ func NewRuntimeConfig(
 		GracefulShutdownDuration:     gracefulShutdownDuration,
 		EnableAPILogging:             enableAPILogging,
 		DisableBuiltinK8sSecretStore: disableBuiltinK8sSecretStore,
+		AppHealthCheck:               appHealthCheck,
 	}
 }
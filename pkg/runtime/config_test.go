@@ -18,12 +18,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/dapr/pkg/apphealth"
 )
 
 func TestNewConfig(t *testing.T) {
 	publicPort := DefaultDaprPublicPort
 	c := NewRuntimeConfig("app1", []string{"localhost:5050"}, "localhost:5051", "*", "config", "components", "http", "kubernetes",
-		3500, 50002, 50001, []string{"1.2.3.4"}, &publicPort, 8080, 7070, true, 1, true, "localhost:5052", true, 4, "", 4, true, time.Second, true, true)
+		3500, 50002, 50001, []string{"1.2.3.4"}, &publicPort, 8080, 7070, true, 1, true, "localhost:5052", true, 4, "", 4, true, time.Second, true, true, &apphealth.Config{Path: "/healthz"})
 
 	assert.Equal(t, "app1", c.ID)
 	assert.Equal(t, "localhost:5050", c.PlacementAddresses[0])
@@ -52,4 +54,5 @@ func TestNewConfig(t *testing.T) {
 	assert.Equal(t, time.Second, c.GracefulShutdownDuration)
 	assert.Equal(t, true, c.EnableAPILogging)
 	assert.Equal(t, true, c.DisableBuiltinK8sSecretStore)
+	assert.Equal(t, "/healthz", c.AppHealthCheck.Path)
 }
@@ -0,0 +1,243 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dapr/components-contrib/secretstores"
+
+	httpendpoint_v1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	"github.com/dapr/dapr/pkg/channel"
+	http_channel "github.com/dapr/dapr/pkg/channel/http"
+	"github.com/dapr/dapr/pkg/components"
+	"github.com/dapr/dapr/pkg/modes"
+)
+
+// loadHTTPEndpoints loads the HTTP endpoints authorized for this app and resolves their secrets.
+// Secret stores must be initialized before the endpoints are loaded.
+// The endpoints are loaded once, they aren't hot-reloaded with the components: the names which
+// collide with app IDs are rejected here, so that they can't shadow a Dapr app later on.
+func (a *DaprRuntime) loadHTTPEndpoints() error {
+	var loader components.HTTPEndpointsLoader
+
+	switch a.runtimeConfig.Mode {
+	case modes.KubernetesMode:
+		loader = components.NewKubernetesComponents(a.runtimeConfig.Kubernetes, a.namespace, a.operatorClient, a.podName)
+	case modes.StandaloneMode:
+		loader = components.NewStandaloneComponents(a.runtimeConfig.Standalone)
+	default:
+		return errors.Errorf("http endpoints loader for mode %s not found", a.runtimeConfig.Mode)
+	}
+
+	log.Info("loading http endpoints")
+	endpoints, err := loader.LoadHTTPEndpoints()
+	if err != nil {
+		return err
+	}
+
+	a.httpEndpoints = make([]httpendpoint_v1alpha1.HTTPEndpoint, 0, len(endpoints))
+	names := make(map[string]struct{}, len(endpoints))
+	for _, endpoint := range endpoints {
+		if !a.isHTTPEndpointAuthorized(endpoint) {
+			continue
+		}
+
+		if err = a.validateHTTPEndpointName(endpoint.Name, names); err != nil {
+			log.Warnf("http endpoint %s is not loaded: %s", endpoint.Name, err)
+			continue
+		}
+		names[endpoint.Name] = struct{}{}
+
+		endpoint, err = a.processHTTPEndpointSecrets(endpoint)
+		if err != nil {
+			log.Warnf("failed to process secrets of http endpoint %s: %s", endpoint.Name, err)
+			continue
+		}
+
+		log.Infof("http endpoint loaded. name: %s, base url: %s", endpoint.Name, endpoint.Spec.BaseURL)
+		a.httpEndpoints = append(a.httpEndpoints, endpoint)
+	}
+
+	return nil
+}
+
+func (a *DaprRuntime) isHTTPEndpointAuthorized(endpoint httpendpoint_v1alpha1.HTTPEndpoint) bool {
+	if a.namespace != "" && endpoint.ObjectMeta.Namespace != a.namespace {
+		return false
+	}
+	if len(endpoint.Scopes) == 0 {
+		return true
+	}
+
+	// scopes are defined, make sure this runtime ID is authorized
+	for _, s := range endpoint.Scopes {
+		if s == a.runtimeConfig.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// validateHTTPEndpointName returns an error if the name of the endpoint can't be told apart from this app,
+// from an app ID qualified with its namespace or from another endpoint, as all are invoked by name.
+// Other apps with the same ID in the namespace of this app are shadowed by the endpoint.
+func (a *DaprRuntime) validateHTTPEndpointName(name string, loaded map[string]struct{}) error {
+	if name == a.runtimeConfig.ID {
+		return errors.New("the name is the ID of this app")
+	}
+	if strings.Contains(name, ".") {
+		// Targets with a dot are app IDs qualified with their namespace.
+		return errors.New("the name can't contain '.', which separates app IDs from their namespace")
+	}
+	if _, ok := loaded[name]; ok {
+		return errors.New("an http endpoint with the same name is already loaded")
+	}
+	return nil
+}
+
+// processHTTPEndpointSecrets replaces the secret references of the headers and TLS settings of the endpoint with their values.
+func (a *DaprRuntime) processHTTPEndpointSecrets(endpoint httpendpoint_v1alpha1.HTTPEndpoint) (httpendpoint_v1alpha1.HTTPEndpoint, error) {
+	endpoint = *endpoint.DeepCopy()
+	cache := map[string]secretstores.GetSecretResponse{}
+
+	for i, h := range endpoint.Spec.Headers {
+		if h.SecretKeyRef.Name == "" {
+			continue
+		}
+		val, err := a.getHTTPEndpointSecret(endpoint, h.SecretKeyRef, cache)
+		if err != nil {
+			return endpoint, err
+		}
+		endpoint.Spec.Headers[i].Value = val
+	}
+
+	if tlsSpec := endpoint.Spec.ClientTLS; tlsSpec != nil {
+		for _, doc := range []*httpendpoint_v1alpha1.TLSDocument{tlsSpec.RootCA, tlsSpec.Certificate, tlsSpec.PrivateKey} {
+			if doc == nil || doc.SecretKeyRef.Name == "" {
+				continue
+			}
+			val, err := a.getHTTPEndpointSecret(endpoint, doc.SecretKeyRef, cache)
+			if err != nil {
+				return endpoint, err
+			}
+			doc.Value = val
+		}
+	}
+
+	return endpoint, nil
+}
+
+func (a *DaprRuntime) getHTTPEndpointSecret(endpoint httpendpoint_v1alpha1.HTTPEndpoint, ref httpendpoint_v1alpha1.SecretKeyRef, cache map[string]secretstores.GetSecretResponse) (string, error) {
+	secretStoreName := endpoint.Auth.SecretStore
+	if secretStoreName == "" && a.runtimeConfig.Mode == modes.KubernetesMode {
+		secretStoreName = kubernetesSecretStore
+	}
+	secretStore := a.getSecretStore(secretStoreName)
+	if secretStore == nil {
+		return "", errors.Errorf("secret store %s isn't loaded", secretStoreName)
+	}
+
+	resp, ok := cache[ref.Name]
+	if !ok {
+		r, err := secretStore.GetSecret(secretstores.GetSecretRequest{
+			Name: ref.Name,
+			Metadata: map[string]string{
+				"namespace": endpoint.ObjectMeta.Namespace,
+			},
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "error getting secret %s", ref.Name)
+		}
+		resp = r
+		cache[ref.Name] = resp
+	}
+
+	// Use the SecretKeyRef.Name key if SecretKeyRef.Key is not given
+	key := ref.Key
+	if key == "" {
+		key = ref.Name
+	}
+	val, ok := resp.Data[key]
+	if !ok {
+		return "", errors.Errorf("key %s not found in secret %s", key, ref.Name)
+	}
+	return val, nil
+}
+
+// getHTTPEndpointsAppChannels creates a channel for each of the loaded HTTP endpoints, by endpoint name.
+func (a *DaprRuntime) getHTTPEndpointsAppChannels() map[string]channel.AppChannel {
+	channels := make(map[string]channel.AppChannel, len(a.httpEndpoints))
+
+	for _, endpoint := range a.httpEndpoints {
+		headers := make(map[string]string, len(endpoint.Spec.Headers))
+		for _, h := range endpoint.Spec.Headers {
+			headers[h.Name] = h.Value
+		}
+
+		tlsConfig, err := getHTTPEndpointTLSConfig(endpoint.Spec.ClientTLS)
+		if err != nil {
+			log.Warnf("invalid TLS settings for http endpoint %s: %s", endpoint.Name, err)
+			continue
+		}
+
+		ch, err := http_channel.CreateHTTPEndpointChannel(endpoint.Spec.BaseURL, headers, tlsConfig, a.globalConfig.Spec.TracingSpec, a.runtimeConfig.MaxRequestBodySize, a.runtimeConfig.ReadBufferSize)
+		if err != nil {
+			log.Warnf("failed to create channel for http endpoint %s: %s", endpoint.Name, err)
+			continue
+		}
+		channels[endpoint.Name] = ch
+	}
+
+	return channels
+}
+
+// nolint:gosec
+func getHTTPEndpointTLSConfig(spec *httpendpoint_v1alpha1.TLS) (*tls.Config, error) {
+	if spec == nil {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: spec.InsecureSkipVerify,
+	}
+
+	if spec.RootCA != nil && spec.RootCA.Value != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(spec.RootCA.Value)) {
+			return nil, errors.New("failed to parse root CA")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	hasCert := spec.Certificate != nil && spec.Certificate.Value != ""
+	hasKey := spec.PrivateKey != nil && spec.PrivateKey.Value != ""
+	if hasCert != hasKey {
+		return nil, errors.New("both the client certificate and private key must be set")
+	}
+	if hasCert {
+		cert, err := tls.X509KeyPair([]byte(spec.Certificate.Value), []byte(spec.PrivateKey.Value))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
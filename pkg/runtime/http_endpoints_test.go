@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	httpendpoint_v1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	"github.com/dapr/dapr/pkg/modes"
+)
+
+func TestLoadHTTPEndpoints(t *testing.T) {
+	dir := t.TempDir()
+	yaml := `
+apiVersion: dapr.io/v1alpha1
+kind: HTTPEndpoint
+metadata:
+  name: external
+spec:
+  baseUrl: http://api.example.com
+  headers:
+  - name: Accept
+    value: application/json
+  - name: Authorization
+    secretKeyRef:
+      name: token
+      key: key1
+auth:
+  secretStore: mockSecretStore
+---
+apiVersion: dapr.io/v1alpha1
+kind: HTTPEndpoint
+metadata:
+  name: notscoped
+spec:
+  baseUrl: http://other.example.com
+scopes:
+- otherapp
+---
+apiVersion: dapr.io/v1alpha1
+kind: HTTPEndpoint
+metadata:
+  name: external
+spec:
+  baseUrl: http://duplicate.example.com
+---
+apiVersion: dapr.io/v1alpha1
+kind: HTTPEndpoint
+metadata:
+  name: consumer0
+spec:
+  baseUrl: http://app.example.com
+---
+apiVersion: dapr.io/v1alpha1
+kind: HTTPEndpoint
+metadata:
+  name: app.namespace
+spec:
+  baseUrl: http://namespaced.example.com
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "endpoints.yaml"), []byte(yaml), 0o600))
+
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+	rt.runtimeConfig.Standalone.ComponentsPath = dir
+	rt.secretStores["mockSecretStore"] = &mockSecretStore{}
+
+	require.NoError(t, rt.loadHTTPEndpoints())
+	require.Len(t, rt.httpEndpoints, 1)
+	assert.Equal(t, "external", rt.httpEndpoints[0].Name)
+	assert.Equal(t, "application/json", rt.httpEndpoints[0].Spec.Headers[0].Value)
+	assert.Equal(t, "value1", rt.httpEndpoints[0].Spec.Headers[1].Value)
+
+	channels := rt.getHTTPEndpointsAppChannels()
+	require.Len(t, channels, 1)
+	assert.Equal(t, "http://api.example.com", channels["external"].GetBaseAddress())
+}
+
+func TestProcessHTTPEndpointSecrets(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	endpoint := httpendpoint_v1alpha1.HTTPEndpoint{
+		Spec: httpendpoint_v1alpha1.HTTPEndpointSpec{
+			BaseURL: "https://api.example.com",
+			Headers: []httpendpoint_v1alpha1.Header{
+				{Name: "Authorization", SecretKeyRef: httpendpoint_v1alpha1.SecretKeyRef{Name: "token", Key: "key1"}},
+			},
+		},
+		Auth: httpendpoint_v1alpha1.Auth{SecretStore: "mockSecretStore"},
+	}
+
+	t.Run("secret store not loaded", func(t *testing.T) {
+		_, err := rt.processHTTPEndpointSecrets(endpoint)
+		assert.Error(t, err)
+	})
+
+	t.Run("secret is resolved", func(t *testing.T) {
+		rt.secretStores["mockSecretStore"] = &mockSecretStore{}
+
+		processed, err := rt.processHTTPEndpointSecrets(endpoint)
+		assert.NoError(t, err)
+		assert.Equal(t, "value1", processed.Spec.Headers[0].Value)
+		// The original endpoint is not modified.
+		assert.Equal(t, "", endpoint.Spec.Headers[0].Value)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		endpoint.Spec.Headers[0].SecretKeyRef.Key = "notfound"
+		_, err := rt.processHTTPEndpointSecrets(endpoint)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetHTTPEndpointTLSConfig(t *testing.T) {
+	t.Run("no TLS settings", func(t *testing.T) {
+		tlsConfig, err := getHTTPEndpointTLSConfig(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, tlsConfig)
+	})
+
+	t.Run("insecure skip verify", func(t *testing.T) {
+		tlsConfig, err := getHTTPEndpointTLSConfig(&httpendpoint_v1alpha1.TLS{InsecureSkipVerify: true})
+		assert.NoError(t, err)
+		assert.True(t, tlsConfig.InsecureSkipVerify)
+	})
+
+	t.Run("invalid root CA", func(t *testing.T) {
+		_, err := getHTTPEndpointTLSConfig(&httpendpoint_v1alpha1.TLS{
+			RootCA: &httpendpoint_v1alpha1.TLSDocument{Value: "not a certificate"},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("certificate without private key", func(t *testing.T) {
+		_, err := getHTTPEndpointTLSConfig(&httpendpoint_v1alpha1.TLS{
+			Certificate: &httpendpoint_v1alpha1.TLSDocument{Value: "cert"},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateHTTPEndpointName(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	loaded := map[string]struct{}{"external": {}}
+	assert.NoError(t, rt.validateHTTPEndpointName("other", loaded))
+	assert.Error(t, rt.validateHTTPEndpointName("external", loaded))
+	assert.Error(t, rt.validateHTTPEndpointName(TestRuntimeConfigID, loaded))
+	assert.Error(t, rt.validateHTTPEndpointName("app.namespace", loaded))
+}
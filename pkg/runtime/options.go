@@ -4,6 +4,7 @@ import (
 	"github.com/dapr/dapr/pkg/components/bindings"
 	"github.com/dapr/dapr/pkg/components/configuration"
 	"github.com/dapr/dapr/pkg/components/lock"
+	"github.com/dapr/dapr/pkg/components/middleware/grpc"
 	"github.com/dapr/dapr/pkg/components/middleware/http"
 	"github.com/dapr/dapr/pkg/components/nameresolution"
 	"github.com/dapr/dapr/pkg/components/pubsub"
@@ -23,6 +24,7 @@ type (
 		inputBindings   []bindings.InputBinding
 		outputBindings  []bindings.OutputBinding
 		httpMiddleware  []http.Middleware
+		grpcMiddleware  []grpc.Middleware
 
 		componentsCallback ComponentsCallback
 	}
@@ -93,6 +95,13 @@ func WithHTTPMiddleware(httpMiddleware ...http.Middleware) Option {
 	}
 }
 
+// WithGRPCMiddleware adds gRPC middleware components to the runtime.
+func WithGRPCMiddleware(grpcMiddleware ...grpc.Middleware) Option {
+	return func(o *runtimeOpts) {
+		o.grpcMiddleware = append(o.grpcMiddleware, grpcMiddleware...)
+	}
+}
+
 // WithComponentsCallback sets the components callback for applications that embed Dapr.
 func WithComponentsCallback(componentsCallback ComponentsCallback) Option {
 	return func(o *runtimeOpts) {
@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+// BulkMessageEntry is a single message of a bulk publish request.
+type BulkMessageEntry struct {
+	// EntryID uniquely identifies the entry in the bulk request.
+	EntryID string `json:"entryId"`
+	// Event is the data to publish, already wrapped in a CloudEvent if required.
+	Event []byte `json:"event"`
+	// ContentType is the content type of the event.
+	ContentType string `json:"contentType,omitempty"`
+	// Metadata is the metadata of this entry.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// BulkPublishRequest is the request to publish multiple messages to a topic.
+type BulkPublishRequest struct {
+	PubsubName string             `json:"pubsubname"`
+	Topic      string             `json:"topic"`
+	Entries    []BulkMessageEntry `json:"entries"`
+	// Metadata applies to the whole request.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// BulkPublishResponse is the result of a bulk publish request.
+// An empty list of failed entries means that all the entries were published.
+type BulkPublishResponse struct {
+	FailedEntries []BulkPublishResponseFailedEntry `json:"failedEntries,omitempty"`
+}
+
+// BulkPublishResponseFailedEntry is an entry which could not be published.
+type BulkPublishResponseFailedEntry struct {
+	EntryID string `json:"entryId"`
+	Error   error  `json:"-"`
+}
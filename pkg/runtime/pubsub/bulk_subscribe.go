@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsub
+
+import (
+	"github.com/dapr/components-contrib/pubsub"
+)
+
+// BulkSubscribeEventType is the type of the envelope delivering a batch of messages to the app.
+const BulkSubscribeEventType = "com.dapr.event.sent.bulk"
+
+// BulkSubscribeEnvelope is the body of the request delivering a batch of messages to the app over HTTP.
+type BulkSubscribeEnvelope struct {
+	ID       string                     `json:"id"`
+	Entries  []BulkSubscribeMessageItem `json:"entries"`
+	Metadata map[string]string          `json:"metadata,omitempty"`
+	Topic    string                     `json:"topic"`
+	Pubsub   string                     `json:"pubsubname"`
+	Type     string                     `json:"type"`
+}
+
+// BulkSubscribeMessageItem is a single message of a bulk subscribe envelope.
+type BulkSubscribeMessageItem struct {
+	EntryID     string            `json:"entryId"`
+	Event       interface{}       `json:"event"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	ContentType string            `json:"contentType,omitempty"`
+}
+
+// BulkSubscribeResponse is the response of the app to a bulk subscribe envelope.
+type BulkSubscribeResponse struct {
+	Statuses []BulkSubscribeResponseEntry `json:"statuses"`
+}
+
+// BulkSubscribeResponseEntry is the status returned by the app for a single message.
+type BulkSubscribeResponseEntry struct {
+	EntryID string                   `json:"entryId"`
+	Status  pubsub.AppResponseStatus `json:"status"`
+}
@@ -1,5 +1,7 @@
 package pubsub
 
+import "fmt"
+
 type Subscription struct {
 	PubsubName      string            `json:"pubsubname"`
 	Topic           string            `json:"topic"`
@@ -7,6 +9,14 @@ type Subscription struct {
 	Metadata        map[string]string `json:"metadata"`
 	Rules           []*Rule           `json:"rules,omitempty"`
 	Scopes          []string          `json:"scopes"`
+	BulkSubscribe   BulkSubscribe     `json:"bulkSubscribe,omitempty"`
+}
+
+// BulkSubscribe configures the delivery of messages to the app in batches.
+type BulkSubscribe struct {
+	Enabled            bool  `json:"enabled"`
+	MaxMessagesCount   int32 `json:"maxMessagesCount,omitempty"`
+	MaxAwaitDurationMs int32 `json:"maxAwaitDurationMs,omitempty"`
 }
 
 type Rule struct {
@@ -14,6 +24,14 @@ type Rule struct {
 	Path  string `json:"path"`
 }
 
+// MatchExpression returns the expression of the rule, or an empty string for the default rule.
+func (r *Rule) MatchExpression() string {
+	if s, ok := r.Match.(fmt.Stringer); ok {
+		return s.String()
+	}
+	return ""
+}
+
 type Expr interface {
 	Eval(variables map[string]interface{}) (interface{}, error)
 }
@@ -47,6 +47,13 @@ type (
 		Metadata        map[string]string `json:"metadata,omitempty"`
 		Route           string            `json:"route"`  // Single route from v1alpha1
 		Routes          RoutesJSON        `json:"routes"` // Multiple routes from v2alpha1
+		BulkSubscribe   BulkSubscribeJSON `json:"bulkSubscribe,omitempty"`
+	}
+
+	BulkSubscribeJSON struct {
+		Enabled            bool  `json:"enabled"`
+		MaxMessagesCount   int32 `json:"maxMessagesCount,omitempty"`
+		MaxAwaitDurationMs int32 `json:"maxAwaitDurationMs,omitempty"`
 	}
 
 	RoutesJSON struct {
@@ -128,6 +135,11 @@ func GetSubscriptionsHTTP(channel channel.AppChannel, log logger.Logger) ([]Subs
 				Metadata:        si.Metadata,
 				DeadLetterTopic: si.DeadLetterTopic,
 				Rules:           rules,
+				BulkSubscribe: BulkSubscribe{
+					Enabled:            si.BulkSubscribe.Enabled,
+					MaxMessagesCount:   si.BulkSubscribe.MaxMessagesCount,
+					MaxAwaitDurationMs: si.BulkSubscribe.MaxAwaitDurationMs,
+				},
 			}
 		}
 
@@ -207,6 +219,11 @@ func GetSubscriptionsGRPC(channel runtimev1pb.AppCallbackClient, log logger.Logg
 				Metadata:        s.GetMetadata(),
 				DeadLetterTopic: s.DeadLetterTopic,
 				Rules:           rules,
+				BulkSubscribe: BulkSubscribe{
+					Enabled:            s.GetBulkSubscribe().GetEnabled(),
+					MaxMessagesCount:   s.GetBulkSubscribe().GetMaxMessagesCount(),
+					MaxAwaitDurationMs: s.GetBulkSubscribe().GetMaxAwaitDurationMs(),
+				},
 			})
 		}
 	}
@@ -284,6 +301,11 @@ func marshalSubscription(b []byte) (*Subscription, error) {
 			Metadata:        sub.Spec.Metadata,
 			Scopes:          sub.Scopes,
 			DeadLetterTopic: sub.Spec.DeadLetterTopic,
+			BulkSubscribe: BulkSubscribe{
+				Enabled:            sub.Spec.BulkSubscribe.Enabled,
+				MaxMessagesCount:   sub.Spec.BulkSubscribe.MaxMessagesCount,
+				MaxAwaitDurationMs: sub.Spec.BulkSubscribe.MaxAwaitDurationMs,
+			},
 		}, nil
 
 	default:
@@ -237,6 +237,26 @@ func TestDeclarativeSubscriptionsV2(t *testing.T) {
 		}
 	})
 
+	t.Run("load subscription with bulk subscribe", func(t *testing.T) {
+		os.RemoveAll(dir)
+		os.Mkdir(dir, 0o777)
+
+		s := testDeclarativeSubscriptionV2()
+		s.Spec.BulkSubscribe = subscriptionsapi_v2alpha1.BulkSubscribe{
+			Enabled:            true,
+			MaxMessagesCount:   10,
+			MaxAwaitDurationMs: 500,
+		}
+
+		filePath := filepath.Join(dir, "sub.yaml")
+		writeSubscriptionToDisk(s, filePath)
+
+		subs := DeclarativeSelfHosted(dir, log)
+		if assert.Len(t, subs, 1) {
+			assert.Equal(t, BulkSubscribe{Enabled: true, MaxMessagesCount: 10, MaxAwaitDurationMs: 500}, subs[0].BulkSubscribe)
+		}
+	})
+
 	t.Run("no subscriptions loaded", func(t *testing.T) {
 		os.RemoveAll(dir)
 
@@ -323,6 +343,11 @@ func (m *mockHTTPSubscriptions) InvokeMethod(ctx context.Context, req *invokev1.
 				},
 				Default: "myroute",
 			},
+			BulkSubscribe: BulkSubscribeJSON{
+				Enabled:            true,
+				MaxMessagesCount:   10,
+				MaxAwaitDurationMs: 500,
+			},
 		},
 	}
 
@@ -347,6 +372,7 @@ func TestHTTPSubscriptions(t *testing.T) {
 			}
 			assert.Equal(t, "pubsub", subs[0].PubsubName)
 			assert.Equal(t, "testValue", subs[0].Metadata["testName"])
+			assert.Equal(t, BulkSubscribe{Enabled: true, MaxMessagesCount: 10, MaxAwaitDurationMs: 500}, subs[0].BulkSubscribe)
 		}
 	})
 
@@ -450,6 +476,11 @@ func (m *mockGRPCSubscriptions) ListTopicSubscriptions(ctx context.Context, in *
 					},
 					Default: "myroute",
 				},
+				BulkSubscribe: &runtimev1pb.BulkSubscribeConfig{
+					Enabled:            true,
+					MaxMessagesCount:   10,
+					MaxAwaitDurationMs: 500,
+				},
 			},
 		},
 	}, nil
@@ -469,6 +500,7 @@ func TestGRPCSubscriptions(t *testing.T) {
 			}
 			assert.Equal(t, "pubsub", subs[0].PubsubName)
 			assert.Equal(t, "testValue", subs[0].Metadata["testName"])
+			assert.Equal(t, BulkSubscribe{Enabled: true, MaxMessagesCount: 10, MaxAwaitDurationMs: 500}, subs[0].BulkSubscribe)
 		}
 	})
 
@@ -23,6 +23,7 @@ import (
 	nethttp "net/http"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -59,28 +60,36 @@ import (
 	"github.com/dapr/dapr/pkg/resiliency"
 	"github.com/dapr/kit/logger"
 
+	"github.com/dapr/dapr/pkg/acl"
 	"github.com/dapr/dapr/pkg/actors"
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+	httpendpoint_v1alpha1 "github.com/dapr/dapr/pkg/apis/httpEndpoint/v1alpha1"
+	"github.com/dapr/dapr/pkg/apphealth"
 	"github.com/dapr/dapr/pkg/channel"
 	http_channel "github.com/dapr/dapr/pkg/channel/http"
 	"github.com/dapr/dapr/pkg/components"
 	bindings_loader "github.com/dapr/dapr/pkg/components/bindings"
+	grpc_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/grpc"
 	http_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/http"
 	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
 	pubsub_loader "github.com/dapr/dapr/pkg/components/pubsub"
 	secretstores_loader "github.com/dapr/dapr/pkg/components/secretstores"
 	state_loader "github.com/dapr/dapr/pkg/components/state"
+	"github.com/dapr/dapr/pkg/concurrency"
 	"github.com/dapr/dapr/pkg/config"
 	diag "github.com/dapr/dapr/pkg/diagnostics"
 	diag_utils "github.com/dapr/dapr/pkg/diagnostics/utils"
 	"github.com/dapr/dapr/pkg/encryption"
+	"github.com/dapr/dapr/pkg/fswatcher"
 	"github.com/dapr/dapr/pkg/grpc"
 	"github.com/dapr/dapr/pkg/http"
 	"github.com/dapr/dapr/pkg/messaging"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	grpc_middleware "github.com/dapr/dapr/pkg/middleware/grpc"
 	http_middleware "github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/modes"
 	"github.com/dapr/dapr/pkg/operator/client"
+	"github.com/dapr/dapr/pkg/outbox"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
 	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
@@ -100,8 +109,15 @@ const (
 
 	// hot reloading is currently unsupported, but
 	// setting this environment variable restores the
-	// partial hot reloading support for k8s.
+	// partial hot reloading support for k8s and
+	// the file-based hot reloading in self-hosted mode.
 	hotReloadingEnvVar = "DAPR_ENABLE_HOT_RELOADING"
+
+	// capabilities of the components reported by the metadata API, in addition to the features of the components.
+	componentCapabilityQueryAPI      = "QUERY_API"
+	componentCapabilityActorState    = "ACTOR"
+	componentCapabilityInputBinding  = "INPUT_BINDING"
+	componentCapabilityOutputBinding = "OUTPUT_BINDING"
 )
 
 type ComponentCategory string
@@ -136,8 +152,9 @@ var log = logger.NewLogger("dapr.runtime")
 var ErrUnexpectedEnvelopeData = errors.New("unexpected data type encountered in envelope")
 
 type Route struct {
-	metadata map[string]string
-	rules    []*runtime_pubsub.Rule
+	metadata      map[string]string
+	rules         []*runtime_pubsub.Rule
+	bulkSubscribe runtime_pubsub.BulkSubscribe
 }
 
 type TopicRoute struct {
@@ -155,6 +172,7 @@ type DaprRuntime struct {
 	components             []components_v1alpha1.Component
 	grpc                   *grpc.Manager
 	appChannel             channel.AppChannel
+	appHTTPPipeline        http_middleware.Pipeline
 	appConfig              config.ApplicationConfig
 	directMessaging        messaging.DirectMessaging
 	stateStoreRegistry     state_loader.Registry
@@ -171,6 +189,7 @@ type DaprRuntime struct {
 	pubSubs                map[string]pubsub.PubSub
 	nameResolver           nr.Resolver
 	httpMiddlewareRegistry http_middleware_loader.Registry
+	grpcMiddlewareRegistry grpc_middleware_loader.Registry
 	hostAddress            string
 	actorStateStoreName    string
 	actorStateStoreLock    *sync.RWMutex
@@ -184,9 +203,15 @@ type DaprRuntime struct {
 	operatorClient         operatorv1pb.OperatorClient
 	topicRoutes            map[string]TopicRoute
 	deadLetterTopics       map[string]string
+	topicRoutesLock        sync.RWMutex
+	subscribeLock          sync.Mutex
+	subscribeCancel        context.CancelFunc
 	inputBindingRoutes     map[string]string
 	shutdownC              chan error
 	apiClosers             []io.Closer
+	traceExporterClosers   []io.Closer
+	metricsExporter        io.Closer
+	reEncryptionJobs       *encryption.ReEncryptionJobs
 
 	secretsConfiguration map[string]config.SecretsScope
 
@@ -201,6 +226,12 @@ type DaprRuntime struct {
 
 	proxy messaging.Proxy
 
+	httpEndpoints []httpendpoint_v1alpha1.HTTPEndpoint
+
+	appHealth     *apphealth.AppHealth
+	appHealthyCh  chan struct{}
+	appHealthLock sync.RWMutex
+
 	resiliency resiliency.Provider
 
 	// TODO: Remove feature flag once feature is ratified
@@ -230,6 +261,10 @@ type pubsubSubscribedMessage struct {
 	metadata   map[string]string
 	path       string
 	pubsub     string
+	// rawPayload is true if the subscription reads raw payloads, wrapped in the CloudEvent.
+	rawPayload bool
+	// message is the message received from the component.
+	message *pubsub.NewMessage
 }
 
 // NewDaprRuntime returns a new runtime with the given runtime config and global config.
@@ -249,6 +284,7 @@ func NewDaprRuntime(runtimeConfig *Config, globalConfig *config.Configuration, a
 		outputBindings:         map[string]bindings.OutputBinding{},
 		secretStores:           map[string]secretstores.SecretStore{},
 		stateStores:            map[string]state.Store{},
+		reEncryptionJobs:       encryption.NewReEncryptionJobs(ctx),
 		pubSubs:                map[string]pubsub.PubSub{},
 		stateStoreRegistry:     state_loader.NewRegistry(),
 		bindingsRegistry:       bindings_loader.NewRegistry(),
@@ -256,6 +292,7 @@ func NewDaprRuntime(runtimeConfig *Config, globalConfig *config.Configuration, a
 		secretStoresRegistry:   secretstores_loader.NewRegistry(),
 		nameResolutionRegistry: nr_loader.NewRegistry(),
 		httpMiddlewareRegistry: http_middleware_loader.NewRegistry(),
+		grpcMiddlewareRegistry: grpc_middleware_loader.NewRegistry(),
 
 		scopedSubscriptions: map[string][]string{},
 		scopedPublishings:   map[string][]string{},
@@ -341,6 +378,22 @@ func (a *DaprRuntime) setupTracing(hostAddress string, exporters traceExporterSt
 		exporter := zipkin.NewExporter(reporter, localEndpoint)
 		exporters.RegisterExporter(exporter)
 	}
+
+	// Register OTLP trace exporter if OtelSpec is specified
+	if otelSpec := a.globalConfig.Spec.TracingSpec.Otel; otelSpec.EndpointAddress != "" {
+		exporter, err := diag_utils.NewOTLPExporter(diag_utils.OTLPExporterOptions{
+			ServiceName: a.runtimeConfig.ID,
+			Endpoint:    otelSpec.EndpointAddress,
+			Protocol:    otelSpec.Protocol,
+			Insecure:    otelSpec.Insecure,
+			Headers:     diag_utils.ParseOTLPHeaders(otelSpec.Headers),
+		})
+		if err != nil {
+			return err
+		}
+		exporters.RegisterExporter(exporter)
+		a.traceExporterClosers = append(a.traceExporterClosers, exporter)
+	}
 	return nil
 }
 
@@ -349,7 +402,7 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 
 	// Initialize metrics only if MetricSpec is enabled.
 	if a.globalConfig.Spec.MetricSpec.Enabled {
-		if err := diag.InitMetrics(a.runtimeConfig.ID, a.namespace); err != nil {
+		if err := diag.InitMetrics(a.runtimeConfig.ID, a.namespace, a.globalConfig.Spec.MetricSpec); err != nil {
 			log.Errorf("failed to initialize metrics: %v", err)
 		}
 	}
@@ -384,6 +437,7 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 	a.bindingsRegistry.RegisterInputBindings(opts.inputBindings...)
 	a.bindingsRegistry.RegisterOutputBindings(opts.outputBindings...)
 	a.httpMiddlewareRegistry.Register(opts.httpMiddleware...)
+	a.grpcMiddlewareRegistry.Register(opts.grpcMiddleware...)
 	a.lockStoreRegistry.Register(opts.locks...)
 
 	go a.processComponents()
@@ -405,11 +459,31 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 
 	a.flushOutstandingComponents()
 
+	// The outbox relay starts once the components are loaded, so that the pubsubs of the outboxes are available.
+	go outbox.NewRelay(a.runtimeConfig.ID, a).Run(a.ctx)
+
+	err = a.loadHTTPEndpoints()
+	if err != nil {
+		log.Warnf("failed to load http endpoints: %s", err)
+	}
+
 	pipeline, err := a.buildHTTPPipeline()
 	if err != nil {
 		log.Warnf("failed to build HTTP pipeline: %s", err)
 	}
 
+	// The gRPC API is not started without its middleware, which may enforce its authentication.
+	grpcPipeline, err := a.buildGRPCPipeline()
+	if err != nil {
+		return errors.Wrap(err, "failed to build gRPC pipeline")
+	}
+
+	// Requests are not sent to the app without its middleware, which may enforce its authentication.
+	a.appHTTPPipeline, err = a.buildAppHTTPPipeline()
+	if err != nil {
+		return errors.Wrap(err, "failed to build app HTTP pipeline")
+	}
+
 	// Setup allow/deny list for secrets
 	a.populateSecretsConfiguration()
 
@@ -419,7 +493,7 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 	// Create and start internal and external gRPC servers
 	grpcAPI := a.getGRPCAPI()
 
-	err = a.startGRPCAPIServer(grpcAPI, a.runtimeConfig.APIGRPCPort)
+	err = a.startGRPCAPIServer(grpcAPI, a.runtimeConfig.APIGRPCPort, grpcPipeline)
 	if err != nil {
 		log.Fatalf("failed to start API gRPC server: %s", err)
 	}
@@ -459,6 +533,29 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 	}
 	a.daprHTTPAPI.SetAppChannel(a.appChannel)
 	grpcAPI.SetAppChannel(a.appChannel)
+	if a.appChannel != nil {
+		appConnectionConfig := channel.AppConnectionConfig{
+			Protocol:       string(a.runtimeConfig.ApplicationProtocol),
+			Port:           a.runtimeConfig.ApplicationPort,
+			MaxConcurrency: a.runtimeConfig.MaxConcurrency,
+		}
+		a.daprHTTPAPI.SetAppConnectionConfig(appConnectionConfig)
+		grpcAPI.SetAppConnectionConfig(appConnectionConfig)
+	}
+
+	enabledFeatures := config.EnabledFeatures(a.globalConfig.Spec.Features)
+	a.daprHTTPAPI.SetEnabledFeatures(enabledFeatures)
+	grpcAPI.SetEnabledFeatures(enabledFeatures)
+	a.daprHTTPAPI.SetSubscriptionsFn(a.getSubscriptions)
+	grpcAPI.SetSubscriptionsFn(a.getSubscriptions)
+	a.daprHTTPAPI.SetComponentsCapabilitiesFn(a.getComponentsCapabilities)
+	grpcAPI.SetComponentsCapabilitiesFn(a.getComponentsCapabilities)
+	a.daprHTTPAPI.SetReEncryptionJobs(a.reEncryptionJobs)
+	grpcAPI.SetReEncryptionJobs(a.reEncryptionJobs)
+
+	a.initAppHealthCheck()
+	a.daprHTTPAPI.SetAppHealth(a.appHealth)
+	grpcAPI.SetAppHealth(a.appHealth)
 
 	a.loadAppConfiguration()
 
@@ -492,11 +589,18 @@ func (a *DaprRuntime) initRuntime(opts *runtimeOpts) error {
 		}
 	}
 
-	a.startSubscribing()
+	// When the app health checks are enabled, subscriptions start once the app is healthy.
+	if a.appHealth == nil {
+		a.startSubscribing()
+	}
 	err = a.startReadingFromBindings()
 	if err != nil {
 		log.Warnf("failed to read from bindings: %s ", err)
 	}
+	if a.appHealth != nil {
+		a.appHealth.OnHealthChange(a.appHealthChanged)
+		a.appHealth.StartProbes(a.ctx)
+	}
 	return nil
 }
 
@@ -508,28 +612,66 @@ func (a *DaprRuntime) populateSecretsConfiguration() {
 }
 
 func (a *DaprRuntime) buildHTTPPipeline() (http_middleware.Pipeline, error) {
+	if a.globalConfig == nil {
+		return http_middleware.Pipeline{}, nil
+	}
+	return a.buildHTTPPipelineForSpec(a.globalConfig.Spec.HTTPPipelineSpec, "http")
+}
+
+// buildAppHTTPPipeline builds the pipeline of the requests sent by Dapr to the app.
+func (a *DaprRuntime) buildAppHTTPPipeline() (http_middleware.Pipeline, error) {
+	if a.globalConfig == nil {
+		return http_middleware.Pipeline{}, nil
+	}
+	return a.buildHTTPPipelineForSpec(a.globalConfig.Spec.AppHTTPPipelineSpec, "app http")
+}
+
+func (a *DaprRuntime) buildHTTPPipelineForSpec(spec config.PipelineSpec, targetPipeline string) (http_middleware.Pipeline, error) {
 	var handlers []http_middleware.Middleware
 
+	for i := 0; i < len(spec.Handlers); i++ {
+		middlewareSpec := spec.Handlers[i]
+		component, exists := a.getComponent(middlewareSpec.Type, middlewareSpec.Name)
+		if !exists {
+			return http_middleware.Pipeline{}, errors.Errorf("couldn't find middleware component with name %s and type %s/%s",
+				middlewareSpec.Name,
+				middlewareSpec.Type,
+				middlewareSpec.Version)
+		}
+		handler, err := a.httpMiddlewareRegistry.Create(middlewareSpec.Type, middlewareSpec.Version,
+			middleware.Metadata{Properties: a.convertMetadataItemsToProperties(component.Spec.Metadata)})
+		if err != nil {
+			return http_middleware.Pipeline{}, err
+		}
+		log.Infof("enabled %s/%s %s middleware", middlewareSpec.Type, middlewareSpec.Version, targetPipeline)
+		handlers = append(handlers, handler)
+	}
+	return http_middleware.Pipeline{Handlers: handlers}, nil
+}
+
+func (a *DaprRuntime) buildGRPCPipeline() (grpc_middleware.Pipeline, error) {
+	var handlers []grpc_middleware.Middleware
+
 	if a.globalConfig != nil {
-		for i := 0; i < len(a.globalConfig.Spec.HTTPPipelineSpec.Handlers); i++ {
-			middlewareSpec := a.globalConfig.Spec.HTTPPipelineSpec.Handlers[i]
+		for i := 0; i < len(a.globalConfig.Spec.GRPCPipelineSpec.Handlers); i++ {
+			middlewareSpec := a.globalConfig.Spec.GRPCPipelineSpec.Handlers[i]
 			component, exists := a.getComponent(middlewareSpec.Type, middlewareSpec.Name)
 			if !exists {
-				return http_middleware.Pipeline{}, errors.Errorf("couldn't find middleware component with name %s and type %s/%s",
+				return grpc_middleware.Pipeline{}, errors.Errorf("couldn't find middleware component with name %s and type %s/%s",
 					middlewareSpec.Name,
 					middlewareSpec.Type,
 					middlewareSpec.Version)
 			}
-			handler, err := a.httpMiddlewareRegistry.Create(middlewareSpec.Type, middlewareSpec.Version,
+			handler, err := a.grpcMiddlewareRegistry.Create(middlewareSpec.Type, middlewareSpec.Version,
 				middleware.Metadata{Properties: a.convertMetadataItemsToProperties(component.Spec.Metadata)})
 			if err != nil {
-				return http_middleware.Pipeline{}, err
+				return grpc_middleware.Pipeline{}, err
 			}
-			log.Infof("enabled %s/%s http middleware", middlewareSpec.Type, middlewareSpec.Version)
+			log.Infof("enabled %s/%s grpc middleware", middlewareSpec.Type, middlewareSpec.Version)
 			handlers = append(handlers, handler)
 		}
 	}
-	return http_middleware.Pipeline{Handlers: handlers}, nil
+	return grpc_middleware.Pipeline{Handlers: handlers}, nil
 }
 
 func (a *DaprRuntime) initBinding(c components_v1alpha1.Component) error {
@@ -550,7 +692,9 @@ func (a *DaprRuntime) initBinding(c components_v1alpha1.Component) error {
 }
 
 func (a *DaprRuntime) sendToDeadLetterIfConfigured(name string, msg *pubsub.NewMessage) (isDeadLetterConfigured bool, err error) {
+	a.topicRoutesLock.RLock()
 	deadLetterTopic, ok := a.deadLetterTopics[fmt.Sprintf(deadLetterKeyFormat, name, msg.Topic)]
+	a.topicRoutesLock.RUnlock()
 	if !ok {
 		return false, nil
 	}
@@ -571,11 +715,14 @@ func (a *DaprRuntime) sendToDeadLetterIfConfigured(name string, msg *pubsub.NewM
 
 func (a *DaprRuntime) beginPubSub(subscribeCtx context.Context, name string, ps pubsub.PubSub) error {
 	var publishFunc func(ctx context.Context, msg *pubsubSubscribedMessage) error
+	var publishBulkFunc bulkDeliverFunc
 	switch a.runtimeConfig.ApplicationProtocol {
 	case HTTPProtocol:
 		publishFunc = a.publishMessageHTTP
+		publishBulkFunc = a.publishBulkMessageHTTP
 	case GRPCProtocol:
 		publishFunc = a.publishMessageGRPC
+		publishBulkFunc = a.publishBulkMessageGRPC
 	}
 	topicRoutes, err := a.getTopicRoutes()
 	if err != nil {
@@ -585,8 +732,12 @@ func (a *DaprRuntime) beginPubSub(subscribeCtx context.Context, name string, ps
 	if !ok {
 		return nil
 	}
+	a.componentsLock.RLock()
+	scopedSubscriptions := a.scopedSubscriptions[name]
+	a.componentsLock.RUnlock()
 	for topic, route := range v.routes {
-		allowed := a.isPubSubOperationAllowed(name, topic, a.scopedSubscriptions[name])
+		allowed := a.isPubSubOperationAllowed(name, topic, scopedSubscriptions) &&
+			a.isPubSubOperationAllowedByAccessControlPolicy(name, topic, config.PubSubSubscribeOperation)
 		if !allowed {
 			log.Warnf("subscription to topic %s on pubsub %s is not allowed", topic, name)
 			continue
@@ -596,6 +747,23 @@ func (a *DaprRuntime) beginPubSub(subscribeCtx context.Context, name string, ps
 
 		routeMetadata := route.metadata
 		routeRules := route.rules
+		deliverFunc := publishFunc
+		if route.bulkSubscribe.Enabled {
+			log.Debugf("bulk subscribe is enabled for topic=%s on pubsub=%s", topic, name)
+			deliverFunc = newBulkSubscriber(subscribeCtx, name, topic, route.bulkSubscribe,
+				func(ctx context.Context, entries []*bulkSubscribedMessage) (statuses map[string]pubsub.AppResponseStatus, err error) {
+					policy := a.resiliency.ComponentInboundPolicy(ctx, name)
+					err = policy(func(ctx context.Context) (rErr error) {
+						statuses, rErr = publishBulkFunc(ctx, entries)
+						return rErr
+					})
+					return statuses, err
+				},
+				func(msg *pubsubSubscribedMessage) bool {
+					configured, err := a.sendToDeadLetterIfConfigured(name, msg.message)
+					return configured && err == nil
+				}).enqueue
+		}
 		if err := ps.Subscribe(subscribeCtx, pubsub.SubscribeRequest{
 			Topic:    topic,
 			Metadata: route.metadata,
@@ -676,13 +844,15 @@ func (a *DaprRuntime) beginPubSub(subscribeCtx context.Context, name string, ps
 
 			policy := a.resiliency.ComponentInboundPolicy(ctx, name)
 			err = policy(func(ctx context.Context) error {
-				return publishFunc(ctx, &pubsubSubscribedMessage{
+				return deliverFunc(ctx, &pubsubSubscribedMessage{
 					cloudEvent: cloudEvent,
 					data:       data,
 					topic:      msg.Topic,
 					metadata:   msg.Metadata,
 					path:       routePath,
 					pubsub:     name,
+					rawPayload: rawPayload,
+					message:    msg,
 				})
 			})
 			if err != nil && err != context.Canceled {
@@ -762,6 +932,7 @@ func (a *DaprRuntime) initDirectMessaging(resolver nr.Resolver) {
 		a.runtimeConfig.StreamRequestBody,
 		a.resiliency,
 		config.IsFeatureEnabled(a.globalConfig.Spec.Features, config.Resiliency),
+		a.getHTTPEndpointsAppChannels(),
 	)
 }
 
@@ -772,12 +943,145 @@ func (a *DaprRuntime) initProxy() {
 	log.Info("gRPC proxy enabled")
 }
 
-// begin components updates for kubernetes mode.
+// begin components updates for kubernetes and self-hosted mode.
 func (a *DaprRuntime) beginComponentsUpdates() error {
-	if a.runtimeConfig.Mode != modes.KubernetesMode {
+	switch a.runtimeConfig.Mode {
+	case modes.KubernetesMode:
+		return a.beginKubernetesComponentsUpdates()
+	case modes.StandaloneMode:
+		return a.beginStandaloneComponentsUpdates()
+	}
+	return nil
+}
+
+// beginStandaloneComponentsUpdates watches the components directory and
+// reloads components, declarative subscriptions and resiliency on every change.
+func (a *DaprRuntime) beginStandaloneComponentsUpdates() error {
+	componentsPath := a.runtimeConfig.Standalone.ComponentsPath
+	if componentsPath == "" {
 		return nil
 	}
 
+	eventCh := make(chan struct{})
+	go func() {
+		if err := fswatcher.Watch(a.ctx, componentsPath, eventCh); err != nil {
+			log.Errorf("error watching components directory %s: %s", componentsPath, err)
+		}
+	}()
+
+	go func() {
+		for {
+			select {
+			case <-eventCh:
+				log.Infof("changes detected in %s, reloading resources", componentsPath)
+				a.reloadStandaloneResources()
+			case <-a.ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (a *DaprRuntime) reloadStandaloneResources() {
+	comps, err := components.NewStandaloneComponents(a.runtimeConfig.Standalone).LoadComponents()
+	if err != nil {
+		log.Warnf("failed to reload components: %s", err)
+		return
+	}
+	authorizedComps := a.getAuthorizedComponents(comps)
+
+	loaded := make(map[string]struct{}, len(authorizedComps))
+	for _, comp := range authorizedComps {
+		loaded[comp.Spec.Type+"/"+comp.Name] = struct{}{}
+		if a.onComponentUpdated(comp) {
+			log.Infof("component updated. name: %s, type: %s/%s", comp.ObjectMeta.Name, comp.Spec.Type, comp.Spec.Version)
+		}
+	}
+	for _, comp := range a.getComponents() {
+		if _, ok := loaded[comp.Spec.Type+"/"+comp.Name]; ok {
+			continue
+		}
+		log.Infof("component removed. name: %s, type: %s/%s", comp.ObjectMeta.Name, comp.Spec.Type, comp.Spec.Version)
+		a.onComponentDeleted(comp)
+	}
+	a.flushOutstandingComponents()
+
+	if r, ok := a.resiliency.(*resiliency.Resiliency); ok {
+		r.Reload(resiliency.LoadStandaloneResiliency(log, a.runtimeConfig.ID, a.runtimeConfig.Standalone.ComponentsPath)...)
+	}
+
+	// Declarative subscriptions may have changed as well, so resubscribe to all topics.
+	a.restartSubscribing()
+}
+
+// onComponentDeleted removes the component from the runtime and closes its instance.
+func (a *DaprRuntime) onComponentDeleted(component components_v1alpha1.Component) {
+	a.componentsLock.Lock()
+	for i, c := range a.components {
+		if c.Spec.Type == component.Spec.Type && c.ObjectMeta.Name == component.Name {
+			a.components = append(a.components[:i], a.components[i+1:]...)
+			break
+		}
+	}
+
+	var closers []interface{}
+	name := component.ObjectMeta.Name
+	switch a.extractComponentCategory(component) {
+	case bindingsComponent:
+		if binding, ok := a.inputBindings[name]; ok {
+			closers = append(closers, binding)
+			delete(a.inputBindings, name)
+			delete(a.inputBindingRoutes, name)
+		}
+		if binding, ok := a.outputBindings[name]; ok {
+			closers = append(closers, binding)
+			delete(a.outputBindings, name)
+		}
+	case pubsubComponent:
+		if ps, ok := a.pubSubs[name]; ok {
+			closers = append(closers, ps)
+			delete(a.pubSubs, name)
+			delete(a.scopedSubscriptions, name)
+			delete(a.scopedPublishings, name)
+			delete(a.allowedTopics, name)
+		}
+	case secretStoreComponent:
+		if store, ok := a.secretStores[name]; ok {
+			closers = append(closers, store)
+			delete(a.secretStores, name)
+		}
+	case stateComponent:
+		if store, ok := a.stateStores[name]; ok {
+			closers = append(closers, store)
+			delete(a.stateStores, name)
+			encryption.RemoveEncryptedStateStore(name)
+			outbox.RemoveOutboxStateStore(name)
+		}
+	case configurationComponent:
+		if store, ok := a.configurationStores[name]; ok {
+			closers = append(closers, store)
+			delete(a.configurationStores, name)
+		}
+	case lockComponent:
+		if store, ok := a.lockStores[name]; ok {
+			closers = append(closers, store)
+			delete(a.lockStores, name)
+		}
+	}
+	a.componentsLock.Unlock()
+
+	for _, c := range closers {
+		if closer, ok := c.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				log.Warnf("error closing component %s: %s", name, err)
+			}
+		}
+	}
+}
+
+// begin components updates for kubernetes mode.
+func (a *DaprRuntime) beginKubernetesComponentsUpdates() error {
 	go func() {
 		parseAndUpdate := func(compRaw []byte) {
 			var component components_v1alpha1.Component
@@ -863,6 +1167,10 @@ func (a *DaprRuntime) onComponentUpdated(component components_v1alpha1.Component
 		return false
 	}
 
+	if exists {
+		// The running instance is closed before it is replaced, so that its connections aren't leaked.
+		a.onComponentDeleted(oldComp)
+	}
 	a.pendingComponents <- component
 	return true
 }
@@ -899,7 +1207,10 @@ func (a *DaprRuntime) sendToOutputBinding(name string, req *bindings.InvokeReque
 		return nil, errors.New("operation field is missing from request")
 	}
 
-	if binding, ok := a.outputBindings[name]; ok {
+	a.componentsLock.RLock()
+	binding, ok := a.outputBindings[name]
+	a.componentsLock.RUnlock()
+	if ok {
 		ops := binding.Operations()
 		for _, o := range ops {
 			if o == req.Operation {
@@ -924,10 +1235,13 @@ func (a *DaprRuntime) sendToOutputBinding(name string, req *bindings.InvokeReque
 func (a *DaprRuntime) onAppResponse(response *bindings.AppResponse) error {
 	if len(response.State) > 0 {
 		go func(reqs []state.SetRequest) {
-			if a.stateStores != nil {
+			a.componentsLock.RLock()
+			store, ok := a.stateStores[response.StoreName]
+			a.componentsLock.RUnlock()
+			if ok {
 				policy := a.resiliency.ComponentOutboundPolicy(a.ctx, response.StoreName)
 				err := policy(func(ctx context.Context) (err error) {
-					return a.stateStores[response.StoreName].BulkSet(reqs)
+					return store.BulkSet(reqs)
 				})
 				if err != nil {
 					log.Errorf("error saving state from app response: %s", err)
@@ -958,7 +1272,9 @@ func (a *DaprRuntime) sendBindingEventToApp(bindingName string, data []byte, met
 	ctx, span := diag.StartInternalCallbackSpan(a.ctx, spanName, trace.SpanContext{}, a.globalConfig.Spec.TracingSpec)
 
 	var appResponseBody []byte
+	a.componentsLock.RLock()
 	path := a.inputBindingRoutes[bindingName]
+	a.componentsLock.RUnlock()
 	if path == "" {
 		path = bindingName
 	}
@@ -1083,6 +1399,11 @@ func (a *DaprRuntime) sendBindingEventToApp(bindingName string, data []byte, met
 func (a *DaprRuntime) readFromBinding(name string, binding bindings.InputBinding) error {
 	err := binding.Read(func(ctx context.Context, resp *bindings.ReadResponse) ([]byte, error) {
 		if resp != nil {
+			// Events are not delivered while the app is unhealthy.
+			if err := a.waitUntilAppIsHealthy(ctx); err != nil {
+				return nil, err
+			}
+
 			start := time.Now()
 			b, err := a.sendBindingEventToApp(name, resp.Data, resp.Metadata)
 			elapsed := diag.ElapsedSince(start)
@@ -1106,10 +1427,13 @@ func (a *DaprRuntime) startHTTPServer(port int, publicPort *int, profilePort int
 		a.appChannel,
 		a.directMessaging,
 		a.getComponents,
+		a.componentsLock,
 		a.resiliency,
 		a.stateStores,
 		a.secretStores,
 		a.secretsConfiguration,
+		a.configurationStores,
+		a.lockStores,
 		a.getPublishAdapter(),
 		a.actor,
 		a.sendToOutputBinding,
@@ -1154,9 +1478,9 @@ func (a *DaprRuntime) startGRPCInternalServer(api grpc.API, port int) error {
 	return nil
 }
 
-func (a *DaprRuntime) startGRPCAPIServer(api grpc.API, port int) error {
+func (a *DaprRuntime) startGRPCAPIServer(api grpc.API, port int, pipeline grpc_middleware.Pipeline) error {
 	serverConf := a.getNewServerConfig(a.runtimeConfig.APIListenAddresses, port)
-	server := grpc.NewAPIServer(api, serverConf, a.globalConfig.Spec.TracingSpec, a.globalConfig.Spec.MetricSpec, a.globalConfig.Spec.APISpec, a.proxy)
+	server := grpc.NewAPIServer(api, serverConf, a.globalConfig.Spec.TracingSpec, a.globalConfig.Spec.MetricSpec, a.globalConfig.Spec.APISpec, a.proxy, pipeline)
 	if err := server.StartNonBlocking(); err != nil {
 		return err
 	}
@@ -1178,7 +1502,7 @@ func (a *DaprRuntime) getNewServerConfig(apiListenAddresses []string, port int)
 func (a *DaprRuntime) getGRPCAPI() grpc.API {
 	return grpc.NewAPI(a.runtimeConfig.ID, a.appChannel, a.resiliency, a.stateStores, a.secretStores, a.secretsConfiguration, a.configurationStores,
 		a.lockStores, a.getPublishAdapter(), a.directMessaging, a.actor,
-		a.sendToOutputBinding, a.globalConfig.Spec.TracingSpec, a.accessControlList, string(a.runtimeConfig.ApplicationProtocol), a.getComponents, a.ShutdownWithWait)
+		a.sendToOutputBinding, a.globalConfig.Spec.TracingSpec, a.accessControlList, string(a.runtimeConfig.ApplicationProtocol), a.getComponents, a.componentsLock, a.ShutdownWithWait)
 }
 
 func (a *DaprRuntime) getPublishAdapter() runtime_pubsub.Adapter {
@@ -1249,6 +1573,7 @@ func (a *DaprRuntime) initInputBinding(c components_v1alpha1.Component) error {
 	}
 
 	log.Infof("successful init for input binding %s (%s/%s)", c.ObjectMeta.Name, c.Spec.Type, c.Spec.Version)
+	a.componentsLock.Lock()
 	a.inputBindingRoutes[c.Name] = c.Name
 	for _, item := range c.Spec.Metadata {
 		if item.Name == "route" {
@@ -1256,6 +1581,7 @@ func (a *DaprRuntime) initInputBinding(c components_v1alpha1.Component) error {
 		}
 	}
 	a.inputBindings[c.Name] = binding
+	a.componentsLock.Unlock()
 	diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
 	return nil
 }
@@ -1279,7 +1605,9 @@ func (a *DaprRuntime) initOutputBinding(c components_v1alpha1.Component) error {
 			return err
 		}
 		log.Infof("successful init for output binding %s (%s/%s)", c.ObjectMeta.Name, c.Spec.Type, c.Spec.Version)
+		a.componentsLock.Lock()
 		a.outputBindings[c.ObjectMeta.Name] = binding
+		a.componentsLock.Unlock()
 		diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
 	}
 	return nil
@@ -1303,7 +1631,9 @@ func (a *DaprRuntime) initConfiguration(s components_v1alpha1.Component) error {
 			return err
 		}
 
+		a.componentsLock.Lock()
 		a.configurationStores[s.ObjectMeta.Name] = store
+		a.componentsLock.Unlock()
 		diag.DefaultMonitoring.ComponentInitialized(s.Spec.Type)
 	}
 
@@ -1332,7 +1662,9 @@ func (a *DaprRuntime) initLock(s components_v1alpha1.Component) error {
 		return err
 	}
 	// save lock related configuration
+	a.componentsLock.Lock()
 	a.lockStores[s.ObjectMeta.Name] = store
+	a.componentsLock.Unlock()
 	err = lock_loader.SaveLockConfiguration(s.ObjectMeta.Name, props)
 	if err != nil {
 		diag.DefaultMonitoring.ComponentInitFailed(s.Spec.Type, "init")
@@ -1380,7 +1712,9 @@ func (a *DaprRuntime) initState(s components_v1alpha1.Component) error {
 			return err
 		}
 
+		a.componentsLock.Lock()
 		a.stateStores[s.ObjectMeta.Name] = store
+		a.componentsLock.Unlock()
 		err = state_loader.SaveStateConfiguration(s.ObjectMeta.Name, props)
 		if err != nil {
 			diag.DefaultMonitoring.ComponentInitFailed(s.Spec.Type, "init")
@@ -1388,6 +1722,16 @@ func (a *DaprRuntime) initState(s components_v1alpha1.Component) error {
 			return err
 		}
 
+		outboxEnabled, outboxErr := outbox.AddOutboxStateStore(s.ObjectMeta.Name, store, props)
+		if outboxErr != nil {
+			diag.DefaultMonitoring.ComponentInitFailed(s.Spec.Type, "init")
+			log.Warnf("error initializing state store outbox %s (%s/%s): %s", s.ObjectMeta.Name, s.Spec.Type, s.Spec.Version, outboxErr)
+			return outboxErr
+		}
+		if outboxEnabled {
+			log.Infof("outbox enabled for state store %s", s.ObjectMeta.Name)
+		}
+
 		// set specified actor store if "actorStateStore" is true in the spec.
 		actorStoreSpecified := props[actorStateStore]
 		if actorStoreSpecified == "true" {
@@ -1438,12 +1782,17 @@ func (a *DaprRuntime) getDeclarativeSubscriptions() []runtime_pubsub.Subscriptio
 	return subs
 }
 
+// getTopicRoutes returns the topic routes of the app, loading them if they were reset by restartSubscribing.
+// It must be called with subscribeLock held, so that the routes are loaded once.
 func (a *DaprRuntime) getTopicRoutes() (map[string]TopicRoute, error) {
-	if a.topicRoutes != nil {
-		return a.topicRoutes, nil
+	a.topicRoutesLock.RLock()
+	topicRoutes := a.topicRoutes
+	a.topicRoutesLock.RUnlock()
+	if topicRoutes != nil {
+		return topicRoutes, nil
 	}
 
-	topicRoutes := make(map[string]TopicRoute)
+	topicRoutes = make(map[string]TopicRoute)
 	deadLetterTopics := make(map[string]string)
 
 	if a.appChannel == nil {
@@ -1490,7 +1839,7 @@ func (a *DaprRuntime) getTopicRoutes() (map[string]TopicRoute, error) {
 			topicRoutes[s.PubsubName] = TopicRoute{routes: make(map[string]Route)}
 		}
 
-		topicRoutes[s.PubsubName].routes[s.Topic] = Route{metadata: s.Metadata, rules: s.Rules}
+		topicRoutes[s.PubsubName].routes[s.Topic] = Route{metadata: s.Metadata, rules: s.Rules, bulkSubscribe: s.BulkSubscribe}
 		if len(s.DeadLetterTopic) > 0 {
 			deadLetterTopics[fmt.Sprintf(deadLetterKeyFormat, s.PubsubName, s.Topic)] = s.DeadLetterTopic
 		}
@@ -1505,11 +1854,80 @@ func (a *DaprRuntime) getTopicRoutes() (map[string]TopicRoute, error) {
 			log.Infof("app is subscribed to the following topics: %v through pubsub=%s", topics, pubsubName)
 		}
 	}
+	a.topicRoutesLock.Lock()
 	a.topicRoutes = topicRoutes
 	a.deadLetterTopics = deadLetterTopics
+	a.topicRoutesLock.Unlock()
 	return topicRoutes, nil
 }
 
+// getSubscriptions returns the resolved topic subscriptions of the app, sorted by pubsub and topic.
+// It is empty until the subscriptions are loaded from the app and from the declarative subscriptions.
+func (a *DaprRuntime) getSubscriptions() []runtime_pubsub.Subscription {
+	a.topicRoutesLock.RLock()
+	defer a.topicRoutesLock.RUnlock()
+
+	// The subscriptions are copied, as the routes are reloaded when the app subscriptions change.
+	subscriptions := []runtime_pubsub.Subscription{}
+	for pubsubName, v := range a.topicRoutes {
+		for topic, route := range v.routes {
+			var metadata map[string]string
+			if route.metadata != nil {
+				metadata = make(map[string]string, len(route.metadata))
+				for k, v := range route.metadata {
+					metadata[k] = v
+				}
+			}
+			subscriptions = append(subscriptions, runtime_pubsub.Subscription{
+				PubsubName:      pubsubName,
+				Topic:           topic,
+				DeadLetterTopic: a.deadLetterTopics[fmt.Sprintf(deadLetterKeyFormat, pubsubName, topic)],
+				Metadata:        metadata,
+				Rules:           append([]*runtime_pubsub.Rule(nil), route.rules...),
+				BulkSubscribe:   route.bulkSubscribe,
+			})
+		}
+	}
+	sort.Slice(subscriptions, func(i, j int) bool {
+		if subscriptions[i].PubsubName != subscriptions[j].PubsubName {
+			return subscriptions[i].PubsubName < subscriptions[j].PubsubName
+		}
+		return subscriptions[i].Topic < subscriptions[j].Topic
+	})
+	return subscriptions
+}
+
+// getComponentsCapabilities returns the capabilities of the loaded components, by component name.
+func (a *DaprRuntime) getComponentsCapabilities() map[string][]string {
+	a.componentsLock.RLock()
+	defer a.componentsLock.RUnlock()
+
+	capabilities := make(map[string][]string)
+	for name, store := range a.stateStores {
+		for _, feature := range store.Features() {
+			capabilities[name] = append(capabilities[name], string(feature))
+		}
+		if _, ok := store.(state.Querier); ok {
+			capabilities[name] = append(capabilities[name], componentCapabilityQueryAPI)
+		}
+		if name == a.actorStateStoreName {
+			capabilities[name] = append(capabilities[name], componentCapabilityActorState)
+		}
+	}
+	for name, ps := range a.pubSubs {
+		for _, feature := range ps.Features() {
+			capabilities[name] = append(capabilities[name], string(feature))
+		}
+	}
+	for name := range a.inputBindings {
+		capabilities[name] = append(capabilities[name], componentCapabilityInputBinding)
+	}
+	for name := range a.outputBindings {
+		capabilities[name] = append(capabilities[name], componentCapabilityOutputBinding)
+	}
+	return capabilities
+}
+
 func (a *DaprRuntime) initPubSub(c components_v1alpha1.Component) error {
 	pubSub, err := a.pubSubRegistry.Create(c.Spec.Type, c.Spec.Version)
 	if err != nil {
@@ -1536,10 +1954,12 @@ func (a *DaprRuntime) initPubSub(c components_v1alpha1.Component) error {
 
 	pubsubName := c.ObjectMeta.Name
 
+	a.componentsLock.Lock()
 	a.scopedSubscriptions[pubsubName] = scopes.GetScopedTopics(scopes.SubscriptionScopes, a.runtimeConfig.ID, properties)
 	a.scopedPublishings[pubsubName] = scopes.GetScopedTopics(scopes.PublishingScopes, a.runtimeConfig.ID, properties)
 	a.allowedTopics[pubsubName] = scopes.GetAllowedTopics(properties)
 	a.pubSubs[pubsubName] = pubSub
+	a.componentsLock.Unlock()
 	diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
 
 	return nil
@@ -1554,27 +1974,96 @@ func (a *DaprRuntime) Publish(req *pubsub.PublishRequest) error {
 		return runtime_pubsub.NotFoundError{PubsubName: req.PubsubName}
 	}
 
-	if allowed := a.isPubSubOperationAllowed(req.PubsubName, req.Topic, a.scopedPublishings[req.PubsubName]); !allowed {
+	if allowed := a.isPubSubOperationAllowed(req.PubsubName, req.Topic, a.getScopedPublishings(req.PubsubName)) &&
+		a.isPubSubOperationAllowedByAccessControlPolicy(req.PubsubName, req.Topic, config.PubSubPublishOperation); !allowed {
 		return runtime_pubsub.NotAllowedError{Topic: req.Topic, ID: a.runtimeConfig.ID}
 	}
 
 	policy := a.resiliency.ComponentOutboundPolicy(a.ctx, req.PubsubName)
 	return policy(func(ctx context.Context) (err error) {
-		return a.pubSubs[req.PubsubName].Publish(req)
+		return thepubsub.Publish(req)
 	})
 }
 
+// BulkPublish is an adapter method for the runtime to pre-validate bulk publish requests
+// And then forward them to the Pub/Sub component.
+// Each entry is published individually, the entries which failed are returned in the response.
+// This method is used by the HTTP and gRPC APIs.
+func (a *DaprRuntime) BulkPublish(req *runtime_pubsub.BulkPublishRequest) (runtime_pubsub.BulkPublishResponse, error) {
+	thepubsub := a.GetPubSub(req.PubsubName)
+	if thepubsub == nil {
+		return runtime_pubsub.BulkPublishResponse{}, runtime_pubsub.NotFoundError{PubsubName: req.PubsubName}
+	}
+
+	if allowed := a.isPubSubOperationAllowed(req.PubsubName, req.Topic, a.getScopedPublishings(req.PubsubName)) &&
+		a.isPubSubOperationAllowedByAccessControlPolicy(req.PubsubName, req.Topic, config.PubSubPublishOperation); !allowed {
+		return runtime_pubsub.BulkPublishResponse{}, runtime_pubsub.NotAllowedError{Topic: req.Topic, ID: a.runtimeConfig.ID}
+	}
+
+	policy := a.resiliency.ComponentOutboundPolicy(a.ctx, req.PubsubName)
+	var lock sync.Mutex
+	res := runtime_pubsub.BulkPublishResponse{}
+	limiter := concurrency.NewLimiter(concurrency.DefaultLimit)
+	for i := range req.Entries {
+		fn := func(param interface{}) {
+			entry := param.(*runtime_pubsub.BulkMessageEntry)
+			metadata := make(map[string]string, len(req.Metadata)+len(entry.Metadata))
+			for k, v := range req.Metadata {
+				metadata[k] = v
+			}
+			for k, v := range entry.Metadata {
+				metadata[k] = v
+			}
+
+			err := policy(func(ctx context.Context) (err error) {
+				return thepubsub.Publish(&pubsub.PublishRequest{
+					PubsubName: req.PubsubName,
+					Topic:      req.Topic,
+					Data:       entry.Event,
+					Metadata:   metadata,
+				})
+			})
+			if err != nil {
+				lock.Lock()
+				res.FailedEntries = append(res.FailedEntries, runtime_pubsub.BulkPublishResponseFailedEntry{
+					EntryID: entry.EntryID,
+					Error:   err,
+				})
+				lock.Unlock()
+			}
+		}
+		limiter.Execute(fn, &req.Entries[i])
+	}
+	limiter.Wait()
+
+	return res, nil
+}
+
 // GetPubSub is an adapter method to find a pubsub by name.
 func (a *DaprRuntime) GetPubSub(pubsubName string) pubsub.PubSub {
+	a.componentsLock.RLock()
+	defer a.componentsLock.RUnlock()
+
 	return a.pubSubs[pubsubName]
 }
 
+func (a *DaprRuntime) getScopedPublishings(pubsubName string) []string {
+	a.componentsLock.RLock()
+	defer a.componentsLock.RUnlock()
+
+	return a.scopedPublishings[pubsubName]
+}
+
 func (a *DaprRuntime) isPubSubOperationAllowed(pubsubName string, topic string, scopedTopics []string) bool {
 	inAllowedTopics := false
 
+	a.componentsLock.RLock()
+	allowedTopics := a.allowedTopics[pubsubName]
+	a.componentsLock.RUnlock()
+
 	// first check if allowedTopics contain it
-	if len(a.allowedTopics[pubsubName]) > 0 {
-		for _, t := range a.allowedTopics[pubsubName] {
+	if len(allowedTopics) > 0 {
+		for _, t := range allowedTopics {
 			if t == topic {
 				inAllowedTopics = true
 				break
@@ -1599,6 +2088,25 @@ func (a *DaprRuntime) isPubSubOperationAllowed(pubsubName string, topic string,
 	return allowedScope
 }
 
+// isPubSubOperationAllowedByAccessControlPolicy applies the pub/sub policies of the access control spec
+// matching the identity of the app to the operation on the topic.
+func (a *DaprRuntime) isPubSubOperationAllowedByAccessControlPolicy(pubsubName string, topic string, operation string) bool {
+	if a.accessControlList == nil {
+		return true
+	}
+
+	namespace := a.namespace
+	if namespace == "" {
+		namespace = config.DefaultNamespace
+	}
+	spiffeID := &config.SpiffeID{
+		TrustDomain: a.accessControlList.TrustDomain,
+		Namespace:   namespace,
+		AppID:       a.runtimeConfig.ID,
+	}
+	return acl.IsPubSubOperationAllowedByAccessControlPolicy(spiffeID, pubsubName, topic, operation, a.accessControlList)
+}
+
 func (a *DaprRuntime) initNameResolution() error {
 	var resolver nr.Resolver
 	var err error
@@ -2185,6 +2693,16 @@ func (a *DaprRuntime) Shutdown(duration time.Duration) {
 	log.Infof("Waiting %s to finish outstanding operations", duration)
 	<-time.After(duration)
 	a.shutdownOutputComponents()
+	for _, closer := range a.traceExporterClosers {
+		if err := closer.Close(); err != nil {
+			log.Warnf("error closing trace exporter: %v", err)
+		}
+	}
+	if a.metricsExporter != nil {
+		if err := a.metricsExporter.Close(); err != nil {
+			log.Warnf("error closing metrics exporter: %v", err)
+		}
+	}
 	a.shutdownC <- nil
 }
 
@@ -2284,6 +2802,10 @@ func (a *DaprRuntime) getSecretStore(storeName string) secretstores.SecretStore
 	if storeName == "" {
 		return nil
 	}
+
+	a.componentsLock.RLock()
+	defer a.componentsLock.RUnlock()
+
 	return a.secretStores[storeName]
 }
 
@@ -2325,18 +2847,18 @@ func (a *DaprRuntime) loadAppConfiguration() {
 
 func (a *DaprRuntime) createAppChannel() error {
 	if a.runtimeConfig.ApplicationPort > 0 {
-		var channelCreatorFn func(port, maxConcurrency int, spec config.TracingSpec, sslEnabled bool, maxRequestBodySize int, readBufferSize int) (channel.AppChannel, error)
+		var ch channel.AppChannel
+		var err error
 
 		switch a.runtimeConfig.ApplicationProtocol {
 		case GRPCProtocol:
-			channelCreatorFn = a.grpc.CreateLocalChannel
+			ch, err = a.grpc.CreateLocalChannel(a.runtimeConfig.ApplicationPort, a.runtimeConfig.MaxConcurrency, a.globalConfig.Spec.TracingSpec, a.runtimeConfig.AppSSL, a.runtimeConfig.MaxRequestBodySize, a.runtimeConfig.ReadBufferSize)
 		case HTTPProtocol:
-			channelCreatorFn = http_channel.CreateLocalChannel
+			ch, err = http_channel.CreateLocalChannel(a.runtimeConfig.ApplicationPort, a.runtimeConfig.MaxConcurrency, a.globalConfig.Spec.TracingSpec, a.runtimeConfig.AppSSL, a.runtimeConfig.MaxRequestBodySize, a.runtimeConfig.ReadBufferSize, a.appHTTPPipeline)
 		default:
 			return errors.Errorf("cannot create app channel for protocol %s", string(a.runtimeConfig.ApplicationProtocol))
 		}
 
-		ch, err := channelCreatorFn(a.runtimeConfig.ApplicationPort, a.runtimeConfig.MaxConcurrency, a.globalConfig.Spec.TracingSpec, a.runtimeConfig.AppSSL, a.runtimeConfig.MaxRequestBodySize, a.runtimeConfig.ReadBufferSize)
 		if err != nil {
 			log.Infof("app max concurrency set to %v", a.runtimeConfig.MaxConcurrency)
 		}
@@ -2393,7 +2915,9 @@ func (a *DaprRuntime) initSecretStore(c components_v1alpha1.Component) error {
 		return err
 	}
 
+	a.componentsLock.Lock()
 	a.secretStores[c.ObjectMeta.Name] = secretStore
+	a.componentsLock.Unlock()
 	diag.DefaultMonitoring.ComponentInitialized(c.Spec.Type)
 	return nil
 }
@@ -2465,14 +2989,63 @@ func componentDependency(compCategory ComponentCategory, name string) string {
 }
 
 func (a *DaprRuntime) startSubscribing() {
+	a.subscribeLock.Lock()
+	defer a.subscribeLock.Unlock()
+
+	if a.subscribeCancel != nil {
+		// Already subscribed.
+		return
+	}
+	a.startSubscribingLocked()
+}
+
+// stopSubscribing stops all active subscriptions, until startSubscribing is called again.
+func (a *DaprRuntime) stopSubscribing() {
+	a.subscribeLock.Lock()
+	defer a.subscribeLock.Unlock()
+
+	if a.subscribeCancel == nil {
+		return
+	}
+	a.subscribeCancel()
+	a.subscribeCancel = nil
+}
+
+func (a *DaprRuntime) startSubscribingLocked() {
 	// PubSub subscribers are stopped via cancelation of the main runtime's context
-	for name, pubsub := range a.pubSubs {
-		if err := a.beginPubSub(a.ctx, name, pubsub); err != nil {
+	ctx, cancel := context.WithCancel(a.ctx)
+	a.subscribeCancel = cancel
+
+	a.componentsLock.RLock()
+	pubSubs := make(map[string]pubsub.PubSub, len(a.pubSubs))
+	for name, ps := range a.pubSubs {
+		pubSubs[name] = ps
+	}
+	a.componentsLock.RUnlock()
+
+	for name, pubsub := range pubSubs {
+		if err := a.beginPubSub(ctx, name, pubsub); err != nil {
 			log.Errorf("error occurred while beginning pubsub %s: %s", name, err)
 		}
 	}
 }
 
+// restartSubscribing stops all active subscriptions, reloads the topic routes
+// and subscribes again. It's a no-op for subscriptions that have not started yet.
+func (a *DaprRuntime) restartSubscribing() {
+	a.subscribeLock.Lock()
+	defer a.subscribeLock.Unlock()
+
+	a.topicRoutesLock.Lock()
+	a.topicRoutes = nil
+	a.topicRoutesLock.Unlock()
+	if a.subscribeCancel == nil {
+		return
+	}
+	a.subscribeCancel()
+	a.startSubscribingLocked()
+}
+
 func (a *DaprRuntime) startReadingFromBindings() error {
 	if a.appChannel == nil {
 		return errors.New("app channel not initialized")
@@ -48,6 +48,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	pb "github.com/trusch/grpc-proxy/testservice"
+	"github.com/valyala/fasthttp"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -59,17 +60,21 @@ import (
 
 	"github.com/dapr/components-contrib/bindings"
 	"github.com/dapr/components-contrib/contenttype"
+	"github.com/dapr/components-contrib/middleware"
 	"github.com/dapr/components-contrib/nameresolution"
 	"github.com/dapr/components-contrib/pubsub"
 	"github.com/dapr/components-contrib/secretstores"
 	"github.com/dapr/components-contrib/state"
 	"github.com/dapr/kit/logger"
 
+	"github.com/dapr/dapr/pkg/acl"
 	components_v1alpha1 "github.com/dapr/dapr/pkg/apis/components/v1alpha1"
 	"github.com/dapr/dapr/pkg/apis/resiliency/v1alpha1"
 	subscriptionsapi "github.com/dapr/dapr/pkg/apis/subscriptions/v1alpha1"
 	channelt "github.com/dapr/dapr/pkg/channel/testing"
 	bindings_loader "github.com/dapr/dapr/pkg/components/bindings"
+	grpc_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/grpc"
+	http_middleware_loader "github.com/dapr/dapr/pkg/components/middleware/http"
 	nr_loader "github.com/dapr/dapr/pkg/components/nameresolution"
 	pubsub_loader "github.com/dapr/dapr/pkg/components/pubsub"
 	secretstores_loader "github.com/dapr/dapr/pkg/components/secretstores"
@@ -80,6 +85,8 @@ import (
 	"github.com/dapr/dapr/pkg/encryption"
 	"github.com/dapr/dapr/pkg/expr"
 	invokev1 "github.com/dapr/dapr/pkg/messaging/v1"
+	grpc_middleware "github.com/dapr/dapr/pkg/middleware/grpc"
+	http_middleware "github.com/dapr/dapr/pkg/middleware/http"
 	"github.com/dapr/dapr/pkg/modes"
 	operatorv1pb "github.com/dapr/dapr/pkg/proto/operator/v1"
 	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
@@ -909,6 +916,24 @@ func TestSetupTracing(t *testing.T) {
 			Stdout: true,
 		},
 		expectedExporters: []trace.Exporter{&diag_utils.StdoutExporter{}},
+	}, {
+		name: "otlp trace exporter",
+		tracingConfig: config.TracingSpec{
+			Otel: config.OtelSpec{
+				EndpointAddress: "localhost:4317",
+				Insecure:        true,
+			},
+		},
+		expectedExporters: []trace.Exporter{&diag_utils.OTLPExporter{}},
+	}, {
+		name: "bad otlp protocol",
+		tracingConfig: config.TracingSpec{
+			Otel: config.OtelSpec{
+				EndpointAddress: "localhost:4317",
+				Protocol:        "udp",
+			},
+		},
+		expectedErr: "unsupported otlp protocol",
 	}, {
 		name: "all trace exporters",
 		tracingConfig: config.TracingSpec{
@@ -1078,6 +1103,7 @@ func TestOnComponentUpdated(t *testing.T) {
 				},
 			},
 		})
+		rt.pubSubs["test"] = &mockPubSub{}
 
 		go func() {
 			<-rt.pendingComponents
@@ -1104,6 +1130,8 @@ func TestOnComponentUpdated(t *testing.T) {
 		})
 
 		assert.True(t, updated)
+		// The old instance is closed and removed until the new one is initialized.
+		assert.NotContains(t, rt.pubSubs, "test")
 	})
 
 	t.Run("component spec unchanged, component is skipped", func(t *testing.T) {
@@ -1156,6 +1184,40 @@ func TestOnComponentUpdated(t *testing.T) {
 	})
 }
 
+func TestOnComponentDeleted(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	rt.components = []components_v1alpha1.Component{
+		{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "mystate"},
+			Spec:       components_v1alpha1.ComponentSpec{Type: "state.mockState", Version: "v1"},
+		},
+		{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "mypubsub"},
+			Spec:       components_v1alpha1.ComponentSpec{Type: "pubsub.mockPubSub", Version: "v1"},
+		},
+	}
+	rt.stateStores["mystate"] = &mockStateStore{}
+	rt.pubSubs["mypubsub"] = &mockPubSub{}
+	rt.scopedPublishings["mypubsub"] = []string{"topic1"}
+
+	rt.onComponentDeleted(rt.components[1])
+
+	assert.Len(t, rt.components, 1)
+	assert.Equal(t, "mystate", rt.components[0].Name)
+	assert.NotContains(t, rt.pubSubs, "mypubsub")
+	assert.NotContains(t, rt.scopedPublishings, "mypubsub")
+	assert.Contains(t, rt.stateStores, "mystate")
+
+	encryption.AddEncryptedStateStore("mystate", encryption.ComponentEncryptionKeys{})
+	rt.onComponentDeleted(rt.components[0])
+
+	assert.Empty(t, rt.components)
+	assert.NotContains(t, rt.stateStores, "mystate")
+	assert.False(t, encryption.EncryptedStateStore("mystate"))
+}
+
 func TestConsumerID(t *testing.T) {
 	metadata := []components_v1alpha1.MetadataItem{
 		{
@@ -3204,7 +3266,8 @@ func NewTestDaprRuntimeWithProtocol(mode modes.DaprMode, protocol string, appPor
 		false,
 		time.Second,
 		true,
-		true)
+		true,
+		nil)
 
 	return NewDaprRuntime(testRuntimeConfig, &config.Configuration{}, &config.AccessControlList{}, resiliency.New(logger.NewLogger("test")))
 }
@@ -3602,6 +3665,127 @@ func (m *mockPublishPubSub) Features() []pubsub.Feature {
 	return nil
 }
 
+// mockBulkPublishPubSub fails to publish the events with "fail" as data.
+type mockBulkPublishPubSub struct {
+	mockPublishPubSub
+	lock      sync.Mutex
+	published []string
+}
+
+// Publish is a mock publish method.
+func (m *mockBulkPublishPubSub) Publish(req *pubsub.PublishRequest) error {
+	if string(req.Data) == "fail" {
+		return errors.New("publish failed")
+	}
+
+	m.lock.Lock()
+	m.published = append(m.published, string(req.Data))
+	m.lock.Unlock()
+	return nil
+}
+
+func TestBulkPublish(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	req := &runtime_pubsub.BulkPublishRequest{
+		PubsubName: TestPubsubName,
+		Topic:      "topic0",
+		Entries: []runtime_pubsub.BulkMessageEntry{
+			{EntryID: "1", Event: []byte("event1")},
+			{EntryID: "2", Event: []byte("fail")},
+			{EntryID: "3", Event: []byte("event3")},
+		},
+	}
+
+	t.Run("pubsub not found", func(t *testing.T) {
+		_, err := rt.BulkPublish(req)
+		assert.ErrorAs(t, err, &runtime_pubsub.NotFoundError{})
+	})
+
+	t.Run("topic not allowed", func(t *testing.T) {
+		rt.pubSubs[TestPubsubName] = &mockBulkPublishPubSub{}
+		rt.allowedTopics[TestPubsubName] = []string{"topic1"}
+		defer delete(rt.allowedTopics, TestPubsubName)
+
+		_, err := rt.BulkPublish(req)
+		assert.ErrorAs(t, err, &runtime_pubsub.NotAllowedError{})
+	})
+
+	t.Run("topic denied by access control policy", func(t *testing.T) {
+		rt.pubSubs[TestPubsubName] = &mockBulkPublishPubSub{}
+		accessControlList, err := acl.ParseAccessControlSpec(config.AccessControlSpec{
+			DefaultAction: config.AllowAccess,
+			TrustDomain:   config.DefaultTrustDomain,
+			AppPolicies: []config.AppPolicySpec{
+				{
+					AppName:     TestRuntimeConfigID,
+					TrustDomain: config.DefaultTrustDomain,
+					Namespace:   config.DefaultNamespace,
+					PubSubActions: []config.PubSubAction{
+						{
+							PubSubName: TestPubsubName,
+							Topic:      "topic0",
+							Operations: []string{config.PubSubPublishOperation},
+							Action:     config.DenyAccess,
+						},
+					},
+				},
+			},
+		}, config.HTTPProtocol)
+		require.NoError(t, err)
+		defer func(previous *config.AccessControlList) { rt.accessControlList = previous }(rt.accessControlList)
+		rt.accessControlList = accessControlList
+
+		_, err = rt.BulkPublish(req)
+		assert.ErrorAs(t, err, &runtime_pubsub.NotAllowedError{})
+	})
+
+	t.Run("publish each entry", func(t *testing.T) {
+		ps := &mockBulkPublishPubSub{}
+		rt.pubSubs[TestPubsubName] = ps
+
+		res, err := rt.BulkPublish(req)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(res.FailedEntries))
+		assert.Equal(t, "2", res.FailedEntries[0].EntryID)
+		assert.ElementsMatch(t, []string{"event1", "event3"}, ps.published)
+	})
+}
+
+func TestGetSubscriptions(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	assert.Empty(t, rt.getSubscriptions())
+
+	rules := []*runtime_pubsub.Rule{{Path: "orders"}}
+	rt.topicRoutes = map[string]TopicRoute{
+		"pubsub2": {routes: map[string]Route{"topic1": {rules: rules}}},
+		"pubsub1": {routes: map[string]Route{
+			"topic2": {rules: rules},
+			"topic1": {rules: rules, metadata: map[string]string{"rawPayload": "true"}},
+		}},
+	}
+	rt.deadLetterTopics = map[string]string{"pubsub1||topic2": "dead"}
+	defer func() {
+		rt.topicRoutes = nil
+		rt.deadLetterTopics = nil
+	}()
+
+	subscriptions := rt.getSubscriptions()
+	require.Len(t, subscriptions, 3)
+	assert.Equal(t, runtime_pubsub.Subscription{
+		PubsubName: "pubsub1",
+		Topic:      "topic1",
+		Metadata:   map[string]string{"rawPayload": "true"},
+		Rules:      rules,
+	}, subscriptions[0])
+	assert.Equal(t, "topic2", subscriptions[1].Topic)
+	assert.Equal(t, "dead", subscriptions[1].DeadLetterTopic)
+	assert.Equal(t, "pubsub2", subscriptions[2].PubsubName)
+}
+
 func TestInitActors(t *testing.T) {
 	t.Run("missing namespace on kubernetes", func(t *testing.T) {
 		r := NewDaprRuntime(&Config{Mode: modes.KubernetesMode}, &config.Configuration{}, &config.AccessControlList{}, resiliency.New(logger.NewLogger("test")))
@@ -4252,3 +4436,89 @@ func (s *pingStreamService) PingStream(stream pb.TestService_PingStreamServer) e
 	}
 	return nil
 }
+
+func TestBuildGRPCPipeline(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	rt.globalConfig.Spec.GRPCPipelineSpec = config.PipelineSpec{
+		Handlers: []config.HandlerSpec{
+			{Name: "auth", Type: "middleware.grpc.mock", Version: "v1"},
+		},
+	}
+
+	t.Run("middleware component is missing", func(t *testing.T) {
+		_, err := rt.buildGRPCPipeline()
+		assert.Error(t, err)
+	})
+
+	t.Run("middleware is created with the component metadata", func(t *testing.T) {
+		rt.components = append(rt.components, components_v1alpha1.Component{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name: "auth",
+			},
+			Spec: components_v1alpha1.ComponentSpec{
+				Type:    "middleware.grpc.mock",
+				Version: "v1",
+				Metadata: []components_v1alpha1.MetadataItem{
+					{
+						Name: "header",
+						Value: components_v1alpha1.DynamicValue{
+							JSON: v1.JSON{Raw: []byte("authorization")},
+						},
+					},
+				},
+			},
+		})
+
+		var props map[string]string
+		rt.grpcMiddlewareRegistry.Register(grpc_middleware_loader.New("mock", func(metadata middleware.Metadata) (grpc_middleware.Middleware, error) {
+			props = metadata.Properties
+			return grpc_middleware.Middleware{
+				Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+					return handler(ctx, req)
+				},
+			}, nil
+		}))
+
+		pipeline, err := rt.buildGRPCPipeline()
+		require.NoError(t, err)
+		assert.Len(t, pipeline.UnaryServerInterceptors(), 1)
+		assert.Len(t, pipeline.StreamServerInterceptors(), 0)
+		assert.Equal(t, "authorization", props["header"])
+	})
+}
+
+func TestBuildAppHTTPPipeline(t *testing.T) {
+	rt := NewTestDaprRuntime(modes.StandaloneMode)
+	defer stopRuntime(t, rt)
+
+	rt.components = append(rt.components, components_v1alpha1.Component{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "appauth",
+		},
+		Spec: components_v1alpha1.ComponentSpec{
+			Type:    "middleware.http.mock",
+			Version: "v1",
+		},
+	})
+	rt.httpMiddlewareRegistry.Register(http_middleware_loader.New("mock", func(metadata middleware.Metadata) (http_middleware.Middleware, error) {
+		return func(h fasthttp.RequestHandler) fasthttp.RequestHandler {
+			return h
+		}, nil
+	}))
+	rt.globalConfig.Spec.AppHTTPPipelineSpec = config.PipelineSpec{
+		Handlers: []config.HandlerSpec{
+			{Name: "appauth", Type: "middleware.http.mock", Version: "v1"},
+		},
+	}
+
+	pipeline, err := rt.buildAppHTTPPipeline()
+	require.NoError(t, err)
+	assert.Len(t, pipeline.Handlers, 1)
+
+	// The pipeline of the Dapr API is configured separately.
+	pipeline, err = rt.buildHTTPPipeline()
+	require.NoError(t, err)
+	assert.Len(t, pipeline.Handlers, 0)
+}
@@ -17,12 +17,15 @@ package testing
 
 import (
 	"github.com/dapr/components-contrib/pubsub"
+
+	runtime_pubsub "github.com/dapr/dapr/pkg/runtime/pubsub"
 )
 
 // MockPubSubAdapter is mock for PubSubAdapter
 type MockPubSubAdapter struct {
-	PublishFn   func(req *pubsub.PublishRequest) error
-	GetPubSubFn func(pubsubName string) pubsub.PubSub
+	PublishFn     func(req *pubsub.PublishRequest) error
+	BulkPublishFn func(req *runtime_pubsub.BulkPublishRequest) (runtime_pubsub.BulkPublishResponse, error)
+	GetPubSubFn   func(pubsubName string) pubsub.PubSub
 }
 
 // Publish is an adapter method for the runtime to pre-validate publish requests
@@ -32,6 +35,12 @@ func (a *MockPubSubAdapter) Publish(req *pubsub.PublishRequest) error {
 	return a.PublishFn(req)
 }
 
+// BulkPublish is an adapter method for the runtime to pre-validate bulk publish requests
+// And then forward them to the Pub/Sub component.
+func (a *MockPubSubAdapter) BulkPublish(req *runtime_pubsub.BulkPublishRequest) (runtime_pubsub.BulkPublishResponse, error) {
+	return a.BulkPublishFn(req)
+}
+
 // GetPubSub is an adapter method to fetch a pubsub
 func (a *MockPubSubAdapter) GetPubSub(pubsubName string) pubsub.PubSub {
 	return a.GetPubSubFn(pubsubName)